@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file myncer/datasource.proto.
  */
 export const file_myncer_datasource: GenFile = /*@__PURE__*/
-  fileDesc("ChdteW5jZXIvZGF0YXNvdXJjZS5wcm90bxIGbXluY2VyInsKGEV4Y2hhbmdlT0F1dGhDb2RlUmVxdWVzdBImCgpkYXRhc291cmNlGAEgASgOMhIubXluY2VyLkRhdGFzb3VyY2USDAoEY29kZRgCIAEoCRISCgpjc3JmX3Rva2VuGAMgASgJEhUKDWNvZGVfdmVyaWZpZXIYBCABKAkibgoZRXhjaGFuZ2VPQXV0aENvZGVSZXNwb25zZRIVCg1lcnJvcl9tZXNzYWdlGAEgASgJEjoKFW9hdXRoX2V4Y2hhbmdlX3N0YXR1cxgCIAEoDjIbLm15bmNlci5PQXV0aEV4Y2hhbmdlU3RhdHVzIkEKF1VubGlua0RhdGFzb3VyY2VSZXF1ZXN0EiYKCmRhdGFzb3VyY2UYASABKA4yEi5teW5jZXIuRGF0YXNvdXJjZSIaChhVbmxpbmtEYXRhc291cmNlUmVzcG9uc2UiGAoWTGlzdERhdGFzb3VyY2VzUmVxdWVzdCJCChdMaXN0RGF0YXNvdXJjZXNSZXNwb25zZRInCgtkYXRhc291cmNlcxgBIAMoDjISLm15bmNlci5EYXRhc291cmNlIj4KFExpc3RQbGF5bGlzdHNSZXF1ZXN0EiYKCmRhdGFzb3VyY2UYASABKA4yEi5teW5jZXIuRGF0YXNvdXJjZSJrCghQbGF5bGlzdBIpCgxtdXNpY19zb3VyY2UYASABKAsyEy5teW5jZXIuTXVzaWNTb3VyY2USDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIRCglpbWFnZV91cmwYBCABKAkiOwoVTGlzdFBsYXlsaXN0c1Jlc3BvbnNlEiIKCHBsYXlsaXN0GAEgAygLMhAubXluY2VyLlBsYXlsaXN0IlgKGUdldFBsYXlsaXN0RGV0YWlsc1JlcXVlc3QSJgoKZGF0YXNvdXJjZRgBIAEoDjISLm15bmNlci5EYXRhc291cmNlEhMKC3BsYXlsaXN0X2lkGAIgASgJIkAKGkdldFBsYXlsaXN0RGV0YWlsc1Jlc3BvbnNlEiIKCHBsYXlsaXN0GAEgASgLMhAubXluY2VyLlBsYXlsaXN0IkoKC011c2ljU291cmNlEiYKCmRhdGFzb3VyY2UYASABKA4yEi5teW5jZXIuRGF0YXNvdXJjZRITCgtwbGF5bGlzdF9pZBgCIAEoCSpuCgpEYXRhc291cmNlEhoKFkRBVEFTT1VSQ0VfVU5TUEVDSUZJRUQQABIWChJEQVRBU09VUkNFX1NQT1RJRlkQARIWChJEQVRBU09VUkNFX1lPVVRVQkUQAhIUChBEQVRBU09VUkNFX1RJREFMEAMqhQEKE09BdXRoRXhjaGFuZ2VTdGF0dXMSJgoiT19BVVRIX0VYQ0hBTkdFX1NUQVRVU19VTlNQRUNJRklFRBAAEiIKHk9fQVVUSF9FWENIQU5HRV9TVEFUVVNfU1VDQ0VTUxABEiIKHk9fQVVUSF9FWENIQU5HRV9TVEFUVVNfRkFJTFVSRRACMsMDChFEYXRhc291cmNlU2VydmljZRJYChFFeGNoYW5nZU9BdXRoQ29kZRIgLm15bmNlci5FeGNoYW5nZU9BdXRoQ29kZVJlcXVlc3QaIS5teW5jZXIuRXhjaGFuZ2VPQXV0aENvZGVSZXNwb25zZRJSCg9MaXN0RGF0YXNvdXJjZXMSHi5teW5jZXIuTGlzdERhdGFzb3VyY2VzUmVxdWVzdBofLm15bmNlci5MaXN0RGF0YXNvdXJjZXNSZXNwb25zZRJMCg1MaXN0UGxheWxpc3RzEhwubXluY2VyLkxpc3RQbGF5bGlzdHNSZXF1ZXN0Gh0ubXluY2VyLkxpc3RQbGF5bGlzdHNSZXNwb25zZRJbChJHZXRQbGF5bGlzdERldGFpbHMSIS5teW5jZXIuR2V0UGxheWxpc3REZXRhaWxzUmVxdWVzdBoiLm15bmNlci5HZXRQbGF5bGlzdERldGFpbHNSZXNwb25zZRJVChBVbmxpbmtEYXRhc291cmNlEh8ubXluY2VyLlVubGlua0RhdGFzb3VyY2VSZXF1ZXN0GiAubXluY2VyLlVubGlua0RhdGFzb3VyY2VSZXNwb25zZUIzWjFnaXRodWIuY29tL2hhbnNiYWxhL215bmNlci9wcm90by9teW5jZXI7bXluY2VyX3BiYgZwcm90bzM");
+  fileDesc("ChdteW5jZXIvZGF0YXNvdXJjZS5wcm90bxIGbXluY2VyInsKGEV4Y2hhbmdlT0F1dGhDb2RlUmVxdWVzdBImCgpkYXRhc291cmNlGAEgASgOMhIubXluY2VyLkRhdGFzb3VyY2USDAoEY29kZRgCIAEoCRISCgpjc3JmX3Rva2VuGAMgASgJEhUKDWNvZGVfdmVyaWZpZXIYBCABKAkibgoZRXhjaGFuZ2VPQXV0aENvZGVSZXNwb25zZRIVCg1lcnJvcl9tZXNzYWdlGAEgASgJEjoKFW9hdXRoX2V4Y2hhbmdlX3N0YXR1cxgCIAEoDjIbLm15bmNlci5PQXV0aEV4Y2hhbmdlU3RhdHVzIkEKF1VubGlua0RhdGFzb3VyY2VSZXF1ZXN0EiYKCmRhdGFzb3VyY2UYASABKA4yEi5teW5jZXIuRGF0YXNvdXJjZSIaChhVbmxpbmtEYXRhc291cmNlUmVzcG9uc2UiGAoWTGlzdERhdGFzb3VyY2VzUmVxdWVzdCJCChdMaXN0RGF0YXNvdXJjZXNSZXNwb25zZRInCgtkYXRhc291cmNlcxgBIAMoDjISLm15bmNlci5EYXRhc291cmNlIj4KFExpc3RQbGF5bGlzdHNSZXF1ZXN0EiYKCmRhdGFzb3VyY2UYASABKA4yEi5teW5jZXIuRGF0YXNvdXJjZSJrCghQbGF5bGlzdBIpCgxtdXNpY19zb3VyY2UYASABKAsyEy5teW5jZXIuTXVzaWNTb3VyY2USDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIRCglpbWFnZV91cmwYBCABKAkiOwoVTGlzdFBsYXlsaXN0c1Jlc3BvbnNlEiIKCHBsYXlsaXN0GAEgAygLMhAubXluY2VyLlBsYXlsaXN0IlgKGUdldFBsYXlsaXN0RGV0YWlsc1JlcXVlc3QSJgoKZGF0YXNvdXJjZRgBIAEoDjISLm15bmNlci5EYXRhc291cmNlEhMKC3BsYXlsaXN0X2lkGAIgASgJIkAKGkdldFBsYXlsaXN0RGV0YWlsc1Jlc3BvbnNlEiIKCHBsYXlsaXN0GAEgASgLMhAubXluY2VyLlBsYXlsaXN0InEKC011c2ljU291cmNlEiYKCmRhdGFzb3VyY2UYASABKA4yEi5teW5jZXIuRGF0YXNvdXJjZRITCgtwbGF5bGlzdF9pZBgCIAEoCRIlCgRraW5kGAMgASgOMhcubXluY2VyLk11c2ljU291cmNlS2luZCpuCgpEYXRhc291cmNlEhoKFkRBVEFTT1VSQ0VfVU5TUEVDSUZJRUQQABIWChJEQVRBU09VUkNFX1NQT1RJRlkQARIWChJEQVRBU09VUkNFX1lPVVRVQkUQAhIUChBEQVRBU09VUkNFX1RJREFMEAMqhQEKE09BdXRoRXhjaGFuZ2VTdGF0dXMSJgoiT19BVVRIX0VYQ0hBTkdFX1NUQVRVU19VTlNQRUNJRklFRBAAEiIKHk9fQVVUSF9FWENIQU5HRV9TVEFUVVNfU1VDQ0VTUxABEiIKHk9fQVVUSF9FWENIQU5HRV9TVEFUVVNfRkFJTFVSRRACKnwKD011c2ljU291cmNlS2luZBIhCh1NVVNJQ19TT1VSQ0VfS0lORF9VTlNQRUNJRklFRBAAEiIKHk1VU0lDX1NPVVJDRV9LSU5EX0xJS0VEX1RSQUNLUxABEiIKHk1VU0lDX1NPVVJDRV9LSU5EX1NBVkVEX0FMQlVNUxACMsMDChFEYXRhc291cmNlU2VydmljZRJYChFFeGNoYW5nZU9BdXRoQ29kZRIgLm15bmNlci5FeGNoYW5nZU9BdXRoQ29kZVJlcXVlc3QaIS5teW5jZXIuRXhjaGFuZ2VPQXV0aENvZGVSZXNwb25zZRJSCg9MaXN0RGF0YXNvdXJjZXMSHi5teW5jZXIuTGlzdERhdGFzb3VyY2VzUmVxdWVzdBofLm15bmNlci5MaXN0RGF0YXNvdXJjZXNSZXNwb25zZRJMCg1MaXN0UGxheWxpc3RzEhwubXluY2VyLkxpc3RQbGF5bGlzdHNSZXF1ZXN0Gh0ubXluY2VyLkxpc3RQbGF5bGlzdHNSZXNwb25zZRJbChJHZXRQbGF5bGlzdERldGFpbHMSIS5teW5jZXIuR2V0UGxheWxpc3REZXRhaWxzUmVxdWVzdBoiLm15bmNlci5HZXRQbGF5bGlzdERldGFpbHNSZXNwb25zZRJVChBVbmxpbmtEYXRhc291cmNlEh8ubXluY2VyLlVubGlua0RhdGFzb3VyY2VSZXF1ZXN0GiAubXluY2VyLlVubGlua0RhdGFzb3VyY2VSZXNwb25zZUIzWjFnaXRodWIuY29tL2hhbnNiYWxhL215bmNlci9wcm90by9teW5jZXI7bXluY2VyX3BiYgZwcm90bzM");
 
 /**
  * @generated from message myncer.ExchangeOAuthCodeRequest
@@ -264,12 +264,18 @@ export type MusicSource = Message<"myncer.MusicSource"> & {
 
   /**
    * Unique, stable playlist identifier for the datasource.
-   *
-   * next: 3
+   * Empty for library collections.
    *
    * @generated from field: string playlist_id = 2;
    */
   playlistId: string;
+
+  /**
+   * next: 4
+   *
+   * @generated from field: myncer.MusicSourceKind kind = 3;
+   */
+  kind: MusicSourceKind;
 };
 
 /**
@@ -336,6 +342,42 @@ export enum OAuthExchangeStatus {
 export const OAuthExchangeStatusSchema: GenEnum<OAuthExchangeStatus> = /*@__PURE__*/
   enumDesc(file_myncer_datasource, 1);
 
+/**
+ * What part of the user's library a music source is.
+ *
+ * @generated from enum myncer.MusicSourceKind
+ */
+export enum MusicSourceKind {
+  /**
+   * The playlist identified by `playlist_id`.
+   *
+   * @generated from enum value: MUSIC_SOURCE_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The tracks the user liked: Liked Songs on Spotify, favorite tracks on Tidal and liked videos
+   * on YouTube.
+   *
+   * @generated from enum value: MUSIC_SOURCE_KIND_LIKED_TRACKS = 1;
+   */
+  LIKED_TRACKS = 1,
+
+  /**
+   * The tracks of every album the user saved to their library.
+   * Can only be read, and isn't available on YouTube.
+   *
+   * @generated from enum value: MUSIC_SOURCE_KIND_SAVED_ALBUMS = 2;
+   */
+  SAVED_ALBUMS = 2,
+}
+
+/**
+ * Describes the enum myncer.MusicSourceKind.
+ */
+export const MusicSourceKindSchema: GenEnum<MusicSourceKind> = /*@__PURE__*/
+  enumDesc(file_myncer_datasource, 2);
+
 /**
  * @generated from service myncer.DatasourceService
  */
@@ -2,8 +2,10 @@
 // @generated from file myncer/song.proto (package myncer, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Datasource } from "./datasource_pb";
 import { file_myncer_datasource } from "./datasource_pb";
 import type { Message } from "@bufbuild/protobuf";
@@ -12,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file myncer/song.proto.
  */
 export const file_myncer_song: GenFile = /*@__PURE__*/
-  fileDesc("ChFteW5jZXIvc29uZy5wcm90bxIGbXluY2VyIpsBCgRTb25nEgoKAmlkGAYgASgJEgwKBG5hbWUYASABKAkSEwoLYXJ0aXN0X25hbWUYAiADKAkSEgoKYWxidW1fbmFtZRgDIAEoCRImCgpkYXRhc291cmNlGAQgASgOMhIubXluY2VyLkRhdGFzb3VyY2USGgoSZGF0YXNvdXJjZV9zb25nX2lkGAUgASgJEgwKBGlzcmMYByABKAlCM1oxZ2l0aHViLmNvbS9oYW5zYmFsYS9teW5jZXIvcHJvdG8vbXluY2VyO215bmNlcl9wYmIGcHJvdG8z", [file_myncer_datasource]);
+  fileDesc("ChFteW5jZXIvc29uZy5wcm90bxIGbXluY2VyIvABCgRTb25nEgoKAmlkGAYgASgJEgwKBG5hbWUYASABKAkSEwoLYXJ0aXN0X25hbWUYAiADKAkSEgoKYWxidW1fbmFtZRgDIAEoCRImCgpkYXRhc291cmNlGAQgASgOMhIubXluY2VyLkRhdGFzb3VyY2USGgoSZGF0YXNvdXJjZV9zb25nX2lkGAUgASgJEgwKBGlzcmMYByABKAkSLAoIYWRkZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCGV4cGxpY2l0GAkgASgIEhMKC2R1cmF0aW9uX21zGAogASgDItwBCg5Tb25nUmVzb2x1dGlvbhIPCgd1c2VyX2lkGAEgASgJEiEKC3NvdXJjZV9zb25nGAIgASgLMgwubXluY2VyLlNvbmcSJgoQZGVzdGluYXRpb25fc29uZxgDIAEoCzIMLm15bmNlci5Tb25nEg0KBXNjb3JlGAQgASgBEi8KC3Jlc29sdmVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpydW5uZXJfdXBzGAYgAygLMhoubXluY2VyLlNvbmdNYXRjaENhbmRpZGF0ZSI/ChJTb25nTWF0Y2hDYW5kaWRhdGUSGgoEc29uZxgBIAEoCzIMLm15bmNlci5Tb25nEg0KBXNjb3JlGAIgASgBIqYCCgxTb25nT3ZlcnJpZGUSDwoHdXNlcl9pZBgBIAEoCRIhCgtzb3VyY2Vfc29uZxgCIAEoCzIMLm15bmNlci5Tb25nEjIKFmRlc3RpbmF0aW9uX2RhdGFzb3VyY2UYAyABKA4yEi5teW5jZXIuRGF0YXNvdXJjZRImCgRraW5kGAQgASgOMhgubXluY2VyLlNvbmdPdmVycmlkZUtpbmQSJgoQZGVzdGluYXRpb25fc29uZxgFIAEoCzIMLm15bmNlci5Tb25nEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wKnUKEFNvbmdPdmVycmlkZUtpbmQSIgoeU09OR19PVkVSUklERV9LSU5EX1VOU1BFQ0lGSUVEEAASGgoWU09OR19PVkVSUklERV9LSU5EX1BJThABEiEKHVNPTkdfT1ZFUlJJREVfS0lORF9ORVZFUl9TWU5DEAJCM1oxZ2l0aHViLmNvbS9oYW5zYmFsYS9teW5jZXIvcHJvdG8vbXluY2VyO215bmNlcl9wYmIGcHJvdG8z", [file_google_protobuf_timestamp, file_myncer_datasource]);
 
 /**
  * @generated from message myncer.Song
@@ -54,11 +56,33 @@ export type Song = Message<"myncer.Song"> & {
   datasourceSongId: string;
 
   /**
-   * next: 8
-   *
    * @generated from field: string isrc = 7;
    */
   isrc: string;
+
+  /**
+   * When the song was added to the playlist it was fetched from.
+   * Unset for songs that weren't fetched from a playlist or if the datasource doesn't say.
+   *
+   * @generated from field: google.protobuf.Timestamp added_at = 8;
+   */
+  addedAt?: Timestamp;
+
+  /**
+   * Whether the datasource marks the song as explicit.
+   *
+   * @generated from field: bool explicit = 9;
+   */
+  explicit: boolean;
+
+  /**
+   * Length of the song. Zero if the datasource doesn't say.
+   *
+   * next: 11
+   *
+   * @generated from field: int64 duration_ms = 10;
+   */
+  durationMs: bigint;
 };
 
 /**
@@ -68,3 +92,164 @@ export type Song = Message<"myncer.Song"> & {
 export const SongSchema: GenMessage<Song> = /*@__PURE__*/
   messageDesc(file_myncer_song, 0);
 
+/**
+ * A song of one datasource resolved to a song of another datasource by searching for it.
+ * Resolutions are cached per user so that repeat runs don't search for the same songs again.
+ *
+ * @generated from message myncer.SongResolution
+ */
+export type SongResolution = Message<"myncer.SongResolution"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Only the datasource and datasource song id are set.
+   *
+   * @generated from field: myncer.Song source_song = 2;
+   */
+  sourceSong?: Song;
+
+  /**
+   * @generated from field: myncer.Song destination_song = 3;
+   */
+  destinationSong?: Song;
+
+  /**
+   * How similar the destination song is to the source song, from 0 to 100.
+   *
+   * @generated from field: double score = 4;
+   */
+  score: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp resolved_at = 5;
+   */
+  resolvedAt?: Timestamp;
+
+  /**
+   * Other songs the search found, best first.
+   *
+   * @generated from field: repeated myncer.SongMatchCandidate runner_ups = 6;
+   */
+  runnerUps: SongMatchCandidate[];
+};
+
+/**
+ * Describes the message myncer.SongResolution.
+ * Use `create(SongResolutionSchema)` to create a new message.
+ */
+export const SongResolutionSchema: GenMessage<SongResolution> = /*@__PURE__*/
+  messageDesc(file_myncer_song, 1);
+
+/**
+ * A song found by searching a datasource.
+ *
+ * @generated from message myncer.SongMatchCandidate
+ */
+export type SongMatchCandidate = Message<"myncer.SongMatchCandidate"> & {
+  /**
+   * @generated from field: myncer.Song song = 1;
+   */
+  song?: Song;
+
+  /**
+   * How similar the song is to the song searched for, from 0 to 100.
+   *
+   * @generated from field: double score = 2;
+   */
+  score: number;
+};
+
+/**
+ * Describes the message myncer.SongMatchCandidate.
+ * Use `create(SongMatchCandidateSchema)` to create a new message.
+ */
+export const SongMatchCandidateSchema: GenMessage<SongMatchCandidate> = /*@__PURE__*/
+  messageDesc(file_myncer_song, 2);
+
+/**
+ * Overrides how a song of one datasource is synced to another datasource.
+ * Overrides are set by the user and take precedence over searching for the song.
+ *
+ * @generated from message myncer.SongOverride
+ */
+export type SongOverride = Message<"myncer.SongOverride"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * Only the datasource and datasource song id identify the song, the rest is kept for display.
+   *
+   * @generated from field: myncer.Song source_song = 2;
+   */
+  sourceSong?: Song;
+
+  /**
+   * @generated from field: myncer.Datasource destination_datasource = 3;
+   */
+  destinationDatasource: Datasource;
+
+  /**
+   * @generated from field: myncer.SongOverrideKind kind = 4;
+   */
+  kind: SongOverrideKind;
+
+  /**
+   * Only set for pins.
+   *
+   * @generated from field: myncer.Song destination_song = 5;
+   */
+  destinationSong?: Song;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 7;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message myncer.SongOverride.
+ * Use `create(SongOverrideSchema)` to create a new message.
+ */
+export const SongOverrideSchema: GenMessage<SongOverride> = /*@__PURE__*/
+  messageDesc(file_myncer_song, 3);
+
+/**
+ * @generated from enum myncer.SongOverrideKind
+ */
+export enum SongOverrideKind {
+  /**
+   * @generated from enum value: SONG_OVERRIDE_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The song is always synced as the destination song instead of searching for it.
+   *
+   * @generated from enum value: SONG_OVERRIDE_KIND_PIN = 1;
+   */
+  PIN = 1,
+
+  /**
+   * The song is never synced to the destination datasource.
+   *
+   * @generated from enum value: SONG_OVERRIDE_KIND_NEVER_SYNC = 2;
+   */
+  NEVER_SYNC = 2,
+}
+
+/**
+ * Describes the enum myncer.SongOverrideKind.
+ */
+export const SongOverrideKindSchema: GenEnum<SongOverrideKind> = /*@__PURE__*/
+  enumDesc(file_myncer_song, 0);
+
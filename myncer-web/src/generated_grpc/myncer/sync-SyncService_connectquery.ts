@@ -14,6 +14,25 @@ export const createSync = SyncService.method.createSync;
  */
 export const deleteSync = SyncService.method.deleteSync;
 
+/**
+ * Replaces the settings of a sync, keeping its run history.
+ *
+ * @generated from rpc myncer.SyncService.UpdateSync
+ */
+export const updateSync = SyncService.method.updateSync;
+
+/**
+ * Stops the sync from running until it is resumed. Runs already in progress are not cancelled.
+ *
+ * @generated from rpc myncer.SyncService.PauseSync
+ */
+export const pauseSync = SyncService.method.pauseSync;
+
+/**
+ * @generated from rpc myncer.SyncService.ResumeSync
+ */
+export const resumeSync = SyncService.method.resumeSync;
+
 /**
  * @generated from rpc myncer.SyncService.ListSyncs
  */
@@ -33,3 +52,86 @@ export const runSync = SyncService.method.runSync;
  * @generated from rpc myncer.SyncService.ListSyncRuns
  */
 export const listSyncRuns = SyncService.method.listSyncRuns;
+
+/**
+ * @generated from rpc myncer.SyncService.CancelSyncRun
+ */
+export const cancelSyncRun = SyncService.method.cancelSyncRun;
+
+/**
+ * Snapshots are taken before a sync run removes songs from a playlist.
+ *
+ * @generated from rpc myncer.SyncService.ListPlaylistSnapshots
+ */
+export const listPlaylistSnapshots = SyncService.method.listPlaylistSnapshots;
+
+/**
+ * @generated from rpc myncer.SyncService.DiffPlaylistSnapshots
+ */
+export const diffPlaylistSnapshots = SyncService.method.diffPlaylistSnapshots;
+
+/**
+ * Puts the playlist back exactly as it was when the snapshot was taken.
+ *
+ * @generated from rpc myncer.SyncService.RestorePlaylistSnapshot
+ */
+export const restorePlaylistSnapshot = SyncService.method.restorePlaylistSnapshot;
+
+/**
+ * Shows how the user's syncs connect their playlists.
+ *
+ * @generated from rpc myncer.SyncService.GetSyncGraph
+ */
+export const getSyncGraph = SyncService.method.getSyncGraph;
+
+/**
+ * Copies playlists of one datasource to another. A new playlist is created on the destination
+ * for each playlist, along with a one-way sync which is run right away.
+ *
+ * @generated from rpc myncer.SyncService.TransferLibrary
+ */
+export const transferLibrary = SyncService.method.transferLibrary;
+
+/**
+ * Returns the transfer with the progress of its runs so far.
+ *
+ * @generated from rpc myncer.SyncService.GetLibraryTransfer
+ */
+export const getLibraryTransfer = SyncService.method.getLibraryTransfer;
+
+/**
+ * @generated from rpc myncer.SyncService.ListLibraryTransfers
+ */
+export const listLibraryTransfers = SyncService.method.listLibraryTransfers;
+
+/**
+ * Forgets which songs the user's songs were resolved to, so that they are searched for again.
+ *
+ * @generated from rpc myncer.SyncService.ClearSongResolutions
+ */
+export const clearSongResolutions = SyncService.method.clearSongResolutions;
+
+/**
+ * Searches a datasource for a song, e.g. to find the song to pin another song to.
+ *
+ * @generated from rpc myncer.SyncService.SearchSongs
+ */
+export const searchSongs = SyncService.method.searchSongs;
+
+/**
+ * Pins a song to a song of another datasource, or marks it to never be synced to the datasource.
+ * Replaces the existing override of the song for the datasource, if any.
+ *
+ * @generated from rpc myncer.SyncService.SetSongOverride
+ */
+export const setSongOverride = SyncService.method.setSongOverride;
+
+/**
+ * @generated from rpc myncer.SyncService.DeleteSongOverride
+ */
+export const deleteSongOverride = SyncService.method.deleteSongOverride;
+
+/**
+ * @generated from rpc myncer.SyncService.ListSongOverrides
+ */
+export const listSongOverrides = SyncService.method.listSongOverrides;
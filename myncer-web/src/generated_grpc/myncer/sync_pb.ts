@@ -6,9 +6,9 @@ import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobu
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
-import type { MusicSource } from "./datasource_pb";
+import type { Datasource, MusicSource } from "./datasource_pb";
 import { file_myncer_datasource } from "./datasource_pb";
-import type { Song } from "./song_pb";
+import type { Song, SongMatchCandidate, SongOverride, SongOverrideKind } from "./song_pb";
 import { file_myncer_song } from "./song_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file myncer/sync.proto.
  */
 export const file_myncer_sync: GenFile = /*@__PURE__*/
-  fileDesc("ChFteW5jZXIvc3luYy5wcm90bxIGbXluY2VyIn8KEVBsYXlsaXN0TWVyZ2VTeW5jEiQKB3NvdXJjZXMYASADKAsyEy5teW5jZXIuTXVzaWNTb3VyY2USKAoLZGVzdGluYXRpb24YAiABKAsyEy5teW5jZXIuTXVzaWNTb3VyY2USGgoSb3ZlcndyaXRlX2V4aXN0aW5nGAMgASgIIvkBCgRTeW5jEgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSLgoKY3JlYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKgoMb25lX3dheV9zeW5jGAUgASgLMhIubXluY2VyLk9uZVdheVN5bmNIABI4ChNwbGF5bGlzdF9tZXJnZV9zeW5jGAYgASgLMhkubXluY2VyLlBsYXlsaXN0TWVyZ2VTeW5jSABCDgoMc3luY192YXJpYW50IvEBCgdTeW5jUnVuEg8KB3N5bmNfaWQYASABKAkSDgoGcnVuX2lkGAIgASgJEicKC3N5bmNfc3RhdHVzGAMgASgOMhIubXluY2VyLlN5bmNTdGF0dXMSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASJQoPdW5tYXRjaGVkX3NvbmdzGAYgAygLMgwubXluY2VyLlNvbmcSFQoNZXJyb3JfbWVzc2FnZRgHIAEoCSJ3CgpPbmVXYXlTeW5jEiMKBnNvdXJjZRgBIAEoCzITLm15bmNlci5NdXNpY1NvdXJjZRIoCgtkZXN0aW5hdGlvbhgCIAEoCzITLm15bmNlci5NdXNpY1NvdXJjZRIaChJvdmVyd3JpdGVfZXhpc3RpbmcYAyABKAgiiQEKEUNyZWF0ZVN5bmNSZXF1ZXN0EioKDG9uZV93YXlfc3luYxgBIAEoCzISLm15bmNlci5PbmVXYXlTeW5jSAASOAoTcGxheWxpc3RfbWVyZ2Vfc3luYxgCIAEoCzIZLm15bmNlci5QbGF5bGlzdE1lcmdlU3luY0gAQg4KDHN5bmNfdmFyaWFudCIwChJDcmVhdGVTeW5jUmVzcG9uc2USGgoEc3luYxgBIAEoCzIMLm15bmNlci5TeW5jIiQKEURlbGV0ZVN5bmNSZXF1ZXN0Eg8KB3N5bmNfaWQYASABKAkiJQoSRGVsZXRlU3luY1Jlc3BvbnNlEg8KB3N5bmNfaWQYASABKAkiEgoQTGlzdFN5bmNzUmVxdWVzdCIwChFMaXN0U3luY3NSZXNwb25zZRIbCgVzeW5jcxgBIAMoCzIMLm15bmNlci5TeW5jIiEKDkdldFN5bmNSZXF1ZXN0Eg8KB3N5bmNfaWQYASABKAkiLQoPR2V0U3luY1Jlc3BvbnNlEhoKBHN5bmMYASABKAsyDC5teW5jZXIuU3luYyIhCg5SdW5TeW5jUmVxdWVzdBIPCgdzeW5jX2lkGAEgASgJIl0KD1J1blN5bmNSZXNwb25zZRIPCgdzeW5jX2lkGAEgASgJEiIKBnN0YXR1cxgCIAEoDjISLm15bmNlci5TeW5jU3RhdHVzEhUKDWVycm9yX21lc3NhZ2UYAyABKAkiFQoTTGlzdFN5bmNSdW5zUmVxdWVzdCI6ChRMaXN0U3luY1J1bnNSZXNwb25zZRIiCglzeW5jX3J1bnMYASADKAsyDy5teW5jZXIuU3luY1J1biqpAQoKU3luY1N0YXR1cxIbChdTWU5DX1NUQVRVU19VTlNQRUNJRklFRBAAEhcKE1NZTkNfU1RBVFVTX1BFTkRJTkcQARIXChNTWU5DX1NUQVRVU19SVU5OSU5HEAISGQoVU1lOQ19TVEFUVVNfQ09NUExFVEVEEAMSFgoSU1lOQ19TVEFUVVNfRkFJTEVEEAQSGQoVU1lOQ19TVEFUVVNfQ0FOQ0VMTEVEEAUynAMKC1N5bmNTZXJ2aWNlEkMKCkNyZWF0ZVN5bmMSGS5teW5jZXIuQ3JlYXRlU3luY1JlcXVlc3QaGi5teW5jZXIuQ3JlYXRlU3luY1Jlc3BvbnNlEkMKCkRlbGV0ZVN5bmMSGS5teW5jZXIuRGVsZXRlU3luY1JlcXVlc3QaGi5teW5jZXIuRGVsZXRlU3luY1Jlc3BvbnNlEkAKCUxpc3RTeW5jcxIYLm15bmNlci5MaXN0U3luY3NSZXF1ZXN0GhkubXluY2VyLkxpc3RTeW5jc1Jlc3BvbnNlEjoKB0dldFN5bmMSFi5teW5jZXIuR2V0U3luY1JlcXVlc3QaFy5teW5jZXIuR2V0U3luY1Jlc3BvbnNlEjoKB1J1blN5bmMSFi5teW5jZXIuUnVuU3luY1JlcXVlc3QaFy5teW5jZXIuUnVuU3luY1Jlc3BvbnNlEkkKDExpc3RTeW5jUnVucxIbLm15bmNlci5MaXN0U3luY1J1bnNSZXF1ZXN0GhwubXluY2VyLkxpc3RTeW5jUnVuc1Jlc3BvbnNlQjNaMWdpdGh1Yi5jb20vaGFuc2JhbGEvbXluY2VyL3Byb3RvL215bmNlcjtteW5jZXJfcGJiBnByb3RvMw", [file_google_protobuf_timestamp, file_myncer_datasource, file_myncer_song]);
+  fileDesc("ChFteW5jZXIvc3luYy5wcm90bxIGbXluY2VyIogCChFQbGF5bGlzdE1lcmdlU3luYxIkCgdzb3VyY2VzGAEgAygLMhMubXluY2VyLk11c2ljU291cmNlEigKC2Rlc3RpbmF0aW9uGAIgASgLMhMubXluY2VyLk11c2ljU291cmNlEhoKEm92ZXJ3cml0ZV9leGlzdGluZxgDIAEoCBIrCgRtb2RlGAQgASgOMh0ubXluY2VyLlBsYXlsaXN0TWVyZ2VTeW5jTW9kZRI0Cg9jb25mbGljdF9wb2xpY3kYBSABKA4yGy5teW5jZXIuTWVyZ2VDb25mbGljdFBvbGljeRIkCgVvcmRlchgGIAEoDjIVLm15bmNlci5QbGF5bGlzdE9yZGVyIsABCgxTeW5jQmFzZWxpbmUSDwoHc3luY19pZBgBIAEoCRIOCgZydW5faWQYAiABKAkSLwoJcGxheWxpc3RzGAMgAygLMhwubXluY2VyLlN5bmNCYXNlbGluZVBsYXlsaXN0Ei4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIloKFFN5bmNCYXNlbGluZVBsYXlsaXN0EiUKCHBsYXlsaXN0GAEgASgLMhMubXluY2VyLk11c2ljU291cmNlEhsKBXNvbmdzGAIgAygLMgwubXluY2VyLlNvbmci2AEKDU1lcmdlQ29uZmxpY3QSIgoMcmVtb3ZlZF9zb25nGAEgASgLMgwubXluY2VyLlNvbmcSKQoMcmVtb3ZlZF9mcm9tGAIgASgLMhMubXluY2VyLk11c2ljU291cmNlEiAKCmFkZGVkX3NvbmcYAyABKAsyDC5teW5jZXIuU29uZxIlCghhZGRlZF90bxgEIAEoCzITLm15bmNlci5NdXNpY1NvdXJjZRIvCgpyZXNvbHV0aW9uGAUgASgOMhsubXluY2VyLk1lcmdlQ29uZmxpY3RQb2xpY3kimQQKBFN5bmMSCgoCaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIuCgpjcmVhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIqCgxvbmVfd2F5X3N5bmMYBSABKAsyEi5teW5jZXIuT25lV2F5U3luY0gAEjgKE3BsYXlsaXN0X21lcmdlX3N5bmMYBiABKAsyGS5teW5jZXIuUGxheWxpc3RNZXJnZVN5bmNIABIqCgxmYW5fb3V0X3N5bmMYCSABKAsyEi5teW5jZXIuRmFuT3V0U3luY0gAEiYKCHNjaGVkdWxlGAcgASgLMhQubXluY2VyLlN5bmNTY2hlZHVsZRIpCgxyZXRyeV9wb2xpY3kYCCABKAsyEy5teW5jZXIuUmV0cnlQb2xpY3kSLAoMZmlsdGVyX3J1bGVzGAogAygLMhYubXluY2VyLlN5bmNGaWx0ZXJSdWxlEjEKEG1hdGNoaW5nX3Byb2ZpbGUYCyABKAsyFy5teW5jZXIuTWF0Y2hpbmdQcm9maWxlEiAKBXBhdXNlGAwgASgLMhEubXluY2VyLlN5bmNQYXVzZRIcChRjb25zZWN1dGl2ZV9mYWlsdXJlcxgNIAEoBUIOCgxzeW5jX3ZhcmlhbnQiXQoJU3luY1BhdXNlEi0KCXBhdXNlZF9hdBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDgoGcmVhc29uGAIgASgJEhEKCWF1dG9tYXRpYxgDIAEoCCKMAQoPTWF0Y2hpbmdQcm9maWxlEhgKEGRlZHVwZV90aHJlc2hvbGQYASABKAESHAoUbWluX2FjY2VwdGFuY2Vfc2NvcmUYAiABKAESFAoMdGl0bGVfd2VpZ2h0GAMgASgBEhUKDWFydGlzdF93ZWlnaHQYBCABKAESFAoMYWxidW1fd2VpZ2h0GAUgASgBIqcBCg5TeW5jRmlsdGVyUnVsZRI0Cg9leGNsdWRlX2FydGlzdHMYASABKAsyGS5teW5jZXIuU3luY0ZpbHRlckFydGlzdHNIABIeChRleGNsdWRlX25hbWVfcGF0dGVybhgCIAEoCUgAEhsKEWFkZGVkX3dpdGhpbl9kYXlzGAMgASgFSAASGgoQZXhjbHVkZV9leHBsaWNpdBgEIAEoCEgAQgYKBHJ1bGUiKQoRU3luY0ZpbHRlckFydGlzdHMSFAoMYXJ0aXN0X25hbWVzGAEgAygJImEKC1JldHJ5UG9saWN5EhQKDG1heF9hdHRlbXB0cxgBIAEoBRIfChdpbml0aWFsX2JhY2tvZmZfc2Vjb25kcxgCIAEoBRIbChNtYXhfYmFja29mZl9zZWNvbmRzGAMgASgFIqABCgxTeW5jU2NoZWR1bGUSLgoIaW50ZXJ2YWwYASABKA4yHC5teW5jZXIuU3luY1NjaGVkdWxlSW50ZXJ2YWwSLwoLbmV4dF9ydW5fYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi8KC2xhc3RfcnVuX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKQBQoHU3luY1J1bhIPCgdzeW5jX2lkGAEgASgJEg4KBnJ1bl9pZBgCIAEoCRInCgtzeW5jX3N0YXR1cxgDIAEoDjISLm15bmNlci5TeW5jU3RhdHVzEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiUKD3VubWF0Y2hlZF9zb25ncxgGIAMoCzIMLm15bmNlci5Tb25nEhUKDWVycm9yX21lc3NhZ2UYByABKAkSIwoFcGhhc2UYCCABKA4yFC5teW5jZXIuU3luY1J1blBoYXNlEhwKFGRlc3RpbmF0aW9uX21vZGlmaWVkGAkgASgIEigKCGF0dGVtcHRzGAogAygLMhYubXluY2VyLlN5bmNSdW5BdHRlbXB0EikKCHByb2dyZXNzGAsgASgLMhcubXluY2VyLlN5bmNSdW5Qcm9ncmVzcxIzCg50YXJnZXRfcmVzdWx0cxgMIAMoCzIbLm15bmNlci5TeW5jUnVuVGFyZ2V0UmVzdWx0EigKCWNvbmZsaWN0cxgNIAMoCzIVLm15bmNlci5NZXJnZUNvbmZsaWN0EiEKBGtpbmQYDiABKA4yEy5teW5jZXIuU3luY1J1bktpbmQSJAoHcHJldmlldxgPIAEoCzITLm15bmNlci5TeW5jUHJldmlldxIkCg5leGNsdWRlZF9zb25ncxgQIAMoCzIMLm15bmNlci5Tb25nEjcKFmxvd19jb25maWRlbmNlX21hdGNoZXMYESADKAsyFy5teW5jZXIuU29uZ01hdGNoUmVzdWx0ImMKC1N5bmNQcmV2aWV3EioKB3RhcmdldHMYASADKAsyGS5teW5jZXIuU3luY1ByZXZpZXdUYXJnZXQSKAoHbWF0Y2hlcxgCIAMoCzIXLm15bmNlci5Tb25nTWF0Y2hSZXN1bHQitwEKEVN5bmNQcmV2aWV3VGFyZ2V0EiMKBnRhcmdldBgBIAEoCzITLm15bmNlci5NdXNpY1NvdXJjZRIXCg9jbGVhcnNfcGxheWxpc3QYAiABKAgSIgoMc29uZ3NfdG9fYWRkGAMgAygLMgwubXluY2VyLlNvbmcSJQoPc29uZ3NfdG9fcmVtb3ZlGAQgAygLMgwubXluY2VyLlNvbmcSGQoRcmVvcmRlcnNfcGxheWxpc3QYBSABKAgijQEKE1N5bmNSdW5UYXJnZXRSZXN1bHQSIwoGdGFyZ2V0GAEgASgLMhMubXluY2VyLk11c2ljU291cmNlEiUKD3VubWF0Y2hlZF9zb25ncxgCIAMoCzIMLm15bmNlci5Tb25nEhMKC2FkZGVkX3NvbmdzGAMgASgFEhUKDXJlbW92ZWRfc29uZ3MYBCABKAUioAEKD1N5bmNSdW5Qcm9ncmVzcxITCgt0b3RhbF9zb25ncxgBIAEoBRIVCg1tYXRjaGVkX3NvbmdzGAIgASgFEhcKD3VubWF0Y2hlZF9zb25ncxgDIAEoBRITCgthZGRlZF9zb25ncxgEIAEoBRIVCg1yZW1vdmVkX3NvbmdzGAUgASgFEhwKFGxvd19jb25maWRlbmNlX3NvbmdzGAYgASgFIt8BCgxTeW5jUnVuRXZlbnQSDgoGcnVuX2lkGAEgASgJEi4KCmNyZWF0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiUKBXBoYXNlGAMgASgOMhQubXluY2VyLlN5bmNSdW5QaGFzZUgAEjQKEXNvbmdfbWF0Y2hfcmVzdWx0GAQgASgLMhcubXluY2VyLlNvbmdNYXRjaFJlc3VsdEgAEikKCHByb2dyZXNzGAUgASgLMhcubXluY2VyLlN5bmNSdW5Qcm9ncmVzc0IHCgVldmVudCLhAQoPU29uZ01hdGNoUmVzdWx0EiEKC3NvdXJjZV9zb25nGAEgASgLMgwubXluY2VyLlNvbmcSDwoHbWF0Y2hlZBgCIAEoCBIbChNkZXN0aW5hdGlvbl9zb25nX2lkGAMgASgJEg0KBXNjb3JlGAQgASgBEiYKEGRlc3RpbmF0aW9uX3NvbmcYBSABKAsyDC5teW5jZXIuU29uZxIuCgpydW5uZXJfdXBzGAYgAygLMhoubXluY2VyLlNvbmdNYXRjaENhbmRpZGF0ZRIWCg5sb3dfY29uZmlkZW5jZRgHIAEoCCLoAQoOU3luY1J1bkF0dGVtcHQSFgoOYXR0ZW1wdF9udW1iZXIYASABKAUSLgoKc3RhcnRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLwoLZmluaXNoZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKDWVycm9yX21lc3NhZ2UYBCABKAkSEQoJcmV0cnlhYmxlGAUgASgIEjMKD25leHRfYXR0ZW1wdF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi3wEKCk9uZVdheVN5bmMSIwoGc291cmNlGAEgASgLMhMubXluY2VyLk11c2ljU291cmNlEigKC2Rlc3RpbmF0aW9uGAIgASgLMhMubXluY2VyLk11c2ljU291cmNlEhoKEm92ZXJ3cml0ZV9leGlzdGluZxgDIAEoCBIkCgRtb2RlGAQgASgOMhYubXluY2VyLk9uZVdheVN5bmNNb2RlEhoKEnJlbW92ZV9leHRyYV9zb25ncxgFIAEoCBIkCgVvcmRlchgGIAEoDjIVLm15bmNlci5QbGF5bGlzdE9yZGVyIuABCgpGYW5PdXRTeW5jEiMKBnNvdXJjZRgBIAEoCzITLm15bmNlci5NdXNpY1NvdXJjZRIpCgxkZXN0aW5hdGlvbnMYAiADKAsyEy5teW5jZXIuTXVzaWNTb3VyY2USGgoSb3ZlcndyaXRlX2V4aXN0aW5nGAMgASgIEiQKBG1vZGUYBCABKA4yFi5teW5jZXIuT25lV2F5U3luY01vZGUSGgoScmVtb3ZlX2V4dHJhX3NvbmdzGAUgASgIEiQKBW9yZGVyGAYgASgOMhUubXluY2VyLlBsYXlsaXN0T3JkZXIisQMKEUNyZWF0ZVN5bmNSZXF1ZXN0EioKDG9uZV93YXlfc3luYxgBIAEoCzISLm15bmNlci5PbmVXYXlTeW5jSAASOAoTcGxheWxpc3RfbWVyZ2Vfc3luYxgCIAEoCzIZLm15bmNlci5QbGF5bGlzdE1lcmdlU3luY0gAEioKDGZhbl9vdXRfc3luYxgGIAEoCzISLm15bmNlci5GYW5PdXRTeW5jSAASNwoRc2NoZWR1bGVfaW50ZXJ2YWwYAyABKA4yHC5teW5jZXIuU3luY1NjaGVkdWxlSW50ZXJ2YWwSKQoMcmV0cnlfcG9saWN5GAQgASgLMhMubXluY2VyLlJldHJ5UG9saWN5EjUKGG5ld19kZXN0aW5hdGlvbl9wbGF5bGlzdBgFIAEoCzITLm15bmNlci5OZXdQbGF5bGlzdBIsCgxmaWx0ZXJfcnVsZXMYByADKAsyFi5teW5jZXIuU3luY0ZpbHRlclJ1bGUSMQoQbWF0Y2hpbmdfcHJvZmlsZRgIIAEoCzIXLm15bmNlci5NYXRjaGluZ1Byb2ZpbGVCDgoMc3luY192YXJpYW50IkAKC05ld1BsYXlsaXN0EgwKBG5hbWUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSDgoGcHVibGljGAMgASgIIjAKEkNyZWF0ZVN5bmNSZXNwb25zZRIaCgRzeW5jGAEgASgLMgwubXluY2VyLlN5bmMiiwMKEVVwZGF0ZVN5bmNSZXF1ZXN0Eg8KB3N5bmNfaWQYASABKAkSKgoMb25lX3dheV9zeW5jGAIgASgLMhIubXluY2VyLk9uZVdheVN5bmNIABI4ChNwbGF5bGlzdF9tZXJnZV9zeW5jGAMgASgLMhkubXluY2VyLlBsYXlsaXN0TWVyZ2VTeW5jSAASKgoMZmFuX291dF9zeW5jGAQgASgLMhIubXluY2VyLkZhbk91dFN5bmNIABI3ChFzY2hlZHVsZV9pbnRlcnZhbBgFIAEoDjIcLm15bmNlci5TeW5jU2NoZWR1bGVJbnRlcnZhbBIpCgxyZXRyeV9wb2xpY3kYBiABKAsyEy5teW5jZXIuUmV0cnlQb2xpY3kSLAoMZmlsdGVyX3J1bGVzGAcgAygLMhYubXluY2VyLlN5bmNGaWx0ZXJSdWxlEjEKEG1hdGNoaW5nX3Byb2ZpbGUYCCABKAsyFy5teW5jZXIuTWF0Y2hpbmdQcm9maWxlQg4KDHN5bmNfdmFyaWFudCIwChJVcGRhdGVTeW5jUmVzcG9uc2USGgoEc3luYxgBIAEoCzIMLm15bmNlci5TeW5jIjMKEFBhdXNlU3luY1JlcXVlc3QSDwoHc3luY19pZBgBIAEoCRIOCgZyZWFzb24YAiABKAkiLwoRUGF1c2VTeW5jUmVzcG9uc2USGgoEc3luYxgBIAEoCzIMLm15bmNlci5TeW5jIiQKEVJlc3VtZVN5bmNSZXF1ZXN0Eg8KB3N5bmNfaWQYASABKAkiMAoSUmVzdW1lU3luY1Jlc3BvbnNlEhoKBHN5bmMYASABKAsyDC5teW5jZXIuU3luYyIkChFEZWxldGVTeW5jUmVxdWVzdBIPCgdzeW5jX2lkGAEgASgJIiUKEkRlbGV0ZVN5bmNSZXNwb25zZRIPCgdzeW5jX2lkGAEgASgJIhIKEExpc3RTeW5jc1JlcXVlc3QiMAoRTGlzdFN5bmNzUmVzcG9uc2USGwoFc3luY3MYASADKAsyDC5teW5jZXIuU3luYyIhCg5HZXRTeW5jUmVxdWVzdBIPCgdzeW5jX2lkGAEgASgJIi0KD0dldFN5bmNSZXNwb25zZRIaCgRzeW5jGAEgASgLMgwubXluY2VyLlN5bmMiMgoOUnVuU3luY1JlcXVlc3QSDwoHc3luY19pZBgBIAEoCRIPCgdkcnlfcnVuGAIgASgIIm0KD1J1blN5bmNSZXNwb25zZRIPCgdzeW5jX2lkGAEgASgJEiIKBnN0YXR1cxgCIAEoDjISLm15bmNlci5TeW5jU3RhdHVzEhUKDWVycm9yX21lc3NhZ2UYAyABKAkSDgoGcnVuX2lkGAQgASgJIhUKE0xpc3RTeW5jUnVuc1JlcXVlc3QiOgoUTGlzdFN5bmNSdW5zUmVzcG9uc2USIgoJc3luY19ydW5zGAEgAygLMg8ubXluY2VyLlN5bmNSdW4iJgoUQ2FuY2VsU3luY1J1blJlcXVlc3QSDgoGcnVuX2lkGAEgASgJIksKFUNhbmNlbFN5bmNSdW5SZXNwb25zZRIOCgZydW5faWQYASABKAkSIgoGc3RhdHVzGAIgASgOMhIubXluY2VyLlN5bmNTdGF0dXMiJQoTV2F0Y2hTeW5jUnVuUmVxdWVzdBIOCgZydW5faWQYASABKAkibAoUV2F0Y2hTeW5jUnVuUmVzcG9uc2USIwoIc3luY19ydW4YASABKAsyDy5teW5jZXIuU3luY1J1bkgAEiUKBWV2ZW50GAIgASgLMhQubXluY2VyLlN5bmNSdW5FdmVudEgAQggKBnVwZGF0ZSLEAQoQUGxheWxpc3RTbmFwc2hvdBIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEg8KB3N5bmNfaWQYAyABKAkSDgoGcnVuX2lkGAQgASgJEiUKCHBsYXlsaXN0GAUgASgLMhMubXluY2VyLk11c2ljU291cmNlEhsKBXNvbmdzGAYgAygLMgwubXluY2VyLlNvbmcSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiVQocTGlzdFBsYXlsaXN0U25hcHNob3RzUmVxdWVzdBIlCghwbGF5bGlzdBgBIAEoCzITLm15bmNlci5NdXNpY1NvdXJjZRIOCgZydW5faWQYAiABKAkiTAodTGlzdFBsYXlsaXN0U25hcHNob3RzUmVzcG9uc2USKwoJc25hcHNob3RzGAEgAygLMhgubXluY2VyLlBsYXlsaXN0U25hcHNob3QiTgocRGlmZlBsYXlsaXN0U25hcHNob3RzUmVxdWVzdBITCgtzbmFwc2hvdF9pZBgBIAEoCRIZChFvdGhlcl9zbmFwc2hvdF9pZBgCIAEoCSJnCh1EaWZmUGxheWxpc3RTbmFwc2hvdHNSZXNwb25zZRIhCgthZGRlZF9zb25ncxgBIAMoCzIMLm15bmNlci5Tb25nEiMKDXJlbW92ZWRfc29uZ3MYAiADKAsyDC5teW5jZXIuU29uZyI1Ch5SZXN0b3JlUGxheWxpc3RTbmFwc2hvdFJlcXVlc3QSEwoLc25hcHNob3RfaWQYASABKAkiTQofUmVzdG9yZVBsYXlsaXN0U25hcHNob3RSZXNwb25zZRIqCghzbmFwc2hvdBgBIAEoCzIYLm15bmNlci5QbGF5bGlzdFNuYXBzaG90IhUKE0dldFN5bmNHcmFwaFJlcXVlc3QiOAoUR2V0U3luY0dyYXBoUmVzcG9uc2USIAoFZ3JhcGgYASABKAsyES5teW5jZXIuU3luY0dyYXBoIn0KCVN5bmNHcmFwaBIiCgVub2RlcxgBIAMoCzITLm15bmNlci5NdXNpY1NvdXJjZRIkCgVlZGdlcxgCIAMoCzIVLm15bmNlci5TeW5jR3JhcGhFZGdlEiYKBmlzc3VlcxgDIAMoCzIWLm15bmNlci5TeW5jR3JhcGhJc3N1ZSKDAQoNU3luY0dyYXBoRWRnZRIPCgdzeW5jX2lkGAEgASgJEiMKBnNvdXJjZRgCIAEoCzITLm15bmNlci5NdXNpY1NvdXJjZRIoCgtkZXN0aW5hdGlvbhgDIAEoCzITLm15bmNlci5NdXNpY1NvdXJjZRISCgpvdmVyd3JpdGVzGAQgASgIIjMKDlN5bmNHcmFwaElzc3VlEhAKCHN5bmNfaWRzGAEgAygJEg8KB21lc3NhZ2UYAiABKAkioQEKFlRyYW5zZmVyTGlicmFyeVJlcXVlc3QSLQoRc291cmNlX2RhdGFzb3VyY2UYASABKA4yEi5teW5jZXIuRGF0YXNvdXJjZRIyChZkZXN0aW5hdGlvbl9kYXRhc291cmNlGAIgASgOMhIubXluY2VyLkRhdGFzb3VyY2USFAoMcGxheWxpc3RfaWRzGAMgAygJEg4KBnB1YmxpYxgEIAEoCCJEChdUcmFuc2ZlckxpYnJhcnlSZXNwb25zZRIpCgh0cmFuc2ZlchgBIAEoCzIXLm15bmNlci5MaWJyYXJ5VHJhbnNmZXIiMAoZR2V0TGlicmFyeVRyYW5zZmVyUmVxdWVzdBITCgt0cmFuc2Zlcl9pZBgBIAEoCSJHChpHZXRMaWJyYXJ5VHJhbnNmZXJSZXNwb25zZRIpCgh0cmFuc2ZlchgBIAEoCzIXLm15bmNlci5MaWJyYXJ5VHJhbnNmZXIiHQobTGlzdExpYnJhcnlUcmFuc2ZlcnNSZXF1ZXN0IkoKHExpc3RMaWJyYXJ5VHJhbnNmZXJzUmVzcG9uc2USKgoJdHJhbnNmZXJzGAEgAygLMhcubXluY2VyLkxpYnJhcnlUcmFuc2ZlciKTAwoPTGlicmFyeVRyYW5zZmVyEgoKAmlkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSLgoKY3JlYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLQoRc291cmNlX2RhdGFzb3VyY2UYBSABKA4yEi5teW5jZXIuRGF0YXNvdXJjZRIyChZkZXN0aW5hdGlvbl9kYXRhc291cmNlGAYgASgOMhIubXluY2VyLkRhdGFzb3VyY2USKgoFaXRlbXMYByADKAsyGy5teW5jZXIuTGlicmFyeVRyYW5zZmVySXRlbRIiCgZzdGF0dXMYCCABKA4yEi5teW5jZXIuU3luY1N0YXR1cxIpCghwcm9ncmVzcxgJIAEoCzIXLm15bmNlci5TeW5jUnVuUHJvZ3Jlc3MSJQoPdW5tYXRjaGVkX3NvbmdzGAogAygLMgwubXluY2VyLlNvbmci1QEKE0xpYnJhcnlUcmFuc2Zlckl0ZW0SIwoGc291cmNlGAEgASgLMhMubXluY2VyLk11c2ljU291cmNlEhMKC3NvdXJjZV9uYW1lGAIgASgJEigKC2Rlc3RpbmF0aW9uGAMgASgLMhMubXluY2VyLk11c2ljU291cmNlEg8KB3N5bmNfaWQYBCABKAkSDgoGcnVuX2lkGAUgASgJEhUKDWVycm9yX21lc3NhZ2UYBiABKAkSIgoGc3RhdHVzGAcgASgOMhIubXluY2VyLlN5bmNTdGF0dXMiUQobQ2xlYXJTb25nUmVzb2x1dGlvbnNSZXF1ZXN0EjIKFmRlc3RpbmF0aW9uX2RhdGFzb3VyY2UYASABKA4yEi5teW5jZXIuRGF0YXNvdXJjZSIeChxDbGVhclNvbmdSZXNvbHV0aW9uc1Jlc3BvbnNlIosBChJTZWFyY2hTb25nc1JlcXVlc3QSJgoKZGF0YXNvdXJjZRgBIAEoDjISLm15bmNlci5EYXRhc291cmNlEhoKBHNvbmcYAiABKAsyDC5teW5jZXIuU29uZxIxChBtYXRjaGluZ19wcm9maWxlGAMgASgLMhcubXluY2VyLk1hdGNoaW5nUHJvZmlsZSJFChNTZWFyY2hTb25nc1Jlc3BvbnNlEi4KCmNhbmRpZGF0ZXMYASADKAsyGi5teW5jZXIuU29uZ01hdGNoQ2FuZGlkYXRlIr8BChZTZXRTb25nT3ZlcnJpZGVSZXF1ZXN0EiEKC3NvdXJjZV9zb25nGAEgASgLMgwubXluY2VyLlNvbmcSMgoWZGVzdGluYXRpb25fZGF0YXNvdXJjZRgCIAEoDjISLm15bmNlci5EYXRhc291cmNlEiYKBGtpbmQYAyABKA4yGC5teW5jZXIuU29uZ092ZXJyaWRlS2luZBImChBkZXN0aW5hdGlvbl9zb25nGAQgASgLMgwubXluY2VyLlNvbmciRgoXU2V0U29uZ092ZXJyaWRlUmVzcG9uc2USKwoNc29uZ19vdmVycmlkZRgBIAEoCzIULm15bmNlci5Tb25nT3ZlcnJpZGUicgoZRGVsZXRlU29uZ092ZXJyaWRlUmVxdWVzdBIhCgtzb3VyY2Vfc29uZxgBIAEoCzIMLm15bmNlci5Tb25nEjIKFmRlc3RpbmF0aW9uX2RhdGFzb3VyY2UYAiABKA4yEi5teW5jZXIuRGF0YXNvdXJjZSIcChpEZWxldGVTb25nT3ZlcnJpZGVSZXNwb25zZSIaChhMaXN0U29uZ092ZXJyaWRlc1JlcXVlc3QiSQoZTGlzdFNvbmdPdmVycmlkZXNSZXNwb25zZRIsCg5zb25nX292ZXJyaWRlcxgBIAMoCzIULm15bmNlci5Tb25nT3ZlcnJpZGUqlQEKFVBsYXlsaXN0TWVyZ2VTeW5jTW9kZRIoCiRQTEFZTElTVF9NRVJHRV9TWU5DX01PREVfVU5TUEVDSUZJRUQQABIqCiZQTEFZTElTVF9NRVJHRV9TWU5DX01PREVfQklESVJFQ1RJT05BTBABEiYKIlBMQVlMSVNUX01FUkdFX1NZTkNfTU9ERV9USFJFRV9XQVkQAip+ChNNZXJnZUNvbmZsaWN0UG9saWN5EiUKIU1FUkdFX0NPTkZMSUNUX1BPTElDWV9VTlNQRUNJRklFRBAAEh4KGk1FUkdFX0NPTkZMSUNUX1BPTElDWV9LRUVQEAESIAocTUVSR0VfQ09ORkxJQ1RfUE9MSUNZX1JFTU9WRRACKs4BChRTeW5jU2NoZWR1bGVJbnRlcnZhbBImCiJTWU5DX1NDSEVEVUxFX0lOVEVSVkFMX1VOU1BFQ0lGSUVEEAASIQodU1lOQ19TQ0hFRFVMRV9JTlRFUlZBTF9IT1VSTFkQARIhCh1TWU5DX1NDSEVEVUxFX0lOVEVSVkFMX1dFRUtMWRACEiQKIFNZTkNfU0NIRURVTEVfSU5URVJWQUxfQklfV0VFS0xZEAMSIgoeU1lOQ19TQ0hFRFVMRV9JTlRFUlZBTF9NT05USExZEAQqRwoLU3luY1J1bktpbmQSHQoZU1lOQ19SVU5fS0lORF9VTlNQRUNJRklFRBAAEhkKFVNZTkNfUlVOX0tJTkRfUFJFVklFVxABKs8CCgxTeW5jUnVuUGhhc2USHgoaU1lOQ19SVU5fUEhBU0VfVU5TUEVDSUZJRUQQABIfChtTWU5DX1JVTl9QSEFTRV9GRVRDSF9TT1VSQ0UQARIcChhTWU5DX1JVTl9QSEFTRV9OT1JNQUxJWkUQAhIZChVTWU5DX1JVTl9QSEFTRV9TRUFSQ0gQAxIkCiBTWU5DX1JVTl9QSEFTRV9DTEVBUl9ERVNUSU5BVElPThAEEiUKIVNZTkNfUlVOX1BIQVNFX0FERF9UT19ERVNUSU5BVElPThAFEiQKIFNZTkNfUlVOX1BIQVNFX0ZFVENIX0RFU1RJTkFUSU9OEAYSKgomU1lOQ19SVU5fUEhBU0VfUkVNT1ZFX0ZST01fREVTVElOQVRJT04QBxImCiJTWU5DX1JVTl9QSEFTRV9SRU9SREVSX0RFU1RJTkFUSU9OEAgqmAEKDVBsYXlsaXN0T3JkZXISHgoaUExBWUxJU1RfT1JERVJfVU5TUEVDSUZJRUQQABIZChVQTEFZTElTVF9PUkRFUl9TT1VSQ0UQARIXChNQTEFZTElTVF9PUkRFUl9OQU1FEAISGQoVUExBWUxJU1RfT1JERVJfQVJUSVNUEAMSGAoUUExBWUxJU1RfT1JERVJfQUxCVU0QBCpPCg5PbmVXYXlTeW5jTW9kZRIhCh1PTkVfV0FZX1NZTkNfTU9ERV9VTlNQRUNJRklFRBAAEhoKFk9ORV9XQVlfU1lOQ19NT0RFX0RJRkYQASqpAQoKU3luY1N0YXR1cxIbChdTWU5DX1NUQVRVU19VTlNQRUNJRklFRBAAEhcKE1NZTkNfU1RBVFVTX1BFTkRJTkcQARIXChNTWU5DX1NUQVRVU19SVU5OSU5HEAISGQoVU1lOQ19TVEFUVVNfQ09NUExFVEVEEAMSFgoSU1lOQ19TVEFUVVNfRkFJTEVEEAQSGQoVU1lOQ19TVEFUVVNfQ0FOQ0VMTEVEEAUy0A4KC1N5bmNTZXJ2aWNlEkMKCkNyZWF0ZVN5bmMSGS5teW5jZXIuQ3JlYXRlU3luY1JlcXVlc3QaGi5teW5jZXIuQ3JlYXRlU3luY1Jlc3BvbnNlEkMKCkRlbGV0ZVN5bmMSGS5teW5jZXIuRGVsZXRlU3luY1JlcXVlc3QaGi5teW5jZXIuRGVsZXRlU3luY1Jlc3BvbnNlEkMKClVwZGF0ZVN5bmMSGS5teW5jZXIuVXBkYXRlU3luY1JlcXVlc3QaGi5teW5jZXIuVXBkYXRlU3luY1Jlc3BvbnNlEkAKCVBhdXNlU3luYxIYLm15bmNlci5QYXVzZVN5bmNSZXF1ZXN0GhkubXluY2VyLlBhdXNlU3luY1Jlc3BvbnNlEkMKClJlc3VtZVN5bmMSGS5teW5jZXIuUmVzdW1lU3luY1JlcXVlc3QaGi5teW5jZXIuUmVzdW1lU3luY1Jlc3BvbnNlEkAKCUxpc3RTeW5jcxIYLm15bmNlci5MaXN0U3luY3NSZXF1ZXN0GhkubXluY2VyLkxpc3RTeW5jc1Jlc3BvbnNlEjoKB0dldFN5bmMSFi5teW5jZXIuR2V0U3luY1JlcXVlc3QaFy5teW5jZXIuR2V0U3luY1Jlc3BvbnNlEjoKB1J1blN5bmMSFi5teW5jZXIuUnVuU3luY1JlcXVlc3QaFy5teW5jZXIuUnVuU3luY1Jlc3BvbnNlEkkKDExpc3RTeW5jUnVucxIbLm15bmNlci5MaXN0U3luY1J1bnNSZXF1ZXN0GhwubXluY2VyLkxpc3RTeW5jUnVuc1Jlc3BvbnNlEkwKDUNhbmNlbFN5bmNSdW4SHC5teW5jZXIuQ2FuY2VsU3luY1J1blJlcXVlc3QaHS5teW5jZXIuQ2FuY2VsU3luY1J1blJlc3BvbnNlEksKDFdhdGNoU3luY1J1bhIbLm15bmNlci5XYXRjaFN5bmNSdW5SZXF1ZXN0GhwubXluY2VyLldhdGNoU3luY1J1blJlc3BvbnNlMAESZAoVTGlzdFBsYXlsaXN0U25hcHNob3RzEiQubXluY2VyLkxpc3RQbGF5bGlzdFNuYXBzaG90c1JlcXVlc3QaJS5teW5jZXIuTGlzdFBsYXlsaXN0U25hcHNob3RzUmVzcG9uc2USZAoVRGlmZlBsYXlsaXN0U25hcHNob3RzEiQubXluY2VyLkRpZmZQbGF5bGlzdFNuYXBzaG90c1JlcXVlc3QaJS5teW5jZXIuRGlmZlBsYXlsaXN0U25hcHNob3RzUmVzcG9uc2USagoXUmVzdG9yZVBsYXlsaXN0U25hcHNob3QSJi5teW5jZXIuUmVzdG9yZVBsYXlsaXN0U25hcHNob3RSZXF1ZXN0GicubXluY2VyLlJlc3RvcmVQbGF5bGlzdFNuYXBzaG90UmVzcG9uc2USSQoMR2V0U3luY0dyYXBoEhsubXluY2VyLkdldFN5bmNHcmFwaFJlcXVlc3QaHC5teW5jZXIuR2V0U3luY0dyYXBoUmVzcG9uc2USUgoPVHJhbnNmZXJMaWJyYXJ5Eh4ubXluY2VyLlRyYW5zZmVyTGlicmFyeVJlcXVlc3QaHy5teW5jZXIuVHJhbnNmZXJMaWJyYXJ5UmVzcG9uc2USWwoSR2V0TGlicmFyeVRyYW5zZmVyEiEubXluY2VyLkdldExpYnJhcnlUcmFuc2ZlclJlcXVlc3QaIi5teW5jZXIuR2V0TGlicmFyeVRyYW5zZmVyUmVzcG9uc2USYQoUTGlzdExpYnJhcnlUcmFuc2ZlcnMSIy5teW5jZXIuTGlzdExpYnJhcnlUcmFuc2ZlcnNSZXF1ZXN0GiQubXluY2VyLkxpc3RMaWJyYXJ5VHJhbnNmZXJzUmVzcG9uc2USYQoUQ2xlYXJTb25nUmVzb2x1dGlvbnMSIy5teW5jZXIuQ2xlYXJTb25nUmVzb2x1dGlvbnNSZXF1ZXN0GiQubXluY2VyLkNsZWFyU29uZ1Jlc29sdXRpb25zUmVzcG9uc2USRgoLU2VhcmNoU29uZ3MSGi5teW5jZXIuU2VhcmNoU29uZ3NSZXF1ZXN0GhsubXluY2VyLlNlYXJjaFNvbmdzUmVzcG9uc2USUgoPU2V0U29uZ092ZXJyaWRlEh4ubXluY2VyLlNldFNvbmdPdmVycmlkZVJlcXVlc3QaHy5teW5jZXIuU2V0U29uZ092ZXJyaWRlUmVzcG9uc2USWwoSRGVsZXRlU29uZ092ZXJyaWRlEiEubXluY2VyLkRlbGV0ZVNvbmdPdmVycmlkZVJlcXVlc3QaIi5teW5jZXIuRGVsZXRlU29uZ092ZXJyaWRlUmVzcG9uc2USWAoRTGlzdFNvbmdPdmVycmlkZXMSIC5teW5jZXIuTGlzdFNvbmdPdmVycmlkZXNSZXF1ZXN0GiEubXluY2VyLkxpc3RTb25nT3ZlcnJpZGVzUmVzcG9uc2VCM1oxZ2l0aHViLmNvbS9oYW5zYmFsYS9teW5jZXIvcHJvdG8vbXluY2VyO215bmNlcl9wYmIGcHJvdG8z", [file_google_protobuf_timestamp, file_myncer_datasource, file_myncer_song]);
 
 /**
  * Representative of multiple sources -> one destination.
@@ -30,14 +30,40 @@ export type PlaylistMergeSync = Message<"myncer.PlaylistMergeSync"> & {
   sources: MusicSource[];
 
   /**
+   * Optional in bidirectional mode.
+   *
    * @generated from field: myncer.MusicSource destination = 2;
    */
   destination?: MusicSource;
 
   /**
+   * Not supported in bidirectional mode.
+   *
    * @generated from field: bool overwrite_existing = 3;
    */
   overwriteExisting: boolean;
+
+  /**
+   * @generated from field: myncer.PlaylistMergeSyncMode mode = 4;
+   */
+  mode: PlaylistMergeSyncMode;
+
+  /**
+   * How conflicting changes are resolved in three-way mode.
+   *
+   * @generated from field: myncer.MergeConflictPolicy conflict_policy = 5;
+   */
+  conflictPolicy: MergeConflictPolicy;
+
+  /**
+   * How the written playlists are ordered after each run.
+   * Source order follows the order of the sources, then the order of each source.
+   *
+   * next: 7
+   *
+   * @generated from field: myncer.PlaylistOrder order = 6;
+   */
+  order: PlaylistOrder;
 };
 
 /**
@@ -47,6 +73,112 @@ export type PlaylistMergeSync = Message<"myncer.PlaylistMergeSync"> & {
 export const PlaylistMergeSyncSchema: GenMessage<PlaylistMergeSync> = /*@__PURE__*/
   messageDesc(file_myncer_sync, 0);
 
+/**
+ * The songs of each playlist of a three-way merge sync after its last successful run.
+ *
+ * @generated from message myncer.SyncBaseline
+ */
+export type SyncBaseline = Message<"myncer.SyncBaseline"> & {
+  /**
+   * @generated from field: string sync_id = 1;
+   */
+  syncId: string;
+
+  /**
+   * The run that produced the baseline.
+   *
+   * @generated from field: string run_id = 2;
+   */
+  runId: string;
+
+  /**
+   * @generated from field: repeated myncer.SyncBaselinePlaylist playlists = 3;
+   */
+  playlists: SyncBaselinePlaylist[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 5;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message myncer.SyncBaseline.
+ * Use `create(SyncBaselineSchema)` to create a new message.
+ */
+export const SyncBaselineSchema: GenMessage<SyncBaseline> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 1);
+
+/**
+ * @generated from message myncer.SyncBaselinePlaylist
+ */
+export type SyncBaselinePlaylist = Message<"myncer.SyncBaselinePlaylist"> & {
+  /**
+   * @generated from field: myncer.MusicSource playlist = 1;
+   */
+  playlist?: MusicSource;
+
+  /**
+   * @generated from field: repeated myncer.Song songs = 2;
+   */
+  songs: Song[];
+};
+
+/**
+ * Describes the message myncer.SyncBaselinePlaylist.
+ * Use `create(SyncBaselinePlaylistSchema)` to create a new message.
+ */
+export const SyncBaselinePlaylistSchema: GenMessage<SyncBaselinePlaylist> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 2);
+
+/**
+ * @generated from message myncer.MergeConflict
+ */
+export type MergeConflict = Message<"myncer.MergeConflict"> & {
+  /**
+   * @generated from field: myncer.Song removed_song = 1;
+   */
+  removedSong?: Song;
+
+  /**
+   * The playlist the song was removed from.
+   *
+   * @generated from field: myncer.MusicSource removed_from = 2;
+   */
+  removedFrom?: MusicSource;
+
+  /**
+   * @generated from field: myncer.Song added_song = 3;
+   */
+  addedSong?: Song;
+
+  /**
+   * The playlist the song was added to.
+   *
+   * @generated from field: myncer.MusicSource added_to = 4;
+   */
+  addedTo?: MusicSource;
+
+  /**
+   * How the conflict was resolved.
+   *
+   * @generated from field: myncer.MergeConflictPolicy resolution = 5;
+   */
+  resolution: MergeConflictPolicy;
+};
+
+/**
+ * Describes the message myncer.MergeConflict.
+ * Use `create(MergeConflictSchema)` to create a new message.
+ */
+export const MergeConflictSchema: GenMessage<MergeConflict> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 3);
+
 /**
  * @generated from message myncer.Sync
  */
@@ -94,349 +226,2475 @@ export type Sync = Message<"myncer.Sync"> & {
      */
     value: PlaylistMergeSync;
     case: "playlistMergeSync";
+  } | {
+    /**
+     * @generated from field: myncer.FanOutSync fan_out_sync = 9;
+     */
+    value: FanOutSync;
+    case: "fanOutSync";
   } | { case: undefined; value?: undefined };
-};
 
-/**
- * Describes the message myncer.Sync.
- * Use `create(SyncSchema)` to create a new message.
- */
-export const SyncSchema: GenMessage<Sync> = /*@__PURE__*/
-  messageDesc(file_myncer_sync, 1);
-
-/**
- * @generated from message myncer.SyncRun
- */
-export type SyncRun = Message<"myncer.SyncRun"> & {
   /**
-   * The ID of the sync that was run.
+   * When set, the sync is run automatically by the server.
    *
-   * @generated from field: string sync_id = 1;
+   * @generated from field: myncer.SyncSchedule schedule = 7;
    */
-  syncId: string;
+  schedule?: SyncSchedule;
 
   /**
-   * The run id of this sync.
-   * This is expected to be randomly generated for each sync run.
+   * How failed runs of the sync are retried. Unset means failed runs are not retried.
    *
-   * @generated from field: string run_id = 2;
+   * @generated from field: myncer.RetryPolicy retry_policy = 8;
    */
-  runId: string;
+  retryPolicy?: RetryPolicy;
 
   /**
-   * The status of this sync run.
+   * Songs of the sources that any of the rules exclude are not synced.
    *
-   * @generated from field: myncer.SyncStatus sync_status = 3;
+   * @generated from field: repeated myncer.SyncFilterRule filter_rules = 10;
    */
-  syncStatus: SyncStatus;
+  filterRules: SyncFilterRule[];
 
   /**
-   * Metadata which is fetched from SQL (for it's ACID compliance).
+   * How songs are matched across datasources. Unset means the default profile.
    *
-   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   * @generated from field: myncer.MatchingProfile matching_profile = 11;
    */
-  createdAt?: Timestamp;
+  matchingProfile?: MatchingProfile;
 
   /**
-   * @generated from field: google.protobuf.Timestamp updated_at = 5;
+   * Set while the sync is paused. Paused syncs are neither run on their schedule nor on request.
+   * Dry runs are still allowed since they don't change any playlist.
+   *
+   * @generated from field: myncer.SyncPause pause = 12;
    */
-  updatedAt?: Timestamp;
+  pause?: SyncPause;
 
   /**
-   * Lista de canciones no encontradas durante la sincronización
+   * Number of runs in a row that failed. Previews are not counted.
+   * The sync is paused automatically once this reaches the limit.
    *
-   * @generated from field: repeated myncer.Song unmatched_songs = 6;
+   * next: 14
+   *
+   * @generated from field: int32 consecutive_failures = 13;
    */
-  unmatchedSongs: Song[];
+  consecutiveFailures: number;
+};
+
+/**
+ * Describes the message myncer.Sync.
+ * Use `create(SyncSchema)` to create a new message.
+ */
+export const SyncSchema: GenMessage<Sync> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 4);
+
+/**
+ * @generated from message myncer.SyncPause
+ */
+export type SyncPause = Message<"myncer.SyncPause"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp paused_at = 1;
+   */
+  pausedAt?: Timestamp;
 
   /**
-   * Mensaje de error detallado (ej. playlist eliminada)
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+
+  /**
+   * True if the sync was paused by the server after repeated failures rather than by the user.
    *
-   * @generated from field: string error_message = 7;
+   * @generated from field: bool automatic = 3;
    */
-  errorMessage: string;
+  automatic: boolean;
 };
 
 /**
- * Describes the message myncer.SyncRun.
- * Use `create(SyncRunSchema)` to create a new message.
+ * Describes the message myncer.SyncPause.
+ * Use `create(SyncPauseSchema)` to create a new message.
  */
-export const SyncRunSchema: GenMessage<SyncRun> = /*@__PURE__*/
-  messageDesc(file_myncer_sync, 2);
+export const SyncPauseSchema: GenMessage<SyncPause> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 5);
 
 /**
- * Representative of source -> destination.
+ * Thresholds and weights for matching songs across datasources.
+ * Scores range from 0 to 100. Fields left at zero use the defaults.
  *
- * @generated from message myncer.OneWaySync
+ * @generated from message myncer.MatchingProfile
  */
-export type OneWaySync = Message<"myncer.OneWaySync"> & {
+export type MatchingProfile = Message<"myncer.MatchingProfile"> & {
   /**
-   * @generated from field: myncer.MusicSource source = 1;
+   * Songs of a merge scoring at least this are considered duplicates. Defaults to 90.
+   *
+   * @generated from field: double dedupe_threshold = 1;
    */
-  source?: MusicSource;
+  dedupeThreshold: number;
 
   /**
-   * @generated from field: myncer.MusicSource destination = 2;
+   * Search results scoring below this are not accepted as a match. Defaults to 65 for Tidal,
+   * while other datasources accept their best result by default.
+   *
+   * @generated from field: double min_acceptance_score = 2;
    */
-  destination?: MusicSource;
+  minAcceptanceScore: number;
 
   /**
-   * When true, it overwrites the destination songs.
-   * If a song exists in source but not in destination, the song will be lost from destination.
+   * Relative weights of the title, artist and album similarity in the score.
+   * Default to 45, 45 and 10 when all are zero.
    *
-   * next: 4
-   *
-   * @generated from field: bool overwrite_existing = 3;
+   * @generated from field: double title_weight = 3;
    */
-  overwriteExisting: boolean;
+  titleWeight: number;
+
+  /**
+   * @generated from field: double artist_weight = 4;
+   */
+  artistWeight: number;
+
+  /**
+   * @generated from field: double album_weight = 5;
+   */
+  albumWeight: number;
 };
 
 /**
- * Describes the message myncer.OneWaySync.
- * Use `create(OneWaySyncSchema)` to create a new message.
+ * Describes the message myncer.MatchingProfile.
+ * Use `create(MatchingProfileSchema)` to create a new message.
  */
-export const OneWaySyncSchema: GenMessage<OneWaySync> = /*@__PURE__*/
-  messageDesc(file_myncer_sync, 3);
+export const MatchingProfileSchema: GenMessage<MatchingProfile> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 6);
 
 /**
- * @generated from message myncer.CreateSyncRequest
+ * Decides which songs of the sources a sync carries.
+ *
+ * @generated from message myncer.SyncFilterRule
  */
-export type CreateSyncRequest = Message<"myncer.CreateSyncRequest"> & {
+export type SyncFilterRule = Message<"myncer.SyncFilterRule"> & {
   /**
-   * The sync to create.
-   *
-   * @generated from oneof myncer.CreateSyncRequest.sync_variant
+   * @generated from oneof myncer.SyncFilterRule.rule
    */
-  syncVariant: {
+  rule: {
     /**
-     * @generated from field: myncer.OneWaySync one_way_sync = 1;
+     * Excludes songs by any of the artists. Artist names are compared ignoring case.
+     *
+     * @generated from field: myncer.SyncFilterArtists exclude_artists = 1;
      */
-    value: OneWaySync;
-    case: "oneWaySync";
+    value: SyncFilterArtists;
+    case: "excludeArtists";
   } | {
     /**
-     * @generated from field: myncer.PlaylistMergeSync playlist_merge_sync = 2;
+     * Excludes songs whose name matches the regular expression (RE2 syntax).
+     *
+     * @generated from field: string exclude_name_pattern = 2;
      */
-    value: PlaylistMergeSync;
-    case: "playlistMergeSync";
+    value: string;
+    case: "excludeNamePattern";
+  } | {
+    /**
+     * Excludes songs added to their playlist more than this many days before the run.
+     * Songs whose added time is unknown are kept.
+     *
+     * @generated from field: int32 added_within_days = 3;
+     */
+    value: number;
+    case: "addedWithinDays";
+  } | {
+    /**
+     * Excludes songs marked as explicit.
+     *
+     * @generated from field: bool exclude_explicit = 4;
+     */
+    value: boolean;
+    case: "excludeExplicit";
   } | { case: undefined; value?: undefined };
 };
 
 /**
- * Describes the message myncer.CreateSyncRequest.
- * Use `create(CreateSyncRequestSchema)` to create a new message.
+ * Describes the message myncer.SyncFilterRule.
+ * Use `create(SyncFilterRuleSchema)` to create a new message.
  */
-export const CreateSyncRequestSchema: GenMessage<CreateSyncRequest> = /*@__PURE__*/
-  messageDesc(file_myncer_sync, 4);
+export const SyncFilterRuleSchema: GenMessage<SyncFilterRule> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 7);
 
 /**
- * @generated from message myncer.CreateSyncResponse
+ * @generated from message myncer.SyncFilterArtists
  */
-export type CreateSyncResponse = Message<"myncer.CreateSyncResponse"> & {
+export type SyncFilterArtists = Message<"myncer.SyncFilterArtists"> & {
   /**
-   * The created sync.
+   * @generated from field: repeated string artist_names = 1;
+   */
+  artistNames: string[];
+};
+
+/**
+ * Describes the message myncer.SyncFilterArtists.
+ * Use `create(SyncFilterArtistsSchema)` to create a new message.
+ */
+export const SyncFilterArtistsSchema: GenMessage<SyncFilterArtists> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 8);
+
+/**
+ * @generated from message myncer.RetryPolicy
+ */
+export type RetryPolicy = Message<"myncer.RetryPolicy"> & {
+  /**
+   * Total number of attempts for a run, including the first one.
+   * 0 or 1 disables retries.
    *
-   * @generated from field: myncer.Sync sync = 1;
+   * @generated from field: int32 max_attempts = 1;
    */
-  sync?: Sync;
+  maxAttempts: number;
+
+  /**
+   * Wait before the first retry. Doubles with each further retry.
+   * Defaults to 30 seconds.
+   *
+   * @generated from field: int32 initial_backoff_seconds = 2;
+   */
+  initialBackoffSeconds: number;
+
+  /**
+   * Upper bound on the wait between retries. Defaults to an hour.
+   *
+   * @generated from field: int32 max_backoff_seconds = 3;
+   */
+  maxBackoffSeconds: number;
 };
 
 /**
- * Describes the message myncer.CreateSyncResponse.
- * Use `create(CreateSyncResponseSchema)` to create a new message.
+ * Describes the message myncer.RetryPolicy.
+ * Use `create(RetryPolicySchema)` to create a new message.
  */
-export const CreateSyncResponseSchema: GenMessage<CreateSyncResponse> = /*@__PURE__*/
-  messageDesc(file_myncer_sync, 5);
+export const RetryPolicySchema: GenMessage<RetryPolicy> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 9);
 
 /**
- * @generated from message myncer.DeleteSyncRequest
+ * @generated from message myncer.SyncSchedule
  */
-export type DeleteSyncRequest = Message<"myncer.DeleteSyncRequest"> & {
+export type SyncSchedule = Message<"myncer.SyncSchedule"> & {
+  /**
+   * @generated from field: myncer.SyncScheduleInterval interval = 1;
+   */
+  interval: SyncScheduleInterval;
+
+  /**
+   * When the scheduler will next run the sync.
+   * Computed by the server, unset for manual syncs.
+   *
+   * @generated from field: google.protobuf.Timestamp next_run_at = 2;
+   */
+  nextRunAt?: Timestamp;
+
+  /**
+   * When the scheduler last started a run of the sync.
+   *
+   * @generated from field: google.protobuf.Timestamp last_run_at = 3;
+   */
+  lastRunAt?: Timestamp;
+};
+
+/**
+ * Describes the message myncer.SyncSchedule.
+ * Use `create(SyncScheduleSchema)` to create a new message.
+ */
+export const SyncScheduleSchema: GenMessage<SyncSchedule> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 10);
+
+/**
+ * @generated from message myncer.SyncRun
+ */
+export type SyncRun = Message<"myncer.SyncRun"> & {
+  /**
+   * The ID of the sync that was run.
+   *
+   * @generated from field: string sync_id = 1;
+   */
+  syncId: string;
+
+  /**
+   * The run id of this sync.
+   * This is expected to be randomly generated for each sync run.
+   *
+   * @generated from field: string run_id = 2;
+   */
+  runId: string;
+
+  /**
+   * The status of this sync run.
+   *
+   * @generated from field: myncer.SyncStatus sync_status = 3;
+   */
+  syncStatus: SyncStatus;
+
+  /**
+   * Metadata which is fetched from SQL (for it's ACID compliance).
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 5;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * Lista de canciones no encontradas durante la sincronización
+   *
+   * @generated from field: repeated myncer.Song unmatched_songs = 6;
+   */
+  unmatchedSongs: Song[];
+
+  /**
+   * Mensaje de error detallado (ej. playlist eliminada)
+   *
+   * @generated from field: string error_message = 7;
+   */
+  errorMessage: string;
+
+  /**
+   * The last phase the run reached.
+   *
+   * @generated from field: myncer.SyncRunPhase phase = 8;
+   */
+  phase: SyncRunPhase;
+
+  /**
+   * Whether the run started making changes to a destination playlist.
+   *
+   * @generated from field: bool destination_modified = 9;
+   */
+  destinationModified: boolean;
+
+  /**
+   * Every attempt at executing this run, oldest first.
+   *
+   * @generated from field: repeated myncer.SyncRunAttempt attempts = 10;
+   */
+  attempts: SyncRunAttempt[];
+
+  /**
+   * Progress of the latest attempt.
+   *
+   * @generated from field: myncer.SyncRunProgress progress = 11;
+   */
+  progress?: SyncRunProgress;
+
+  /**
+   * Per playlist outcome for syncs that write to several playlists.
+   *
+   * @generated from field: repeated myncer.SyncRunTargetResult target_results = 12;
+   */
+  targetResults: SyncRunTargetResult[];
+
+  /**
+   * Conflicting changes found by a three-way merge.
+   *
+   * @generated from field: repeated myncer.MergeConflict conflicts = 13;
+   */
+  conflicts: MergeConflict[];
+
+  /**
+   * @generated from field: myncer.SyncRunKind kind = 14;
+   */
+  kind: SyncRunKind;
+
+  /**
+   * The planned changes of a preview run.
+   *
+   * @generated from field: myncer.SyncPreview preview = 15;
+   */
+  preview?: SyncPreview;
+
+  /**
+   * Source songs left out by the filter rules of the sync or marked to never be synced.
+   *
+   * @generated from field: repeated myncer.Song excluded_songs = 16;
+   */
+  excludedSongs: Song[];
+
+  /**
+   * Matches that are probably wrong, for the user to review.
+   *
+   * next: 18
+   *
+   * @generated from field: repeated myncer.SongMatchResult low_confidence_matches = 17;
+   */
+  lowConfidenceMatches: SongMatchResult[];
+};
+
+/**
+ * Describes the message myncer.SyncRun.
+ * Use `create(SyncRunSchema)` to create a new message.
+ */
+export const SyncRunSchema: GenMessage<SyncRun> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 11);
+
+/**
+ * The changes a sync would make.
+ *
+ * @generated from message myncer.SyncPreview
+ */
+export type SyncPreview = Message<"myncer.SyncPreview"> & {
+  /**
+   * The changes planned for each playlist the sync writes to.
+   *
+   * @generated from field: repeated myncer.SyncPreviewTarget targets = 1;
+   */
+  targets: SyncPreviewTarget[];
+
+  /**
+   * How each source song was matched on the destination datasource.
+   *
+   * @generated from field: repeated myncer.SongMatchResult matches = 2;
+   */
+  matches: SongMatchResult[];
+};
+
+/**
+ * Describes the message myncer.SyncPreview.
+ * Use `create(SyncPreviewSchema)` to create a new message.
+ */
+export const SyncPreviewSchema: GenMessage<SyncPreview> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 12);
+
+/**
+ * @generated from message myncer.SyncPreviewTarget
+ */
+export type SyncPreviewTarget = Message<"myncer.SyncPreviewTarget"> & {
+  /**
+   * @generated from field: myncer.MusicSource target = 1;
+   */
+  target?: MusicSource;
+
+  /**
+   * Whether every song would be removed before adding songs.
+   *
+   * @generated from field: bool clears_playlist = 2;
+   */
+  clearsPlaylist: boolean;
+
+  /**
+   * @generated from field: repeated myncer.Song songs_to_add = 3;
+   */
+  songsToAdd: Song[];
+
+  /**
+   * @generated from field: repeated myncer.Song songs_to_remove = 4;
+   */
+  songsToRemove: Song[];
+
+  /**
+   * Whether the songs would be reordered.
+   *
+   * @generated from field: bool reorders_playlist = 5;
+   */
+  reordersPlaylist: boolean;
+};
+
+/**
+ * Describes the message myncer.SyncPreviewTarget.
+ * Use `create(SyncPreviewTargetSchema)` to create a new message.
+ */
+export const SyncPreviewTargetSchema: GenMessage<SyncPreviewTarget> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 13);
+
+/**
+ * The outcome of a sync run for one of the playlists it writes to.
+ *
+ * @generated from message myncer.SyncRunTargetResult
+ */
+export type SyncRunTargetResult = Message<"myncer.SyncRunTargetResult"> & {
+  /**
+   * @generated from field: myncer.MusicSource target = 1;
+   */
+  target?: MusicSource;
+
+  /**
+   * Songs that could not be found on the target's datasource.
+   *
+   * @generated from field: repeated myncer.Song unmatched_songs = 2;
+   */
+  unmatchedSongs: Song[];
+
+  /**
+   * @generated from field: int32 added_songs = 3;
+   */
+  addedSongs: number;
+
+  /**
+   * @generated from field: int32 removed_songs = 4;
+   */
+  removedSongs: number;
+};
+
+/**
+ * Describes the message myncer.SyncRunTargetResult.
+ * Use `create(SyncRunTargetResultSchema)` to create a new message.
+ */
+export const SyncRunTargetResultSchema: GenMessage<SyncRunTargetResult> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 14);
+
+/**
+ * @generated from message myncer.SyncRunProgress
+ */
+export type SyncRunProgress = Message<"myncer.SyncRunProgress"> & {
+  /**
+   * Number of songs to search for on the destination datasource.
+   *
+   * @generated from field: int32 total_songs = 1;
+   */
+  totalSongs: number;
+
+  /**
+   * @generated from field: int32 matched_songs = 2;
+   */
+  matchedSongs: number;
+
+  /**
+   * @generated from field: int32 unmatched_songs = 3;
+   */
+  unmatchedSongs: number;
+
+  /**
+   * Number of songs added to the destination playlist.
+   *
+   * @generated from field: int32 added_songs = 4;
+   */
+  addedSongs: number;
+
+  /**
+   * Number of songs removed from the destination playlist.
+   *
+   * @generated from field: int32 removed_songs = 5;
+   */
+  removedSongs: number;
+
+  /**
+   * Number of matched songs that are probably wrong.
+   *
+   * @generated from field: int32 low_confidence_songs = 6;
+   */
+  lowConfidenceSongs: number;
+};
+
+/**
+ * Describes the message myncer.SyncRunProgress.
+ * Use `create(SyncRunProgressSchema)` to create a new message.
+ */
+export const SyncRunProgressSchema: GenMessage<SyncRunProgress> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 15);
+
+/**
+ * Something that happened while a sync run was running.
+ *
+ * @generated from message myncer.SyncRunEvent
+ */
+export type SyncRunEvent = Message<"myncer.SyncRunEvent"> & {
+  /**
+   * @generated from field: string run_id = 1;
+   */
+  runId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 2;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from oneof myncer.SyncRunEvent.event
+   */
+  event: {
+    /**
+     * The run entered a new phase.
+     *
+     * @generated from field: myncer.SyncRunPhase phase = 3;
+     */
+    value: SyncRunPhase;
+    case: "phase";
+  } | {
+    /**
+     * A song was searched for on the destination datasource.
+     *
+     * @generated from field: myncer.SongMatchResult song_match_result = 4;
+     */
+    value: SongMatchResult;
+    case: "songMatchResult";
+  } | { case: undefined; value?: undefined };
+
+  /**
+   * Progress of the run after the event.
+   *
+   * next: 6
+   *
+   * @generated from field: myncer.SyncRunProgress progress = 5;
+   */
+  progress?: SyncRunProgress;
+};
+
+/**
+ * Describes the message myncer.SyncRunEvent.
+ * Use `create(SyncRunEventSchema)` to create a new message.
+ */
+export const SyncRunEventSchema: GenMessage<SyncRunEvent> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 16);
+
+/**
+ * @generated from message myncer.SongMatchResult
+ */
+export type SongMatchResult = Message<"myncer.SongMatchResult"> & {
+  /**
+   * The song as found in the source playlist.
+   *
+   * @generated from field: myncer.Song source_song = 1;
+   */
+  sourceSong?: Song;
+
+  /**
+   * @generated from field: bool matched = 2;
+   */
+  matched: boolean;
+
+  /**
+   * The id of the matched song on the destination datasource. Empty if unmatched.
+   *
+   * @generated from field: string destination_song_id = 3;
+   */
+  destinationSongId: string;
+
+  /**
+   * How similar the matched song is to the source song, from 0 to 100.
+   *
+   * @generated from field: double score = 4;
+   */
+  score: number;
+
+  /**
+   * Unset if unmatched.
+   *
+   * @generated from field: myncer.Song destination_song = 5;
+   */
+  destinationSong?: Song;
+
+  /**
+   * Other songs the song could have been matched to, best first. For unmatched songs these are the
+   * songs the matching profile did not accept.
+   *
+   * @generated from field: repeated myncer.SongMatchCandidate runner_ups = 6;
+   */
+  runnerUps: SongMatchCandidate[];
+
+  /**
+   * Whether the match scored below the confident match score of the matching profile, meaning it
+   * is probably wrong. Songs pinned by the user are never low confidence.
+   *
+   * @generated from field: bool low_confidence = 7;
+   */
+  lowConfidence: boolean;
+};
+
+/**
+ * Describes the message myncer.SongMatchResult.
+ * Use `create(SongMatchResultSchema)` to create a new message.
+ */
+export const SongMatchResultSchema: GenMessage<SongMatchResult> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 17);
+
+/**
+ * @generated from message myncer.SyncRunAttempt
+ */
+export type SyncRunAttempt = Message<"myncer.SyncRunAttempt"> & {
+  /**
+   * 1-indexed.
+   *
+   * @generated from field: int32 attempt_number = 1;
+   */
+  attemptNumber: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at = 2;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp finished_at = 3;
+   */
+  finishedAt?: Timestamp;
+
+  /**
+   * Empty if the attempt succeeded.
+   *
+   * @generated from field: string error_message = 4;
+   */
+  errorMessage: string;
+
+  /**
+   * Whether the error was considered transient (e.g. rate limits, server errors).
+   *
+   * @generated from field: bool retryable = 5;
+   */
+  retryable: boolean;
+
+  /**
+   * When the next attempt is scheduled, if any.
+   *
+   * @generated from field: google.protobuf.Timestamp next_attempt_at = 6;
+   */
+  nextAttemptAt?: Timestamp;
+};
+
+/**
+ * Describes the message myncer.SyncRunAttempt.
+ * Use `create(SyncRunAttemptSchema)` to create a new message.
+ */
+export const SyncRunAttemptSchema: GenMessage<SyncRunAttempt> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 18);
+
+/**
+ * Representative of source -> destination.
+ *
+ * @generated from message myncer.OneWaySync
+ */
+export type OneWaySync = Message<"myncer.OneWaySync"> & {
+  /**
+   * @generated from field: myncer.MusicSource source = 1;
+   */
+  source?: MusicSource;
+
+  /**
+   * @generated from field: myncer.MusicSource destination = 2;
+   */
+  destination?: MusicSource;
+
+  /**
+   * When true, it overwrites the destination songs.
+   * If a song exists in source but not in destination, the song will be lost from destination.
+   * Ignored in diff mode.
+   *
+   * @generated from field: bool overwrite_existing = 3;
+   */
+  overwriteExisting: boolean;
+
+  /**
+   * @generated from field: myncer.OneWaySyncMode mode = 4;
+   */
+  mode: OneWaySyncMode;
+
+  /**
+   * Diff mode only. When true, songs in the destination that are not in the source are removed.
+   *
+   * @generated from field: bool remove_extra_songs = 5;
+   */
+  removeExtraSongs: boolean;
+
+  /**
+   * How the destination is ordered after each run.
+   *
+   * next: 7
+   *
+   * @generated from field: myncer.PlaylistOrder order = 6;
+   */
+  order: PlaylistOrder;
+};
+
+/**
+ * Describes the message myncer.OneWaySync.
+ * Use `create(OneWaySyncSchema)` to create a new message.
+ */
+export const OneWaySyncSchema: GenMessage<OneWaySync> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 19);
+
+/**
+ * Representative of one source -> multiple destinations.
+ * Behaves like a one-way sync to each destination, but the source is only fetched and normalized
+ * once.
+ *
+ * @generated from message myncer.FanOutSync
+ */
+export type FanOutSync = Message<"myncer.FanOutSync"> & {
+  /**
+   * @generated from field: myncer.MusicSource source = 1;
+   */
+  source?: MusicSource;
+
+  /**
+   * @generated from field: repeated myncer.MusicSource destinations = 2;
+   */
+  destinations: MusicSource[];
+
+  /**
+   * Applies to every destination, see OneWaySync.
+   *
+   * @generated from field: bool overwrite_existing = 3;
+   */
+  overwriteExisting: boolean;
+
+  /**
+   * @generated from field: myncer.OneWaySyncMode mode = 4;
+   */
+  mode: OneWaySyncMode;
+
+  /**
+   * @generated from field: bool remove_extra_songs = 5;
+   */
+  removeExtraSongs: boolean;
+
+  /**
+   * next: 7
+   *
+   * @generated from field: myncer.PlaylistOrder order = 6;
+   */
+  order: PlaylistOrder;
+};
+
+/**
+ * Describes the message myncer.FanOutSync.
+ * Use `create(FanOutSyncSchema)` to create a new message.
+ */
+export const FanOutSyncSchema: GenMessage<FanOutSync> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 20);
+
+/**
+ * @generated from message myncer.CreateSyncRequest
+ */
+export type CreateSyncRequest = Message<"myncer.CreateSyncRequest"> & {
+  /**
+   * The sync to create.
+   *
+   * @generated from oneof myncer.CreateSyncRequest.sync_variant
+   */
+  syncVariant: {
+    /**
+     * @generated from field: myncer.OneWaySync one_way_sync = 1;
+     */
+    value: OneWaySync;
+    case: "oneWaySync";
+  } | {
+    /**
+     * @generated from field: myncer.PlaylistMergeSync playlist_merge_sync = 2;
+     */
+    value: PlaylistMergeSync;
+    case: "playlistMergeSync";
+  } | {
+    /**
+     * @generated from field: myncer.FanOutSync fan_out_sync = 6;
+     */
+    value: FanOutSync;
+    case: "fanOutSync";
+  } | { case: undefined; value?: undefined };
+
+  /**
+   * How often the sync should run automatically.
+   * Leave unspecified for syncs that are only run manually.
+   *
+   * @generated from field: myncer.SyncScheduleInterval schedule_interval = 3;
+   */
+  scheduleInterval: SyncScheduleInterval;
+
+  /**
+   * How failed runs are retried. Leave unset to never retry.
+   *
+   * @generated from field: myncer.RetryPolicy retry_policy = 4;
+   */
+  retryPolicy?: RetryPolicy;
+
+  /**
+   * Creates a new playlist on the datasource of the sync's destination and uses it as the destination.
+   * The destination playlist id must be left empty.
+   *
+   * @generated from field: myncer.NewPlaylist new_destination_playlist = 5;
+   */
+  newDestinationPlaylist?: NewPlaylist;
+
+  /**
+   * Which songs of the sources the sync carries. Leave empty to sync every song.
+   *
+   * @generated from field: repeated myncer.SyncFilterRule filter_rules = 7;
+   */
+  filterRules: SyncFilterRule[];
+
+  /**
+   * How songs are matched across datasources. Leave unset for the default profile.
+   *
+   * @generated from field: myncer.MatchingProfile matching_profile = 8;
+   */
+  matchingProfile?: MatchingProfile;
+};
+
+/**
+ * Describes the message myncer.CreateSyncRequest.
+ * Use `create(CreateSyncRequestSchema)` to create a new message.
+ */
+export const CreateSyncRequestSchema: GenMessage<CreateSyncRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 21);
+
+/**
+ * @generated from message myncer.NewPlaylist
+ */
+export type NewPlaylist = Message<"myncer.NewPlaylist"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * @generated from field: bool public = 3;
+   */
+  public: boolean;
+};
+
+/**
+ * Describes the message myncer.NewPlaylist.
+ * Use `create(NewPlaylistSchema)` to create a new message.
+ */
+export const NewPlaylistSchema: GenMessage<NewPlaylist> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 22);
+
+/**
+ * @generated from message myncer.CreateSyncResponse
+ */
+export type CreateSyncResponse = Message<"myncer.CreateSyncResponse"> & {
+  /**
+   * The created sync.
+   *
+   * @generated from field: myncer.Sync sync = 1;
+   */
+  sync?: Sync;
+};
+
+/**
+ * Describes the message myncer.CreateSyncResponse.
+ * Use `create(CreateSyncResponseSchema)` to create a new message.
+ */
+export const CreateSyncResponseSchema: GenMessage<CreateSyncResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 23);
+
+/**
+ * @generated from message myncer.UpdateSyncRequest
+ */
+export type UpdateSyncRequest = Message<"myncer.UpdateSyncRequest"> & {
+  /**
+   * The ID of the sync to update.
+   *
+   * @generated from field: string sync_id = 1;
+   */
+  syncId: string;
+
+  /**
+   * The new settings of the sync, see CreateSyncRequest.
+   * Every setting is replaced, so unchanged settings must be passed as they are.
+   *
+   * @generated from oneof myncer.UpdateSyncRequest.sync_variant
+   */
+  syncVariant: {
+    /**
+     * @generated from field: myncer.OneWaySync one_way_sync = 2;
+     */
+    value: OneWaySync;
+    case: "oneWaySync";
+  } | {
+    /**
+     * @generated from field: myncer.PlaylistMergeSync playlist_merge_sync = 3;
+     */
+    value: PlaylistMergeSync;
+    case: "playlistMergeSync";
+  } | {
+    /**
+     * @generated from field: myncer.FanOutSync fan_out_sync = 4;
+     */
+    value: FanOutSync;
+    case: "fanOutSync";
+  } | { case: undefined; value?: undefined };
+
+  /**
+   * The next scheduled run is kept unless the interval changes.
+   *
+   * @generated from field: myncer.SyncScheduleInterval schedule_interval = 5;
+   */
+  scheduleInterval: SyncScheduleInterval;
+
+  /**
+   * @generated from field: myncer.RetryPolicy retry_policy = 6;
+   */
+  retryPolicy?: RetryPolicy;
+
+  /**
+   * @generated from field: repeated myncer.SyncFilterRule filter_rules = 7;
+   */
+  filterRules: SyncFilterRule[];
+
+  /**
+   * @generated from field: myncer.MatchingProfile matching_profile = 8;
+   */
+  matchingProfile?: MatchingProfile;
+};
+
+/**
+ * Describes the message myncer.UpdateSyncRequest.
+ * Use `create(UpdateSyncRequestSchema)` to create a new message.
+ */
+export const UpdateSyncRequestSchema: GenMessage<UpdateSyncRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 24);
+
+/**
+ * @generated from message myncer.UpdateSyncResponse
+ */
+export type UpdateSyncResponse = Message<"myncer.UpdateSyncResponse"> & {
+  /**
+   * The updated sync.
+   *
+   * @generated from field: myncer.Sync sync = 1;
+   */
+  sync?: Sync;
+};
+
+/**
+ * Describes the message myncer.UpdateSyncResponse.
+ * Use `create(UpdateSyncResponseSchema)` to create a new message.
+ */
+export const UpdateSyncResponseSchema: GenMessage<UpdateSyncResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 25);
+
+/**
+ * @generated from message myncer.PauseSyncRequest
+ */
+export type PauseSyncRequest = Message<"myncer.PauseSyncRequest"> & {
+  /**
+   * @generated from field: string sync_id = 1;
+   */
+  syncId: string;
+
+  /**
+   * Why the sync is paused, e.g. the playlist is being reorganized by hand.
+   *
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message myncer.PauseSyncRequest.
+ * Use `create(PauseSyncRequestSchema)` to create a new message.
+ */
+export const PauseSyncRequestSchema: GenMessage<PauseSyncRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 26);
+
+/**
+ * @generated from message myncer.PauseSyncResponse
+ */
+export type PauseSyncResponse = Message<"myncer.PauseSyncResponse"> & {
+  /**
+   * The paused sync.
+   *
+   * @generated from field: myncer.Sync sync = 1;
+   */
+  sync?: Sync;
+};
+
+/**
+ * Describes the message myncer.PauseSyncResponse.
+ * Use `create(PauseSyncResponseSchema)` to create a new message.
+ */
+export const PauseSyncResponseSchema: GenMessage<PauseSyncResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 27);
+
+/**
+ * @generated from message myncer.ResumeSyncRequest
+ */
+export type ResumeSyncRequest = Message<"myncer.ResumeSyncRequest"> & {
+  /**
+   * @generated from field: string sync_id = 1;
+   */
+  syncId: string;
+};
+
+/**
+ * Describes the message myncer.ResumeSyncRequest.
+ * Use `create(ResumeSyncRequestSchema)` to create a new message.
+ */
+export const ResumeSyncRequestSchema: GenMessage<ResumeSyncRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 28);
+
+/**
+ * @generated from message myncer.ResumeSyncResponse
+ */
+export type ResumeSyncResponse = Message<"myncer.ResumeSyncResponse"> & {
+  /**
+   * The resumed sync.
+   *
+   * @generated from field: myncer.Sync sync = 1;
+   */
+  sync?: Sync;
+};
+
+/**
+ * Describes the message myncer.ResumeSyncResponse.
+ * Use `create(ResumeSyncResponseSchema)` to create a new message.
+ */
+export const ResumeSyncResponseSchema: GenMessage<ResumeSyncResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 29);
+
+/**
+ * @generated from message myncer.DeleteSyncRequest
+ */
+export type DeleteSyncRequest = Message<"myncer.DeleteSyncRequest"> & {
+  /**
+   * The ID of the sync to delete.
+   *
+   * @generated from field: string sync_id = 1;
+   */
+  syncId: string;
+};
+
+/**
+ * Describes the message myncer.DeleteSyncRequest.
+ * Use `create(DeleteSyncRequestSchema)` to create a new message.
+ */
+export const DeleteSyncRequestSchema: GenMessage<DeleteSyncRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 30);
+
+/**
+ * @generated from message myncer.DeleteSyncResponse
+ */
+export type DeleteSyncResponse = Message<"myncer.DeleteSyncResponse"> & {
+  /**
+   * The ID of the deleted sync.
+   *
+   * @generated from field: string sync_id = 1;
+   */
+  syncId: string;
+};
+
+/**
+ * Describes the message myncer.DeleteSyncResponse.
+ * Use `create(DeleteSyncResponseSchema)` to create a new message.
+ */
+export const DeleteSyncResponseSchema: GenMessage<DeleteSyncResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 31);
+
+/**
+ * @generated from message myncer.ListSyncsRequest
+ */
+export type ListSyncsRequest = Message<"myncer.ListSyncsRequest"> & {
+};
+
+/**
+ * Describes the message myncer.ListSyncsRequest.
+ * Use `create(ListSyncsRequestSchema)` to create a new message.
+ */
+export const ListSyncsRequestSchema: GenMessage<ListSyncsRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 32);
+
+/**
+ * @generated from message myncer.ListSyncsResponse
+ */
+export type ListSyncsResponse = Message<"myncer.ListSyncsResponse"> & {
+  /**
+   * List of syncs for the current user.
+   *
+   * @generated from field: repeated myncer.Sync syncs = 1;
+   */
+  syncs: Sync[];
+};
+
+/**
+ * Describes the message myncer.ListSyncsResponse.
+ * Use `create(ListSyncsResponseSchema)` to create a new message.
+ */
+export const ListSyncsResponseSchema: GenMessage<ListSyncsResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 33);
+
+/**
+ * @generated from message myncer.GetSyncRequest
+ */
+export type GetSyncRequest = Message<"myncer.GetSyncRequest"> & {
+  /**
+   * The ID of the sync to get.
+   *
+   * @generated from field: string sync_id = 1;
+   */
+  syncId: string;
+};
+
+/**
+ * Describes the message myncer.GetSyncRequest.
+ * Use `create(GetSyncRequestSchema)` to create a new message.
+ */
+export const GetSyncRequestSchema: GenMessage<GetSyncRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 34);
+
+/**
+ * @generated from message myncer.GetSyncResponse
+ */
+export type GetSyncResponse = Message<"myncer.GetSyncResponse"> & {
+  /**
+   * The requested sync.
+   *
+   * @generated from field: myncer.Sync sync = 1;
+   */
+  sync?: Sync;
+};
+
+/**
+ * Describes the message myncer.GetSyncResponse.
+ * Use `create(GetSyncResponseSchema)` to create a new message.
+ */
+export const GetSyncResponseSchema: GenMessage<GetSyncResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 35);
+
+/**
+ * @generated from message myncer.RunSyncRequest
+ */
+export type RunSyncRequest = Message<"myncer.RunSyncRequest"> & {
+  /**
+   * The ID of the sync to run.
+   *
+   * @generated from field: string sync_id = 1;
+   */
+  syncId: string;
+
+  /**
+   * Plans the sync without changing any playlist.
+   * The plan is stored on the sync run, which can be watched like any other run.
+   * Paused syncs can only be run as a dry run.
+   *
+   * @generated from field: bool dry_run = 2;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message myncer.RunSyncRequest.
+ * Use `create(RunSyncRequestSchema)` to create a new message.
+ */
+export const RunSyncRequestSchema: GenMessage<RunSyncRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 36);
+
+/**
+ * @generated from message myncer.RunSyncResponse
+ */
+export type RunSyncResponse = Message<"myncer.RunSyncResponse"> & {
+  /**
+   * The ID of the sync that was run.
+   *
+   * @generated from field: string sync_id = 1;
+   */
+  syncId: string;
+
+  /**
+   * @generated from field: myncer.SyncStatus status = 2;
+   */
+  status: SyncStatus;
+
+  /**
+   * If the sync failed, this will contain the error message.
+   *
+   * @generated from field: string error_message = 3;
+   */
+  errorMessage: string;
+
+  /**
+   * The sync run that was queued.
+   *
+   * @generated from field: string run_id = 4;
+   */
+  runId: string;
+};
+
+/**
+ * Describes the message myncer.RunSyncResponse.
+ * Use `create(RunSyncResponseSchema)` to create a new message.
+ */
+export const RunSyncResponseSchema: GenMessage<RunSyncResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 37);
+
+/**
+ * @generated from message myncer.ListSyncRunsRequest
+ */
+export type ListSyncRunsRequest = Message<"myncer.ListSyncRunsRequest"> & {
+};
+
+/**
+ * Describes the message myncer.ListSyncRunsRequest.
+ * Use `create(ListSyncRunsRequestSchema)` to create a new message.
+ */
+export const ListSyncRunsRequestSchema: GenMessage<ListSyncRunsRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 38);
+
+/**
+ * @generated from message myncer.ListSyncRunsResponse
+ */
+export type ListSyncRunsResponse = Message<"myncer.ListSyncRunsResponse"> & {
+  /**
+   * List of sync runs for the current user.
+   *
+   * @generated from field: repeated myncer.SyncRun sync_runs = 1;
+   */
+  syncRuns: SyncRun[];
+};
+
+/**
+ * Describes the message myncer.ListSyncRunsResponse.
+ * Use `create(ListSyncRunsResponseSchema)` to create a new message.
+ */
+export const ListSyncRunsResponseSchema: GenMessage<ListSyncRunsResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 39);
+
+/**
+ * @generated from message myncer.CancelSyncRunRequest
+ */
+export type CancelSyncRunRequest = Message<"myncer.CancelSyncRunRequest"> & {
+  /**
+   * The ID of the sync run to cancel.
+   *
+   * @generated from field: string run_id = 1;
+   */
+  runId: string;
+};
+
+/**
+ * Describes the message myncer.CancelSyncRunRequest.
+ * Use `create(CancelSyncRunRequestSchema)` to create a new message.
+ */
+export const CancelSyncRunRequestSchema: GenMessage<CancelSyncRunRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 40);
+
+/**
+ * @generated from message myncer.CancelSyncRunResponse
+ */
+export type CancelSyncRunResponse = Message<"myncer.CancelSyncRunResponse"> & {
+  /**
+   * @generated from field: string run_id = 1;
+   */
+  runId: string;
+
+  /**
+   * CANCELLED if the run had not started yet.
+   * Otherwise RUNNING, and the run stops at the next song or datasource call.
+   *
+   * @generated from field: myncer.SyncStatus status = 2;
+   */
+  status: SyncStatus;
+};
+
+/**
+ * Describes the message myncer.CancelSyncRunResponse.
+ * Use `create(CancelSyncRunResponseSchema)` to create a new message.
+ */
+export const CancelSyncRunResponseSchema: GenMessage<CancelSyncRunResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 41);
+
+/**
+ * @generated from message myncer.WatchSyncRunRequest
+ */
+export type WatchSyncRunRequest = Message<"myncer.WatchSyncRunRequest"> & {
+  /**
+   * The ID of the sync run to watch.
+   *
+   * @generated from field: string run_id = 1;
+   */
+  runId: string;
+};
+
+/**
+ * Describes the message myncer.WatchSyncRunRequest.
+ * Use `create(WatchSyncRunRequestSchema)` to create a new message.
+ */
+export const WatchSyncRunRequestSchema: GenMessage<WatchSyncRunRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 42);
+
+/**
+ * @generated from message myncer.WatchSyncRunResponse
+ */
+export type WatchSyncRunResponse = Message<"myncer.WatchSyncRunResponse"> & {
+  /**
+   * @generated from oneof myncer.WatchSyncRunResponse.update
+   */
+  update: {
+    /**
+     * The run as currently stored.
+     * Sent first so that late subscribers catch up, and again once the run finishes.
+     *
+     * @generated from field: myncer.SyncRun sync_run = 1;
+     */
+    value: SyncRun;
+    case: "syncRun";
+  } | {
+    /**
+     * Sent as the run progresses.
+     *
+     * @generated from field: myncer.SyncRunEvent event = 2;
+     */
+    value: SyncRunEvent;
+    case: "event";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message myncer.WatchSyncRunResponse.
+ * Use `create(WatchSyncRunResponseSchema)` to create a new message.
+ */
+export const WatchSyncRunResponseSchema: GenMessage<WatchSyncRunResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 43);
+
+/**
+ * The songs of a playlist before they were changed.
+ *
+ * @generated from message myncer.PlaylistSnapshot
+ */
+export type PlaylistSnapshot = Message<"myncer.PlaylistSnapshot"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * The sync run about to change the playlist.
+   * Empty for snapshots taken before restoring another snapshot.
+   *
+   * @generated from field: string sync_id = 3;
+   */
+  syncId: string;
+
+  /**
+   * @generated from field: string run_id = 4;
+   */
+  runId: string;
+
+  /**
+   * @generated from field: myncer.MusicSource playlist = 5;
+   */
+  playlist?: MusicSource;
+
+  /**
+   * In playlist order.
+   *
+   * @generated from field: repeated myncer.Song songs = 6;
+   */
+  songs: Song[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message myncer.PlaylistSnapshot.
+ * Use `create(PlaylistSnapshotSchema)` to create a new message.
+ */
+export const PlaylistSnapshotSchema: GenMessage<PlaylistSnapshot> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 44);
+
+/**
+ * @generated from message myncer.ListPlaylistSnapshotsRequest
+ */
+export type ListPlaylistSnapshotsRequest = Message<"myncer.ListPlaylistSnapshotsRequest"> & {
+  /**
+   * Only lists the snapshots of the playlist if set.
+   *
+   * @generated from field: myncer.MusicSource playlist = 1;
+   */
+  playlist?: MusicSource;
+
+  /**
+   * Only lists the snapshots taken by the sync run if set.
+   *
+   * @generated from field: string run_id = 2;
+   */
+  runId: string;
+};
+
+/**
+ * Describes the message myncer.ListPlaylistSnapshotsRequest.
+ * Use `create(ListPlaylistSnapshotsRequestSchema)` to create a new message.
+ */
+export const ListPlaylistSnapshotsRequestSchema: GenMessage<ListPlaylistSnapshotsRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 45);
+
+/**
+ * @generated from message myncer.ListPlaylistSnapshotsResponse
+ */
+export type ListPlaylistSnapshotsResponse = Message<"myncer.ListPlaylistSnapshotsResponse"> & {
+  /**
+   * Newest first.
+   *
+   * @generated from field: repeated myncer.PlaylistSnapshot snapshots = 1;
+   */
+  snapshots: PlaylistSnapshot[];
+};
+
+/**
+ * Describes the message myncer.ListPlaylistSnapshotsResponse.
+ * Use `create(ListPlaylistSnapshotsResponseSchema)` to create a new message.
+ */
+export const ListPlaylistSnapshotsResponseSchema: GenMessage<ListPlaylistSnapshotsResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 46);
+
+/**
+ * @generated from message myncer.DiffPlaylistSnapshotsRequest
+ */
+export type DiffPlaylistSnapshotsRequest = Message<"myncer.DiffPlaylistSnapshotsRequest"> & {
+  /**
+   * @generated from field: string snapshot_id = 1;
+   */
+  snapshotId: string;
+
+  /**
+   * The snapshot to compare against.
+   * If empty, the snapshot is compared against the playlist as it is now.
+   *
+   * @generated from field: string other_snapshot_id = 2;
+   */
+  otherSnapshotId: string;
+};
+
+/**
+ * Describes the message myncer.DiffPlaylistSnapshotsRequest.
+ * Use `create(DiffPlaylistSnapshotsRequestSchema)` to create a new message.
+ */
+export const DiffPlaylistSnapshotsRequestSchema: GenMessage<DiffPlaylistSnapshotsRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 47);
+
+/**
+ * @generated from message myncer.DiffPlaylistSnapshotsResponse
+ */
+export type DiffPlaylistSnapshotsResponse = Message<"myncer.DiffPlaylistSnapshotsResponse"> & {
+  /**
+   * Songs the other side has that the snapshot doesn't.
+   *
+   * @generated from field: repeated myncer.Song added_songs = 1;
+   */
+  addedSongs: Song[];
+
+  /**
+   * Songs the snapshot has that the other side doesn't.
+   *
+   * @generated from field: repeated myncer.Song removed_songs = 2;
+   */
+  removedSongs: Song[];
+};
+
+/**
+ * Describes the message myncer.DiffPlaylistSnapshotsResponse.
+ * Use `create(DiffPlaylistSnapshotsResponseSchema)` to create a new message.
+ */
+export const DiffPlaylistSnapshotsResponseSchema: GenMessage<DiffPlaylistSnapshotsResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 48);
+
+/**
+ * @generated from message myncer.RestorePlaylistSnapshotRequest
+ */
+export type RestorePlaylistSnapshotRequest = Message<"myncer.RestorePlaylistSnapshotRequest"> & {
+  /**
+   * @generated from field: string snapshot_id = 1;
+   */
+  snapshotId: string;
+};
+
+/**
+ * Describes the message myncer.RestorePlaylistSnapshotRequest.
+ * Use `create(RestorePlaylistSnapshotRequestSchema)` to create a new message.
+ */
+export const RestorePlaylistSnapshotRequestSchema: GenMessage<RestorePlaylistSnapshotRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 49);
+
+/**
+ * @generated from message myncer.RestorePlaylistSnapshotResponse
+ */
+export type RestorePlaylistSnapshotResponse = Message<"myncer.RestorePlaylistSnapshotResponse"> & {
+  /**
+   * The snapshot of the playlist taken before it was restored, which can be used to undo the restore.
+   *
+   * @generated from field: myncer.PlaylistSnapshot snapshot = 1;
+   */
+  snapshot?: PlaylistSnapshot;
+};
+
+/**
+ * Describes the message myncer.RestorePlaylistSnapshotResponse.
+ * Use `create(RestorePlaylistSnapshotResponseSchema)` to create a new message.
+ */
+export const RestorePlaylistSnapshotResponseSchema: GenMessage<RestorePlaylistSnapshotResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 50);
+
+/**
+ * @generated from message myncer.GetSyncGraphRequest
+ */
+export type GetSyncGraphRequest = Message<"myncer.GetSyncGraphRequest"> & {
+};
+
+/**
+ * Describes the message myncer.GetSyncGraphRequest.
+ * Use `create(GetSyncGraphRequestSchema)` to create a new message.
+ */
+export const GetSyncGraphRequestSchema: GenMessage<GetSyncGraphRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 51);
+
+/**
+ * @generated from message myncer.GetSyncGraphResponse
+ */
+export type GetSyncGraphResponse = Message<"myncer.GetSyncGraphResponse"> & {
+  /**
+   * @generated from field: myncer.SyncGraph graph = 1;
+   */
+  graph?: SyncGraph;
+};
+
+/**
+ * Describes the message myncer.GetSyncGraphResponse.
+ * Use `create(GetSyncGraphResponseSchema)` to create a new message.
+ */
+export const GetSyncGraphResponseSchema: GenMessage<GetSyncGraphResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 52);
+
+/**
+ * The user's syncs as edges between the playlists they read from and write to.
+ *
+ * @generated from message myncer.SyncGraph
+ */
+export type SyncGraph = Message<"myncer.SyncGraph"> & {
+  /**
+   * @generated from field: repeated myncer.MusicSource nodes = 1;
+   */
+  nodes: MusicSource[];
+
+  /**
+   * @generated from field: repeated myncer.SyncGraphEdge edges = 2;
+   */
+  edges: SyncGraphEdge[];
+
+  /**
+   * Problems between syncs, like syncs undoing each other's changes.
+   * New syncs can't introduce any, but syncs created before these checks existed may have them.
+   *
+   * @generated from field: repeated myncer.SyncGraphIssue issues = 3;
+   */
+  issues: SyncGraphIssue[];
+};
+
+/**
+ * Describes the message myncer.SyncGraph.
+ * Use `create(SyncGraphSchema)` to create a new message.
+ */
+export const SyncGraphSchema: GenMessage<SyncGraph> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 53);
+
+/**
+ * A sync carrying songs from one playlist to another.
+ * Merge syncs that write back to their sources have an edge for every pair of their playlists.
+ *
+ * @generated from message myncer.SyncGraphEdge
+ */
+export type SyncGraphEdge = Message<"myncer.SyncGraphEdge"> & {
+  /**
+   * @generated from field: string sync_id = 1;
+   */
+  syncId: string;
+
+  /**
+   * @generated from field: myncer.MusicSource source = 2;
+   */
+  source?: MusicSource;
+
+  /**
+   * @generated from field: myncer.MusicSource destination = 3;
+   */
+  destination?: MusicSource;
+
+  /**
+   * Whether the sync replaces the songs of the destination instead of adding to them.
+   *
+   * @generated from field: bool overwrites = 4;
+   */
+  overwrites: boolean;
+};
+
+/**
+ * Describes the message myncer.SyncGraphEdge.
+ * Use `create(SyncGraphEdgeSchema)` to create a new message.
+ */
+export const SyncGraphEdgeSchema: GenMessage<SyncGraphEdge> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 54);
+
+/**
+ * @generated from message myncer.SyncGraphIssue
+ */
+export type SyncGraphIssue = Message<"myncer.SyncGraphIssue"> & {
+  /**
+   * The syncs involved, sorted.
+   *
+   * @generated from field: repeated string sync_ids = 1;
+   */
+  syncIds: string[];
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message myncer.SyncGraphIssue.
+ * Use `create(SyncGraphIssueSchema)` to create a new message.
+ */
+export const SyncGraphIssueSchema: GenMessage<SyncGraphIssue> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 55);
+
+/**
+ * @generated from message myncer.TransferLibraryRequest
+ */
+export type TransferLibraryRequest = Message<"myncer.TransferLibraryRequest"> & {
+  /**
+   * @generated from field: myncer.Datasource source_datasource = 1;
+   */
+  sourceDatasource: Datasource;
+
+  /**
+   * @generated from field: myncer.Datasource destination_datasource = 2;
+   */
+  destinationDatasource: Datasource;
+
+  /**
+   * Ids of the source playlists to transfer. Leave empty to transfer every playlist.
+   *
+   * @generated from field: repeated string playlist_ids = 3;
+   */
+  playlistIds: string[];
+
+  /**
+   * Whether the created destination playlists are public.
+   *
+   * @generated from field: bool public = 4;
+   */
+  public: boolean;
+};
+
+/**
+ * Describes the message myncer.TransferLibraryRequest.
+ * Use `create(TransferLibraryRequestSchema)` to create a new message.
+ */
+export const TransferLibraryRequestSchema: GenMessage<TransferLibraryRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 56);
+
+/**
+ * @generated from message myncer.TransferLibraryResponse
+ */
+export type TransferLibraryResponse = Message<"myncer.TransferLibraryResponse"> & {
+  /**
+   * @generated from field: myncer.LibraryTransfer transfer = 1;
+   */
+  transfer?: LibraryTransfer;
+};
+
+/**
+ * Describes the message myncer.TransferLibraryResponse.
+ * Use `create(TransferLibraryResponseSchema)` to create a new message.
+ */
+export const TransferLibraryResponseSchema: GenMessage<TransferLibraryResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 57);
+
+/**
+ * @generated from message myncer.GetLibraryTransferRequest
+ */
+export type GetLibraryTransferRequest = Message<"myncer.GetLibraryTransferRequest"> & {
+  /**
+   * @generated from field: string transfer_id = 1;
+   */
+  transferId: string;
+};
+
+/**
+ * Describes the message myncer.GetLibraryTransferRequest.
+ * Use `create(GetLibraryTransferRequestSchema)` to create a new message.
+ */
+export const GetLibraryTransferRequestSchema: GenMessage<GetLibraryTransferRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 58);
+
+/**
+ * @generated from message myncer.GetLibraryTransferResponse
+ */
+export type GetLibraryTransferResponse = Message<"myncer.GetLibraryTransferResponse"> & {
+  /**
+   * @generated from field: myncer.LibraryTransfer transfer = 1;
+   */
+  transfer?: LibraryTransfer;
+};
+
+/**
+ * Describes the message myncer.GetLibraryTransferResponse.
+ * Use `create(GetLibraryTransferResponseSchema)` to create a new message.
+ */
+export const GetLibraryTransferResponseSchema: GenMessage<GetLibraryTransferResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 59);
+
+/**
+ * @generated from message myncer.ListLibraryTransfersRequest
+ */
+export type ListLibraryTransfersRequest = Message<"myncer.ListLibraryTransfersRequest"> & {
+};
+
+/**
+ * Describes the message myncer.ListLibraryTransfersRequest.
+ * Use `create(ListLibraryTransfersRequestSchema)` to create a new message.
+ */
+export const ListLibraryTransfersRequestSchema: GenMessage<ListLibraryTransfersRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 60);
+
+/**
+ * @generated from message myncer.ListLibraryTransfersResponse
+ */
+export type ListLibraryTransfersResponse = Message<"myncer.ListLibraryTransfersResponse"> & {
+  /**
+   * Newest first.
+   *
+   * @generated from field: repeated myncer.LibraryTransfer transfers = 1;
+   */
+  transfers: LibraryTransfer[];
+};
+
+/**
+ * Describes the message myncer.ListLibraryTransfersResponse.
+ * Use `create(ListLibraryTransfersResponseSchema)` to create a new message.
+ */
+export const ListLibraryTransfersResponseSchema: GenMessage<ListLibraryTransfersResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 61);
+
+/**
+ * A batch of one-way syncs copying playlists from one datasource to another.
+ *
+ * @generated from message myncer.LibraryTransfer
+ */
+export type LibraryTransfer = Message<"myncer.LibraryTransfer"> & {
+  /**
+   * google/uuid generated UUID.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * Myncer user id.
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * Metadata which is fetched from SQL (for it's ACID compliance).
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 4;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: myncer.Datasource source_datasource = 5;
+   */
+  sourceDatasource: Datasource;
+
+  /**
+   * @generated from field: myncer.Datasource destination_datasource = 6;
+   */
+  destinationDatasource: Datasource;
+
+  /**
+   * @generated from field: repeated myncer.LibraryTransferItem items = 7;
+   */
+  items: LibraryTransferItem[];
+
+  /**
+   * The fields below are computed from the runs of the items when the transfer is read.
+   * Running until every run finished, then completed only if every playlist was transferred.
+   *
+   * @generated from field: myncer.SyncStatus status = 8;
+   */
+  status: SyncStatus;
+
+  /**
+   * Sum of the progress of the runs.
+   *
+   * @generated from field: myncer.SyncRunProgress progress = 9;
+   */
+  progress?: SyncRunProgress;
+
+  /**
+   * Songs that could not be found on the destination datasource, across every playlist.
+   *
+   * next: 11
+   *
+   * @generated from field: repeated myncer.Song unmatched_songs = 10;
+   */
+  unmatchedSongs: Song[];
+};
+
+/**
+ * Describes the message myncer.LibraryTransfer.
+ * Use `create(LibraryTransferSchema)` to create a new message.
+ */
+export const LibraryTransferSchema: GenMessage<LibraryTransfer> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 62);
+
+/**
+ * The transfer of a single playlist.
+ *
+ * @generated from message myncer.LibraryTransferItem
+ */
+export type LibraryTransferItem = Message<"myncer.LibraryTransferItem"> & {
+  /**
+   * @generated from field: myncer.MusicSource source = 1;
+   */
+  source?: MusicSource;
+
+  /**
+   * @generated from field: string source_name = 2;
+   */
+  sourceName: string;
+
+  /**
+   * Unset if the destination playlist could not be created.
+   *
+   * @generated from field: myncer.MusicSource destination = 3;
+   */
+  destination?: MusicSource;
+
+  /**
+   * Unset if the sync could not be set up.
+   *
+   * @generated from field: string sync_id = 4;
+   */
+  syncId: string;
+
+  /**
+   * @generated from field: string run_id = 5;
+   */
+  runId: string;
+
+  /**
+   * Why the playlist could not be set up for transfer.
+   *
+   * @generated from field: string error_message = 6;
+   */
+  errorMessage: string;
+
+  /**
+   * Computed from the run when the transfer is read.
+   *
+   * @generated from field: myncer.SyncStatus status = 7;
+   */
+  status: SyncStatus;
+};
+
+/**
+ * Describes the message myncer.LibraryTransferItem.
+ * Use `create(LibraryTransferItemSchema)` to create a new message.
+ */
+export const LibraryTransferItemSchema: GenMessage<LibraryTransferItem> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 63);
+
+/**
+ * @generated from message myncer.ClearSongResolutionsRequest
+ */
+export type ClearSongResolutionsRequest = Message<"myncer.ClearSongResolutionsRequest"> & {
+  /**
+   * Only clears the songs resolved on this datasource. Unspecified clears all of them.
+   *
+   * @generated from field: myncer.Datasource destination_datasource = 1;
+   */
+  destinationDatasource: Datasource;
+};
+
+/**
+ * Describes the message myncer.ClearSongResolutionsRequest.
+ * Use `create(ClearSongResolutionsRequestSchema)` to create a new message.
+ */
+export const ClearSongResolutionsRequestSchema: GenMessage<ClearSongResolutionsRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 64);
+
+/**
+ * @generated from message myncer.ClearSongResolutionsResponse
+ */
+export type ClearSongResolutionsResponse = Message<"myncer.ClearSongResolutionsResponse"> & {
+};
+
+/**
+ * Describes the message myncer.ClearSongResolutionsResponse.
+ * Use `create(ClearSongResolutionsResponseSchema)` to create a new message.
+ */
+export const ClearSongResolutionsResponseSchema: GenMessage<ClearSongResolutionsResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 65);
+
+/**
+ * @generated from message myncer.SearchSongsRequest
+ */
+export type SearchSongsRequest = Message<"myncer.SearchSongsRequest"> & {
+  /**
+   * @generated from field: myncer.Datasource datasource = 1;
+   */
+  datasource: Datasource;
+
+  /**
+   * Only the name, artists, album and ISRC of the song are searched for.
+   *
+   * @generated from field: myncer.Song song = 2;
+   */
+  song?: Song;
+
+  /**
+   * Unset uses the default profile.
+   *
+   * @generated from field: myncer.MatchingProfile matching_profile = 3;
+   */
+  matchingProfile?: MatchingProfile;
+};
+
+/**
+ * Describes the message myncer.SearchSongsRequest.
+ * Use `create(SearchSongsRequestSchema)` to create a new message.
+ */
+export const SearchSongsRequestSchema: GenMessage<SearchSongsRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 66);
+
+/**
+ * @generated from message myncer.SearchSongsResponse
+ */
+export type SearchSongsResponse = Message<"myncer.SearchSongsResponse"> & {
+  /**
+   * Best match first, including songs the matching profile would not accept.
+   *
+   * @generated from field: repeated myncer.SongMatchCandidate candidates = 1;
+   */
+  candidates: SongMatchCandidate[];
+};
+
+/**
+ * Describes the message myncer.SearchSongsResponse.
+ * Use `create(SearchSongsResponseSchema)` to create a new message.
+ */
+export const SearchSongsResponseSchema: GenMessage<SearchSongsResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 67);
+
+/**
+ * @generated from message myncer.SetSongOverrideRequest
+ */
+export type SetSongOverrideRequest = Message<"myncer.SetSongOverrideRequest"> & {
+  /**
+   * Must have a datasource and datasource song id.
+   *
+   * @generated from field: myncer.Song source_song = 1;
+   */
+  sourceSong?: Song;
+
+  /**
+   * @generated from field: myncer.Datasource destination_datasource = 2;
+   */
+  destinationDatasource: Datasource;
+
+  /**
+   * @generated from field: myncer.SongOverrideKind kind = 3;
+   */
+  kind: SongOverrideKind;
+
+  /**
+   * Required for pins, e.g. a song returned by SearchSongs. Must be unset otherwise.
+   *
+   * @generated from field: myncer.Song destination_song = 4;
+   */
+  destinationSong?: Song;
+};
+
+/**
+ * Describes the message myncer.SetSongOverrideRequest.
+ * Use `create(SetSongOverrideRequestSchema)` to create a new message.
+ */
+export const SetSongOverrideRequestSchema: GenMessage<SetSongOverrideRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 68);
+
+/**
+ * @generated from message myncer.SetSongOverrideResponse
+ */
+export type SetSongOverrideResponse = Message<"myncer.SetSongOverrideResponse"> & {
+  /**
+   * @generated from field: myncer.SongOverride song_override = 1;
+   */
+  songOverride?: SongOverride;
+};
+
+/**
+ * Describes the message myncer.SetSongOverrideResponse.
+ * Use `create(SetSongOverrideResponseSchema)` to create a new message.
+ */
+export const SetSongOverrideResponseSchema: GenMessage<SetSongOverrideResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 69);
+
+/**
+ * @generated from message myncer.DeleteSongOverrideRequest
+ */
+export type DeleteSongOverrideRequest = Message<"myncer.DeleteSongOverrideRequest"> & {
+  /**
+   * Only the datasource and datasource song id are used.
+   *
+   * @generated from field: myncer.Song source_song = 1;
+   */
+  sourceSong?: Song;
+
+  /**
+   * @generated from field: myncer.Datasource destination_datasource = 2;
+   */
+  destinationDatasource: Datasource;
+};
+
+/**
+ * Describes the message myncer.DeleteSongOverrideRequest.
+ * Use `create(DeleteSongOverrideRequestSchema)` to create a new message.
+ */
+export const DeleteSongOverrideRequestSchema: GenMessage<DeleteSongOverrideRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 70);
+
+/**
+ * @generated from message myncer.DeleteSongOverrideResponse
+ */
+export type DeleteSongOverrideResponse = Message<"myncer.DeleteSongOverrideResponse"> & {
+};
+
+/**
+ * Describes the message myncer.DeleteSongOverrideResponse.
+ * Use `create(DeleteSongOverrideResponseSchema)` to create a new message.
+ */
+export const DeleteSongOverrideResponseSchema: GenMessage<DeleteSongOverrideResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 71);
+
+/**
+ * @generated from message myncer.ListSongOverridesRequest
+ */
+export type ListSongOverridesRequest = Message<"myncer.ListSongOverridesRequest"> & {
+};
+
+/**
+ * Describes the message myncer.ListSongOverridesRequest.
+ * Use `create(ListSongOverridesRequestSchema)` to create a new message.
+ */
+export const ListSongOverridesRequestSchema: GenMessage<ListSongOverridesRequest> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 72);
+
+/**
+ * @generated from message myncer.ListSongOverridesResponse
+ */
+export type ListSongOverridesResponse = Message<"myncer.ListSongOverridesResponse"> & {
+  /**
+   * Most recently updated first.
+   *
+   * @generated from field: repeated myncer.SongOverride song_overrides = 1;
+   */
+  songOverrides: SongOverride[];
+};
+
+/**
+ * Describes the message myncer.ListSongOverridesResponse.
+ * Use `create(ListSongOverridesResponseSchema)` to create a new message.
+ */
+export const ListSongOverridesResponseSchema: GenMessage<ListSongOverridesResponse> = /*@__PURE__*/
+  messageDesc(file_myncer_sync, 73);
+
+/**
+ * @generated from enum myncer.PlaylistMergeSyncMode
+ */
+export enum PlaylistMergeSyncMode {
+  /**
+   * The merged songs are written to the destination.
+   *
+   * @generated from enum value: PLAYLIST_MERGE_SYNC_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The merged songs are written back to every source, and the destination if set.
+   * Only songs missing from each playlist are added.
+   *
+   * @generated from enum value: PLAYLIST_MERGE_SYNC_MODE_BIDIRECTIONAL = 1;
+   */
+  BIDIRECTIONAL = 1,
+
+  /**
+   * Like bidirectional, but also compares every playlist against its contents after the last
+   * successful run.
+   * Songs removed from any playlist since then are removed from every playlist.
+   *
+   * @generated from enum value: PLAYLIST_MERGE_SYNC_MODE_THREE_WAY = 2;
+   */
+  THREE_WAY = 2,
+}
+
+/**
+ * Describes the enum myncer.PlaylistMergeSyncMode.
+ */
+export const PlaylistMergeSyncModeSchema: GenEnum<PlaylistMergeSyncMode> = /*@__PURE__*/
+  enumDesc(file_myncer_sync, 0);
+
+/**
+ * What happens when a song was removed from one playlist but added to another since the last
+ * successful run of a three-way merge.
+ *
+ * @generated from enum myncer.MergeConflictPolicy
+ */
+export enum MergeConflictPolicy {
+  /**
+   * Same as keep.
+   *
+   * @generated from enum value: MERGE_CONFLICT_POLICY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The song is kept in, and added back to, every playlist.
+   *
+   * @generated from enum value: MERGE_CONFLICT_POLICY_KEEP = 1;
+   */
+  KEEP = 1,
+
   /**
-   * The ID of the sync to delete.
+   * The song is removed from every playlist.
    *
-   * @generated from field: string sync_id = 1;
+   * @generated from enum value: MERGE_CONFLICT_POLICY_REMOVE = 2;
    */
-  syncId: string;
-};
+  REMOVE = 2,
+}
 
 /**
- * Describes the message myncer.DeleteSyncRequest.
- * Use `create(DeleteSyncRequestSchema)` to create a new message.
+ * Describes the enum myncer.MergeConflictPolicy.
  */
-export const DeleteSyncRequestSchema: GenMessage<DeleteSyncRequest> = /*@__PURE__*/
-  messageDesc(file_myncer_sync, 6);
+export const MergeConflictPolicySchema: GenEnum<MergeConflictPolicy> = /*@__PURE__*/
+  enumDesc(file_myncer_sync, 1);
 
 /**
- * @generated from message myncer.DeleteSyncResponse
+ * How often a scheduled sync should run.
+ *
+ * @generated from enum myncer.SyncScheduleInterval
  */
-export type DeleteSyncResponse = Message<"myncer.DeleteSyncResponse"> & {
+export enum SyncScheduleInterval {
   /**
-   * The ID of the deleted sync.
+   * The sync is only ever run manually.
    *
-   * @generated from field: string sync_id = 1;
+   * @generated from enum value: SYNC_SCHEDULE_INTERVAL_UNSPECIFIED = 0;
    */
-  syncId: string;
-};
+  UNSPECIFIED = 0,
 
-/**
- * Describes the message myncer.DeleteSyncResponse.
- * Use `create(DeleteSyncResponseSchema)` to create a new message.
- */
-export const DeleteSyncResponseSchema: GenMessage<DeleteSyncResponse> = /*@__PURE__*/
-  messageDesc(file_myncer_sync, 7);
+  /**
+   * @generated from enum value: SYNC_SCHEDULE_INTERVAL_HOURLY = 1;
+   */
+  HOURLY = 1,
 
-/**
- * @generated from message myncer.ListSyncsRequest
- */
-export type ListSyncsRequest = Message<"myncer.ListSyncsRequest"> & {
-};
+  /**
+   * @generated from enum value: SYNC_SCHEDULE_INTERVAL_WEEKLY = 2;
+   */
+  WEEKLY = 2,
+
+  /**
+   * @generated from enum value: SYNC_SCHEDULE_INTERVAL_BI_WEEKLY = 3;
+   */
+  BI_WEEKLY = 3,
+
+  /**
+   * @generated from enum value: SYNC_SCHEDULE_INTERVAL_MONTHLY = 4;
+   */
+  MONTHLY = 4,
+}
 
 /**
- * Describes the message myncer.ListSyncsRequest.
- * Use `create(ListSyncsRequestSchema)` to create a new message.
+ * Describes the enum myncer.SyncScheduleInterval.
  */
-export const ListSyncsRequestSchema: GenMessage<ListSyncsRequest> = /*@__PURE__*/
-  messageDesc(file_myncer_sync, 8);
+export const SyncScheduleIntervalSchema: GenEnum<SyncScheduleInterval> = /*@__PURE__*/
+  enumDesc(file_myncer_sync, 2);
 
 /**
- * @generated from message myncer.ListSyncsResponse
+ * @generated from enum myncer.SyncRunKind
  */
-export type ListSyncsResponse = Message<"myncer.ListSyncsResponse"> & {
+export enum SyncRunKind {
   /**
-   * List of syncs for the current user.
+   * A regular run which writes to playlists.
    *
-   * @generated from field: repeated myncer.Sync syncs = 1;
+   * @generated from enum value: SYNC_RUN_KIND_UNSPECIFIED = 0;
    */
-  syncs: Sync[];
-};
+  UNSPECIFIED = 0,
+
+  /**
+   * A dry run which plans the changes of the sync without making them.
+   *
+   * @generated from enum value: SYNC_RUN_KIND_PREVIEW = 1;
+   */
+  PREVIEW = 1,
+}
 
 /**
- * Describes the message myncer.ListSyncsResponse.
- * Use `create(ListSyncsResponseSchema)` to create a new message.
+ * Describes the enum myncer.SyncRunKind.
  */
-export const ListSyncsResponseSchema: GenMessage<ListSyncsResponse> = /*@__PURE__*/
-  messageDesc(file_myncer_sync, 9);
+export const SyncRunKindSchema: GenEnum<SyncRunKind> = /*@__PURE__*/
+  enumDesc(file_myncer_sync, 3);
 
 /**
- * @generated from message myncer.GetSyncRequest
+ * The phases of a sync run. Which phases are run depends on the kind of sync.
+ *
+ * @generated from enum myncer.SyncRunPhase
  */
-export type GetSyncRequest = Message<"myncer.GetSyncRequest"> & {
+export enum SyncRunPhase {
   /**
-   * The ID of the sync to get.
+   * @generated from enum value: SYNC_RUN_PHASE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Fetching songs from the source playlists.
    *
-   * @generated from field: string sync_id = 1;
+   * @generated from enum value: SYNC_RUN_PHASE_FETCH_SOURCE = 1;
    */
-  syncId: string;
-};
+  FETCH_SOURCE = 1,
 
-/**
- * Describes the message myncer.GetSyncRequest.
- * Use `create(GetSyncRequestSchema)` to create a new message.
- */
-export const GetSyncRequestSchema: GenMessage<GetSyncRequest> = /*@__PURE__*/
-  messageDesc(file_myncer_sync, 10);
+  /**
+   * Normalizing song metadata with the LLM.
+   *
+   * @generated from enum value: SYNC_RUN_PHASE_NORMALIZE = 2;
+   */
+  NORMALIZE = 2,
 
-/**
- * @generated from message myncer.GetSyncResponse
- */
-export type GetSyncResponse = Message<"myncer.GetSyncResponse"> & {
   /**
-   * The requested sync.
+   * Searching for the songs on the destination datasource.
    *
-   * @generated from field: myncer.Sync sync = 1;
+   * @generated from enum value: SYNC_RUN_PHASE_SEARCH = 3;
    */
-  sync?: Sync;
-};
+  SEARCH = 3,
 
-/**
- * Describes the message myncer.GetSyncResponse.
- * Use `create(GetSyncResponseSchema)` to create a new message.
- */
-export const GetSyncResponseSchema: GenMessage<GetSyncResponse> = /*@__PURE__*/
-  messageDesc(file_myncer_sync, 11);
+  /**
+   * Clearing the destination playlist.
+   *
+   * @generated from enum value: SYNC_RUN_PHASE_CLEAR_DESTINATION = 4;
+   */
+  CLEAR_DESTINATION = 4,
 
-/**
- * @generated from message myncer.RunSyncRequest
- */
-export type RunSyncRequest = Message<"myncer.RunSyncRequest"> & {
   /**
-   * The ID of the sync to run.
+   * Adding songs to the destination playlist.
    *
-   * @generated from field: string sync_id = 1;
+   * @generated from enum value: SYNC_RUN_PHASE_ADD_TO_DESTINATION = 5;
    */
-  syncId: string;
-};
+  ADD_TO_DESTINATION = 5,
+
+  /**
+   * Fetching songs already in the destination playlist.
+   *
+   * @generated from enum value: SYNC_RUN_PHASE_FETCH_DESTINATION = 6;
+   */
+  FETCH_DESTINATION = 6,
+
+  /**
+   * Removing songs from the destination playlist.
+   *
+   * @generated from enum value: SYNC_RUN_PHASE_REMOVE_FROM_DESTINATION = 7;
+   */
+  REMOVE_FROM_DESTINATION = 7,
+
+  /**
+   * Reordering the destination playlist.
+   *
+   * @generated from enum value: SYNC_RUN_PHASE_REORDER_DESTINATION = 8;
+   */
+  REORDER_DESTINATION = 8,
+}
 
 /**
- * Describes the message myncer.RunSyncRequest.
- * Use `create(RunSyncRequestSchema)` to create a new message.
+ * Describes the enum myncer.SyncRunPhase.
  */
-export const RunSyncRequestSchema: GenMessage<RunSyncRequest> = /*@__PURE__*/
-  messageDesc(file_myncer_sync, 12);
+export const SyncRunPhaseSchema: GenEnum<SyncRunPhase> = /*@__PURE__*/
+  enumDesc(file_myncer_sync, 4);
 
 /**
- * @generated from message myncer.RunSyncResponse
+ * How a sync orders the playlists it writes to.
+ *
+ * @generated from enum myncer.PlaylistOrder
  */
-export type RunSyncResponse = Message<"myncer.RunSyncResponse"> & {
+export enum PlaylistOrder {
   /**
-   * The ID of the sync that was run.
+   * The playlist is not reordered. New songs are added to the end.
    *
-   * @generated from field: string sync_id = 1;
+   * @generated from enum value: PLAYLIST_ORDER_UNSPECIFIED = 0;
    */
-  syncId: string;
+  UNSPECIFIED = 0,
 
   /**
-   * @generated from field: myncer.SyncStatus status = 2;
+   * Songs are in the same order as in the source.
+   * Songs that aren't in the source are moved to the end.
+   *
+   * @generated from enum value: PLAYLIST_ORDER_SOURCE = 1;
    */
-  status: SyncStatus;
+  SOURCE = 1,
 
   /**
-   * If the sync failed, this will contain the error message.
+   * Songs are sorted by name.
    *
-   * @generated from field: string error_message = 3;
+   * @generated from enum value: PLAYLIST_ORDER_NAME = 2;
    */
-  errorMessage: string;
-};
+  NAME = 2,
 
-/**
- * Describes the message myncer.RunSyncResponse.
- * Use `create(RunSyncResponseSchema)` to create a new message.
- */
-export const RunSyncResponseSchema: GenMessage<RunSyncResponse> = /*@__PURE__*/
-  messageDesc(file_myncer_sync, 13);
+  /**
+   * Songs are sorted by their first artist.
+   *
+   * @generated from enum value: PLAYLIST_ORDER_ARTIST = 3;
+   */
+  ARTIST = 3,
 
-/**
- * @generated from message myncer.ListSyncRunsRequest
- */
-export type ListSyncRunsRequest = Message<"myncer.ListSyncRunsRequest"> & {
-};
+  /**
+   * Songs are sorted by album.
+   *
+   * @generated from enum value: PLAYLIST_ORDER_ALBUM = 4;
+   */
+  ALBUM = 4,
+}
 
 /**
- * Describes the message myncer.ListSyncRunsRequest.
- * Use `create(ListSyncRunsRequestSchema)` to create a new message.
+ * Describes the enum myncer.PlaylistOrder.
  */
-export const ListSyncRunsRequestSchema: GenMessage<ListSyncRunsRequest> = /*@__PURE__*/
-  messageDesc(file_myncer_sync, 14);
+export const PlaylistOrderSchema: GenEnum<PlaylistOrder> = /*@__PURE__*/
+  enumDesc(file_myncer_sync, 5);
 
 /**
- * @generated from message myncer.ListSyncRunsResponse
+ * How a one-way sync updates the destination playlist.
+ *
+ * @generated from enum myncer.OneWaySyncMode
  */
-export type ListSyncRunsResponse = Message<"myncer.ListSyncRunsResponse"> & {
+export enum OneWaySyncMode {
   /**
-   * List of sync runs for the current user.
+   * Every source song is added to the destination, after clearing it if `overwrite_existing` is set.
    *
-   * @generated from field: repeated myncer.SyncRun sync_runs = 1;
+   * @generated from enum value: ONE_WAY_SYNC_MODE_UNSPECIFIED = 0;
    */
-  syncRuns: SyncRun[];
-};
+  UNSPECIFIED = 0,
+
+  /**
+   * Only source songs missing from the destination are added, so repeated runs are idempotent.
+   *
+   * @generated from enum value: ONE_WAY_SYNC_MODE_DIFF = 1;
+   */
+  DIFF = 1,
+}
 
 /**
- * Describes the message myncer.ListSyncRunsResponse.
- * Use `create(ListSyncRunsResponseSchema)` to create a new message.
+ * Describes the enum myncer.OneWaySyncMode.
  */
-export const ListSyncRunsResponseSchema: GenMessage<ListSyncRunsResponse> = /*@__PURE__*/
-  messageDesc(file_myncer_sync, 15);
+export const OneWaySyncModeSchema: GenEnum<OneWaySyncMode> = /*@__PURE__*/
+  enumDesc(file_myncer_sync, 6);
 
 /**
  * @generated from enum myncer.SyncStatus
@@ -487,7 +2745,7 @@ export enum SyncStatus {
  * Describes the enum myncer.SyncStatus.
  */
 export const SyncStatusSchema: GenEnum<SyncStatus> = /*@__PURE__*/
-  enumDesc(file_myncer_sync, 0);
+  enumDesc(file_myncer_sync, 7);
 
 /**
  * @generated from service myncer.SyncService
@@ -509,6 +2767,34 @@ export const SyncService: GenService<{
     input: typeof DeleteSyncRequestSchema;
     output: typeof DeleteSyncResponseSchema;
   },
+  /**
+   * Replaces the settings of a sync, keeping its run history.
+   *
+   * @generated from rpc myncer.SyncService.UpdateSync
+   */
+  updateSync: {
+    methodKind: "unary";
+    input: typeof UpdateSyncRequestSchema;
+    output: typeof UpdateSyncResponseSchema;
+  },
+  /**
+   * Stops the sync from running until it is resumed. Runs already in progress are not cancelled.
+   *
+   * @generated from rpc myncer.SyncService.PauseSync
+   */
+  pauseSync: {
+    methodKind: "unary";
+    input: typeof PauseSyncRequestSchema;
+    output: typeof PauseSyncResponseSchema;
+  },
+  /**
+   * @generated from rpc myncer.SyncService.ResumeSync
+   */
+  resumeSync: {
+    methodKind: "unary";
+    input: typeof ResumeSyncRequestSchema;
+    output: typeof ResumeSyncResponseSchema;
+  },
   /**
    * @generated from rpc myncer.SyncService.ListSyncs
    */
@@ -541,6 +2827,138 @@ export const SyncService: GenService<{
     input: typeof ListSyncRunsRequestSchema;
     output: typeof ListSyncRunsResponseSchema;
   },
+  /**
+   * @generated from rpc myncer.SyncService.CancelSyncRun
+   */
+  cancelSyncRun: {
+    methodKind: "unary";
+    input: typeof CancelSyncRunRequestSchema;
+    output: typeof CancelSyncRunResponseSchema;
+  },
+  /**
+   * Streams the progress of a sync run until it finishes.
+   *
+   * @generated from rpc myncer.SyncService.WatchSyncRun
+   */
+  watchSyncRun: {
+    methodKind: "server_streaming";
+    input: typeof WatchSyncRunRequestSchema;
+    output: typeof WatchSyncRunResponseSchema;
+  },
+  /**
+   * Snapshots are taken before a sync run removes songs from a playlist.
+   *
+   * @generated from rpc myncer.SyncService.ListPlaylistSnapshots
+   */
+  listPlaylistSnapshots: {
+    methodKind: "unary";
+    input: typeof ListPlaylistSnapshotsRequestSchema;
+    output: typeof ListPlaylistSnapshotsResponseSchema;
+  },
+  /**
+   * @generated from rpc myncer.SyncService.DiffPlaylistSnapshots
+   */
+  diffPlaylistSnapshots: {
+    methodKind: "unary";
+    input: typeof DiffPlaylistSnapshotsRequestSchema;
+    output: typeof DiffPlaylistSnapshotsResponseSchema;
+  },
+  /**
+   * Puts the playlist back exactly as it was when the snapshot was taken.
+   *
+   * @generated from rpc myncer.SyncService.RestorePlaylistSnapshot
+   */
+  restorePlaylistSnapshot: {
+    methodKind: "unary";
+    input: typeof RestorePlaylistSnapshotRequestSchema;
+    output: typeof RestorePlaylistSnapshotResponseSchema;
+  },
+  /**
+   * Shows how the user's syncs connect their playlists.
+   *
+   * @generated from rpc myncer.SyncService.GetSyncGraph
+   */
+  getSyncGraph: {
+    methodKind: "unary";
+    input: typeof GetSyncGraphRequestSchema;
+    output: typeof GetSyncGraphResponseSchema;
+  },
+  /**
+   * Copies playlists of one datasource to another. A new playlist is created on the destination
+   * for each playlist, along with a one-way sync which is run right away.
+   *
+   * @generated from rpc myncer.SyncService.TransferLibrary
+   */
+  transferLibrary: {
+    methodKind: "unary";
+    input: typeof TransferLibraryRequestSchema;
+    output: typeof TransferLibraryResponseSchema;
+  },
+  /**
+   * Returns the transfer with the progress of its runs so far.
+   *
+   * @generated from rpc myncer.SyncService.GetLibraryTransfer
+   */
+  getLibraryTransfer: {
+    methodKind: "unary";
+    input: typeof GetLibraryTransferRequestSchema;
+    output: typeof GetLibraryTransferResponseSchema;
+  },
+  /**
+   * @generated from rpc myncer.SyncService.ListLibraryTransfers
+   */
+  listLibraryTransfers: {
+    methodKind: "unary";
+    input: typeof ListLibraryTransfersRequestSchema;
+    output: typeof ListLibraryTransfersResponseSchema;
+  },
+  /**
+   * Forgets which songs the user's songs were resolved to, so that they are searched for again.
+   *
+   * @generated from rpc myncer.SyncService.ClearSongResolutions
+   */
+  clearSongResolutions: {
+    methodKind: "unary";
+    input: typeof ClearSongResolutionsRequestSchema;
+    output: typeof ClearSongResolutionsResponseSchema;
+  },
+  /**
+   * Searches a datasource for a song, e.g. to find the song to pin another song to.
+   *
+   * @generated from rpc myncer.SyncService.SearchSongs
+   */
+  searchSongs: {
+    methodKind: "unary";
+    input: typeof SearchSongsRequestSchema;
+    output: typeof SearchSongsResponseSchema;
+  },
+  /**
+   * Pins a song to a song of another datasource, or marks it to never be synced to the datasource.
+   * Replaces the existing override of the song for the datasource, if any.
+   *
+   * @generated from rpc myncer.SyncService.SetSongOverride
+   */
+  setSongOverride: {
+    methodKind: "unary";
+    input: typeof SetSongOverrideRequestSchema;
+    output: typeof SetSongOverrideResponseSchema;
+  },
+  /**
+   * @generated from rpc myncer.SyncService.DeleteSongOverride
+   */
+  deleteSongOverride: {
+    methodKind: "unary";
+    input: typeof DeleteSongOverrideRequestSchema;
+    output: typeof DeleteSongOverrideResponseSchema;
+  },
+  /**
+   * @generated from rpc myncer.SyncService.ListSongOverrides
+   */
+  listSongOverrides: {
+    methodKind: "unary";
+    input: typeof ListSongOverridesRequestSchema;
+    output: typeof ListSongOverridesResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_myncer_sync, 0);
 
@@ -23,6 +23,7 @@ const tidalScopes = [
   "playlists.read",
   "playlists.write",
   "collection.read",
+  "collection.write",
   "search.read"
 ].join(" ")
 
@@ -15,12 +15,20 @@ import (
 var schemaFile embed.FS
 
 type Database struct {
-	DatasourceTokenStore DatasourceTokenStore
-	UserStore            UserStore
-	SyncStore            SyncStore
-	SyncRunStore         SyncRunStore
-	SongStore            SongStore
-	DB                   *sql.DB
+	DatasourceTokenStore  DatasourceTokenStore
+	UserStore             UserStore
+	SyncStore             SyncStore
+	SyncRunStore          SyncRunStore
+	SyncRunEventStore     SyncRunEventStore
+	SyncJobStore          SyncJobStore
+	SyncBaselineStore     SyncBaselineStore
+	PlaylistSnapshotStore PlaylistSnapshotStore
+	LibraryTransferStore  LibraryTransferStore
+	SongStore             SongStore
+	SongResolutionStore   SongResolutionStore
+	SongOverrideStore     SongOverrideStore
+	LockStore             LockStore
+	DB                    *sql.DB
 }
 
 func MustGetDatabase(ctx context.Context, config *myncer_pb.Config /*const*/) *Database {
@@ -38,12 +46,20 @@ func MustGetDatabase(ctx context.Context, config *myncer_pb.Config /*const*/) *D
 	}
 
 	return &Database{
-		DB:                   db,
-		UserStore:            NewUserStore(db),
-		SyncStore:            NewSyncStore(db),
-		SyncRunStore:         NewSyncRunStore(db),
-		SongStore:            NewSongStore(db),
-		DatasourceTokenStore: NewDatasourceTokenStore(db),
+		DB:                    db,
+		UserStore:             NewUserStore(db),
+		SyncStore:             NewSyncStore(db),
+		SyncRunStore:          NewSyncRunStore(db),
+		SyncRunEventStore:     NewSyncRunEventStore(db),
+		SyncJobStore:          NewSyncJobStore(db),
+		SyncBaselineStore:     NewSyncBaselineStore(db),
+		PlaylistSnapshotStore: NewPlaylistSnapshotStore(db),
+		LibraryTransferStore:  NewLibraryTransferStore(db),
+		SongStore:             NewSongStore(db),
+		SongResolutionStore:   NewSongResolutionStore(db),
+		SongOverrideStore:     NewSongOverrideStore(db),
+		LockStore:             NewLockStore(db),
+		DatasourceTokenStore:  NewDatasourceTokenStore(db),
 	}
 }
 
@@ -19,25 +19,55 @@ type DatasourceClient interface {
 		userInfo *myncer_pb.User, /*const*/
 		id string,
 	) (*myncer_pb.Playlist, error)
+	// Creates a playlist owned by the user.
+	CreatePlaylist(
+		ctx context.Context,
+		userInfo *myncer_pb.User, /*const*/
+		name string,
+		description string,
+		public bool,
+	) (*myncer_pb.Playlist, error)
+	// The methods below take library collections as well as playlists.
+	// Collections return an error for the operations they don't support, see
+	// `IsMusicSourceWritable` and `IsMusicSourceReorderable`.
 	GetPlaylistSongs(
 		ctx context.Context,
 		userInfo *myncer_pb.User, /*const*/
-		playlistId string,
+		playlist *myncer_pb.MusicSource, /*const*/
 	) ([]Song, error)
 	AddToPlaylist(
 		ctx context.Context,
 		userInfo *myncer_pb.User, /*const*/
-		playlistId string,
+		playlist *myncer_pb.MusicSource, /*const*/
 		songs []Song, /*const*/
 	) error
 	ClearPlaylist(
 		ctx context.Context,
 		userInfo *myncer_pb.User, /*const*/
-		playlistId string,
+		playlist *myncer_pb.MusicSource, /*const*/
+	) error
+	// Removes every occurrence of the songs from the playlist.
+	RemoveFromPlaylist(
+		ctx context.Context,
+		userInfo *myncer_pb.User, /*const*/
+		playlist *myncer_pb.MusicSource, /*const*/
+		songs []Song, /*const*/
+	) error
+	// Reorders the playlist so that its songs are in the order of `songs`, which must hold exactly
+	// the songs of the playlist.
+	ReorderPlaylist(
+		ctx context.Context,
+		userInfo *myncer_pb.User, /*const*/
+		playlist *myncer_pb.MusicSource, /*const*/
+		songs []Song, /*const*/
 	) error
+	// Returns the songs of the datasource that best match `songToSearch`, best first and scored
+	// according to the matching profile.
+	// Songs the profile would not accept are included; it is up to the caller to pick the match.
 	Search(
 		ctx context.Context,
 		userInfo *myncer_pb.User, /*const*/
 		songToSearch Song, /*const*/
-	) (Song, error)
+		profile *myncer_pb.MatchingProfile, /*const,@nullable*/ // nil indicates the default profile
+	) ([]*SearchCandidate, error)
 }
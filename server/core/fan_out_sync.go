@@ -0,0 +1,20 @@
+package core
+
+import (
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+// GetFanOutOneWaySync returns the one-way sync the fan-out sync runs for the destination.
+func GetFanOutOneWaySync(
+	fanOutSync *myncer_pb.FanOutSync, /*const*/
+	destination *myncer_pb.MusicSource, /*const*/
+) *myncer_pb.OneWaySync {
+	return &myncer_pb.OneWaySync{
+		Source:            fanOutSync.GetSource(),
+		Destination:       destination,
+		OverwriteExisting: fanOutSync.GetOverwriteExisting(),
+		Mode:              fanOutSync.GetMode(),
+		RemoveExtraSongs:  fanOutSync.GetRemoveExtraSongs(),
+		Order:             fanOutSync.GetOrder(),
+	}
+}
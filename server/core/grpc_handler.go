@@ -20,6 +20,22 @@ type GrpcHandler[Req any, Resp any] interface {
 	) *GrpcHandlerResponse[Resp]
 }
 
+// Handler for server-streaming RPCs.
+type GrpcStreamHandler[Req any, Resp any] interface {
+	CheckPerms(
+		ctx context.Context,
+		userInfo *myncer_pb.User, /*const,@nullable*/
+		reqBody Req, /*const*/
+	) error
+	// Sends responses with `send` until the stream is complete.
+	ProcessRequest(
+		ctx context.Context,
+		userInfo *myncer_pb.User, /*const,@nullable*/
+		reqBody Req, /*const*/
+		send func(Resp) error,
+	) error
+}
+
 type GrpcHandlerResponse[T any] struct {
 	// Error used for internal logging on server.
 	Err error /*@nullable*/
@@ -0,0 +1,67 @@
+package core
+
+import (
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+// FillLibraryTransferProgress computes the status, progress and unmatched songs of the transfer
+// from the runs of its items, keyed by run id.
+// Items that are still being set up count as pending. Items whose run can't be found, e.g. because
+// their sync was deleted, count as failed.
+func FillLibraryTransferProgress(
+	transfer *myncer_pb.LibraryTransfer,
+	syncRuns map[string]*myncer_pb.SyncRun, /*const*/
+) {
+	progress := &myncer_pb.SyncRunProgress{}
+	unmatchedSongs := []*myncer_pb.Song{}
+	numPending, numUnfinished, numCompleted, numFailed := 0, 0, 0, 0
+	for _, item := range transfer.GetItems() {
+		syncRun, ok := syncRuns[item.GetRunId()]
+		switch {
+		case item.GetErrorMessage() != "":
+			item.Status = myncer_pb.SyncStatus_SYNC_STATUS_FAILED
+		case item.GetRunId() == "":
+			item.Status = myncer_pb.SyncStatus_SYNC_STATUS_PENDING
+		case !ok:
+			item.Status = myncer_pb.SyncStatus_SYNC_STATUS_FAILED
+		default:
+			item.Status = syncRun.GetSyncStatus()
+			runProgress := syncRun.GetProgress()
+			progress.TotalSongs += runProgress.GetTotalSongs()
+			progress.MatchedSongs += runProgress.GetMatchedSongs()
+			progress.UnmatchedSongs += runProgress.GetUnmatchedSongs()
+			progress.AddedSongs += runProgress.GetAddedSongs()
+			progress.RemovedSongs += runProgress.GetRemovedSongs()
+			progress.LowConfidenceSongs += runProgress.GetLowConfidenceSongs()
+			unmatchedSongs = append(unmatchedSongs, syncRun.GetUnmatchedSongs()...)
+		}
+
+		switch item.GetStatus() {
+		case myncer_pb.SyncStatus_SYNC_STATUS_COMPLETED:
+			numCompleted++
+		case myncer_pb.SyncStatus_SYNC_STATUS_FAILED:
+			numFailed++
+		case myncer_pb.SyncStatus_SYNC_STATUS_CANCELLED:
+		case myncer_pb.SyncStatus_SYNC_STATUS_RUNNING:
+			numUnfinished++
+		default:
+			numPending++
+			numUnfinished++
+		}
+	}
+
+	switch {
+	case numPending > 0 && numPending == len(transfer.GetItems()):
+		transfer.Status = myncer_pb.SyncStatus_SYNC_STATUS_PENDING
+	case numUnfinished > 0:
+		transfer.Status = myncer_pb.SyncStatus_SYNC_STATUS_RUNNING
+	case numCompleted == len(transfer.GetItems()):
+		transfer.Status = myncer_pb.SyncStatus_SYNC_STATUS_COMPLETED
+	case numFailed > 0:
+		transfer.Status = myncer_pb.SyncStatus_SYNC_STATUS_FAILED
+	default:
+		transfer.Status = myncer_pb.SyncStatus_SYNC_STATUS_CANCELLED
+	}
+	transfer.Progress = progress
+	transfer.UnmatchedSongs = unmatchedSongs
+}
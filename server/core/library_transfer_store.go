@@ -0,0 +1,136 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type LibraryTransferStore interface {
+	AddLibraryTransfer(ctx context.Context, transfer *myncer_pb.LibraryTransfer /*const*/) error
+	UpdateLibraryTransfer(ctx context.Context, transfer *myncer_pb.LibraryTransfer /*const*/) error
+	GetLibraryTransfer(ctx context.Context, id string) (*myncer_pb.LibraryTransfer, error)
+	// Returns the transfers of the user, newest first.
+	GetLibraryTransfers(ctx context.Context, userId string) ([]*myncer_pb.LibraryTransfer, error)
+}
+
+func NewLibraryTransferStore(db *sql.DB /*const*/) LibraryTransferStore {
+	return &libraryTransferStoreImpl{db: db}
+}
+
+type libraryTransferStoreImpl struct {
+	db *sql.DB
+}
+
+var _ LibraryTransferStore = (*libraryTransferStoreImpl)(nil)
+
+func (s *libraryTransferStoreImpl) AddLibraryTransfer(
+	ctx context.Context,
+	transfer *myncer_pb.LibraryTransfer, /*const*/
+) error {
+	protoBytes, err := proto.Marshal(transfer)
+	if err != nil {
+		return WrappedError(err, "failed to marshal library transfer proto")
+	}
+	if _, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO library_transfers (id, user_id, data) VALUES ($1, $2, $3)`,
+		transfer.GetId(),
+		transfer.GetUserId(),
+		protoBytes,
+	); err != nil {
+		return WrappedError(err, "failed to add library transfer into sql")
+	}
+	return nil
+}
+
+func (s *libraryTransferStoreImpl) UpdateLibraryTransfer(
+	ctx context.Context,
+	transfer *myncer_pb.LibraryTransfer, /*const*/
+) error {
+	protoBytes, err := proto.Marshal(transfer)
+	if err != nil {
+		return WrappedError(err, "failed to marshal library transfer proto")
+	}
+	res, err := s.db.ExecContext(
+		ctx,
+		`UPDATE library_transfers SET data = $1, updated_at = $2 WHERE id = $3`,
+		protoBytes,
+		time.Now(),
+		transfer.GetId(),
+	)
+	if err != nil {
+		return WrappedError(err, "failed to update library transfer in sql")
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return NewError("library transfer not found")
+	}
+	return nil
+}
+
+func (s *libraryTransferStoreImpl) GetLibraryTransfer(
+	ctx context.Context,
+	id string,
+) (*myncer_pb.LibraryTransfer, error) {
+	transfer, err := scanLibraryTransfer(
+		s.db.QueryRowContext(
+			ctx,
+			`SELECT data, created_at, updated_at FROM library_transfers WHERE id = $1`,
+			id,
+		),
+	)
+	if err == sql.ErrNoRows {
+		return nil, NewError("library transfer not found")
+	}
+	if err != nil {
+		return nil, WrappedError(err, "failed to get library transfer from sql")
+	}
+	return transfer, nil
+}
+
+func (s *libraryTransferStoreImpl) GetLibraryTransfers(
+	ctx context.Context,
+	userId string,
+) ([]*myncer_pb.LibraryTransfer, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT data, created_at, updated_at FROM library_transfers WHERE user_id = $1 ORDER BY created_at DESC`,
+		userId,
+	)
+	if err != nil {
+		return nil, WrappedError(err, "failed to query library transfers from sql")
+	}
+	defer rows.Close()
+
+	r := []*myncer_pb.LibraryTransfer{}
+	for rows.Next() {
+		transfer, err := scanLibraryTransfer(rows)
+		if err != nil {
+			return nil, WrappedError(err, "failed to scan library transfer row")
+		}
+		r = append(r, transfer)
+	}
+	return r, rows.Err()
+}
+
+func scanLibraryTransfer(row interface{ Scan(dest ...any) error }) (*myncer_pb.LibraryTransfer, error) {
+	var (
+		protoBytes []byte
+		createdAt  time.Time
+		updatedAt  time.Time
+		transfer   myncer_pb.LibraryTransfer
+	)
+	if err := row.Scan(&protoBytes, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(protoBytes, &transfer); err != nil {
+		return nil, WrappedError(err, "failed to unmarshal library transfer proto")
+	}
+	transfer.CreatedAt = timestamppb.New(createdAt)
+	transfer.UpdatedAt = timestamppb.New(updatedAt)
+	return &transfer, nil
+}
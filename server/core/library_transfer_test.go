@@ -0,0 +1,103 @@
+package core
+
+import (
+	"testing"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFillLibraryTransferProgress(t *testing.T) {
+	newSyncRun := func(status myncer_pb.SyncStatus, addedSongs int32, unmatchedSongs ...string) *myncer_pb.SyncRun {
+		syncRun := &myncer_pb.SyncRun{
+			SyncStatus: status,
+			Progress:   &myncer_pb.SyncRunProgress{AddedSongs: addedSongs},
+		}
+		for _, name := range unmatchedSongs {
+			syncRun.UnmatchedSongs = append(syncRun.UnmatchedSongs, &myncer_pb.Song{Name: name})
+		}
+		return syncRun
+	}
+
+	testCases := []struct {
+		name                   string
+		items                  []*myncer_pb.LibraryTransferItem
+		syncRuns               map[string]*myncer_pb.SyncRun
+		expectedStatus         myncer_pb.SyncStatus
+		expectedAddedSongs     int32
+		expectedUnmatchedSongs int
+	}{
+		{
+			name:  "every run pending",
+			items: []*myncer_pb.LibraryTransferItem{{RunId: "1"}, {RunId: "2"}},
+			syncRuns: map[string]*myncer_pb.SyncRun{
+				"1": newSyncRun(myncer_pb.SyncStatus_SYNC_STATUS_PENDING, 0),
+				"2": newSyncRun(myncer_pb.SyncStatus_SYNC_STATUS_PENDING, 0),
+			},
+			expectedStatus: myncer_pb.SyncStatus_SYNC_STATUS_PENDING,
+		},
+		{
+			name:  "some runs finished",
+			items: []*myncer_pb.LibraryTransferItem{{RunId: "1"}, {RunId: "2"}},
+			syncRuns: map[string]*myncer_pb.SyncRun{
+				"1": newSyncRun(myncer_pb.SyncStatus_SYNC_STATUS_COMPLETED, 3, "a"),
+				"2": newSyncRun(myncer_pb.SyncStatus_SYNC_STATUS_PENDING, 0),
+			},
+			expectedStatus:         myncer_pb.SyncStatus_SYNC_STATUS_RUNNING,
+			expectedAddedSongs:     3,
+			expectedUnmatchedSongs: 1,
+		},
+		{
+			name:  "every run completed",
+			items: []*myncer_pb.LibraryTransferItem{{RunId: "1"}, {RunId: "2"}},
+			syncRuns: map[string]*myncer_pb.SyncRun{
+				"1": newSyncRun(myncer_pb.SyncStatus_SYNC_STATUS_COMPLETED, 3, "a"),
+				"2": newSyncRun(myncer_pb.SyncStatus_SYNC_STATUS_COMPLETED, 4, "b", "c"),
+			},
+			expectedStatus:         myncer_pb.SyncStatus_SYNC_STATUS_COMPLETED,
+			expectedAddedSongs:     7,
+			expectedUnmatchedSongs: 3,
+		},
+		{
+			name: "playlist that could not be set up",
+			items: []*myncer_pb.LibraryTransferItem{
+				{RunId: "1"},
+				{ErrorMessage: "failed to create playlist"},
+			},
+			syncRuns: map[string]*myncer_pb.SyncRun{
+				"1": newSyncRun(myncer_pb.SyncStatus_SYNC_STATUS_COMPLETED, 3),
+			},
+			expectedStatus:     myncer_pb.SyncStatus_SYNC_STATUS_FAILED,
+			expectedAddedSongs: 3,
+		},
+		{
+			name: "playlists still being set up",
+			items: []*myncer_pb.LibraryTransferItem{
+				{RunId: "1"},
+				{},
+			},
+			syncRuns: map[string]*myncer_pb.SyncRun{
+				"1": newSyncRun(myncer_pb.SyncStatus_SYNC_STATUS_COMPLETED, 3),
+			},
+			expectedStatus:     myncer_pb.SyncStatus_SYNC_STATUS_RUNNING,
+			expectedAddedSongs: 3,
+		},
+		{
+			name:           "run of a deleted sync",
+			items:          []*myncer_pb.LibraryTransferItem{{RunId: "1"}},
+			expectedStatus: myncer_pb.SyncStatus_SYNC_STATUS_FAILED,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(
+			tt.name,
+			func(t *testing.T) {
+				transfer := &myncer_pb.LibraryTransfer{Items: tt.items}
+				FillLibraryTransferProgress(transfer, tt.syncRuns)
+				assert.Equal(t, tt.expectedStatus, transfer.GetStatus())
+				assert.Equal(t, tt.expectedAddedSongs, transfer.GetProgress().GetAddedSongs())
+				assert.Len(t, transfer.GetUnmatchedSongs(), tt.expectedUnmatchedSongs)
+			},
+		)
+	}
+}
@@ -0,0 +1,132 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+// A set of held Postgres advisory locks.
+type Lock interface {
+	Unlock(ctx context.Context) error
+}
+
+type LockStore interface {
+	// Tries to acquire advisory locks on all the keys without waiting.
+	// Either all locks are acquired or none are, in which case nil is returned.
+	// Locks are held until unlocked or the server loses its database connection, so they are safe to
+	// use across replicas.
+	TryLock(ctx context.Context, keys ...string) (Lock /*@nullable*/, error)
+}
+
+func NewLockStore(db *sql.DB) LockStore {
+	return &lockStoreImpl{db: db}
+}
+
+type lockStoreImpl struct {
+	db *sql.DB
+}
+
+var _ LockStore = (*lockStoreImpl)(nil)
+
+func (l *lockStoreImpl) TryLock(ctx context.Context, keys ...string) (Lock /*@nullable*/, error) {
+	// Session level advisory locks belong to a connection so one is pinned for the lifetime of the lock.
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, WrappedError(err, "failed to get database connection for lock")
+	}
+	lock := &lockImpl{conn: conn}
+	for _, key := range keys {
+		var acquired bool
+		if err := conn.QueryRowContext(
+			ctx,
+			`SELECT pg_try_advisory_lock(hashtextextended($1, 0))`,
+			key,
+		).Scan(&acquired); err != nil {
+			lock.Unlock(ctx)
+			return nil, WrappedError(err, "failed to acquire lock %s", key)
+		}
+		if !acquired {
+			if err := lock.Unlock(ctx); err != nil {
+				return nil, err
+			}
+			return nil, nil
+		}
+		lock.keys = append(lock.keys, key)
+	}
+	return lock, nil
+}
+
+type lockImpl struct {
+	conn *sql.Conn
+	keys []string
+}
+
+func (l *lockImpl) Unlock(ctx context.Context) error {
+	defer l.conn.Close()
+	for _, key := range l.keys {
+		if _, err := l.conn.ExecContext(
+			ctx,
+			`SELECT pg_advisory_unlock(hashtextextended($1, 0))`,
+			key,
+		); err != nil {
+			// Closing the connection below releases the lock anyway.
+			return WrappedError(err, "failed to release lock %s", key)
+		}
+	}
+	return nil
+}
+
+func GetSyncLockKey(syncId string) string {
+	return fmt.Sprintf("sync:%s", syncId)
+}
+
+// Serializes enqueueing runs of the sync. Kept apart from GetSyncLockKey, which is held for the
+// whole run.
+func GetSyncEnqueueLockKey(syncId string) string {
+	return fmt.Sprintf("enqueue:sync:%s", syncId)
+}
+
+func GetPlaylistLockKey(musicSource *myncer_pb.MusicSource /*const*/) string {
+	if IsLibraryCollection(musicSource) {
+		return fmt.Sprintf("collection:%s:%s", musicSource.GetDatasource(), musicSource.GetKind())
+	}
+	return fmt.Sprintf("playlist:%s:%s", musicSource.GetDatasource(), musicSource.GetPlaylistId())
+}
+
+// GetSyncDestinations returns the playlists a sync writes to.
+func GetSyncDestinations(sync *myncer_pb.Sync /*const*/) []*myncer_pb.MusicSource {
+	switch v := sync.GetSyncVariant().(type) {
+	case *myncer_pb.Sync_OneWaySync:
+		return []*myncer_pb.MusicSource{v.OneWaySync.GetDestination()}
+	case *myncer_pb.Sync_PlaylistMergeSync:
+		return GetPlaylistMergeSyncTargets(v.PlaylistMergeSync)
+	case *myncer_pb.Sync_FanOutSync:
+		return v.FanOutSync.GetDestinations()
+	default:
+		return nil
+	}
+}
+
+// GetPlaylistMergeSyncTargets returns the playlists a merge sync writes the merged songs to.
+func GetPlaylistMergeSyncTargets(
+	mergeSync *myncer_pb.PlaylistMergeSync, /*const*/
+) []*myncer_pb.MusicSource {
+	if mergeSync.GetMode() == myncer_pb.PlaylistMergeSyncMode_PLAYLIST_MERGE_SYNC_MODE_UNSPECIFIED {
+		return []*myncer_pb.MusicSource{mergeSync.GetDestination()}
+	}
+	targets := []*myncer_pb.MusicSource{}
+	seen := NewSet[string]()
+	for _, target := range append(mergeSync.GetSources(), mergeSync.GetDestination()) {
+		// The destination is optional when writing back to the sources.
+		isUnset := target.GetPlaylistId() == "" && !IsLibraryCollection(target)
+		if isUnset || seen.Contains(GetPlaylistLockKey(target)) {
+			continue
+		}
+		seen.Add(GetPlaylistLockKey(target))
+		targets = append(targets, target)
+	}
+	return targets
+}
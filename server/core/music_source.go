@@ -0,0 +1,32 @@
+package core
+
+import (
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+// Returns whether the music source is a library collection rather than a playlist.
+func IsLibraryCollection(musicSource *myncer_pb.MusicSource /*const*/) bool {
+	return musicSource.GetKind() != myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_UNSPECIFIED
+}
+
+// Returns whether the datasource of the music source has the collection it refers to.
+func IsMusicSourceSupported(musicSource *myncer_pb.MusicSource /*const*/) bool {
+	return !(musicSource.GetKind() == myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_SAVED_ALBUMS &&
+		musicSource.GetDatasource() == myncer_pb.Datasource_DATASOURCE_YOUTUBE)
+}
+
+// Returns whether songs can be added to and removed from the music source.
+func IsMusicSourceWritable(musicSource *myncer_pb.MusicSource /*const*/) bool {
+	return musicSource.GetKind() != myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_SAVED_ALBUMS
+}
+
+// Returns whether the songs of the music source can be put in a different order.
+// Library collections are ordered by the datasource.
+func IsMusicSourceReorderable(musicSource *myncer_pb.MusicSource /*const*/) bool {
+	return !IsLibraryCollection(musicSource)
+}
+
+// Returns the error for an operation the music source doesn't support.
+func NewUnsupportedMusicSourceError(musicSource *myncer_pb.MusicSource /*const*/, operation string) error {
+	return NewError("%s is not supported for %v on %v", operation, musicSource.GetKind(), musicSource.GetDatasource())
+}
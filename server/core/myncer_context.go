@@ -12,7 +12,7 @@ type MyncerCtx struct {
 	DB                *Database          /*const*/
 	DatasourceClients *DatasourceClients /*const*/
 	Config            *myncer_pb.Config  /*const*/
-	LlmClient         LlmClient          /*@nullable*/       // nil if LLM is disabled
+	LlmClient         LlmClient          /*@nullable*/ // nil if LLM is disabled
 }
 
 type DatasourceClients struct {
@@ -21,6 +21,19 @@ type DatasourceClients struct {
 	TidalClient   DatasourceClient
 }
 
+func (d *DatasourceClients) GetClient(datasource myncer_pb.Datasource) (DatasourceClient, error) {
+	switch datasource {
+	case myncer_pb.Datasource_DATASOURCE_SPOTIFY:
+		return d.SpotifyClient, nil
+	case myncer_pb.Datasource_DATASOURCE_YOUTUBE:
+		return d.YoutubeClient, nil
+	case myncer_pb.Datasource_DATASOURCE_TIDAL:
+		return d.TidalClient, nil
+	default:
+		return nil, NewError("unsupported datasource: %v", datasource)
+	}
+}
+
 type LlmClients struct {
 	GeminiLlmClient LlmClient
 	OpenAILlmClient LlmClient
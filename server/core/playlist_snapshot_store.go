@@ -0,0 +1,126 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PlaylistSnapshotStore interface {
+	AddPlaylistSnapshot(ctx context.Context, snapshot *myncer_pb.PlaylistSnapshot /*const*/) error
+	GetPlaylistSnapshot(ctx context.Context, id string) (*myncer_pb.PlaylistSnapshot, error)
+	// Returns the snapshots of the user, newest first.
+	GetPlaylistSnapshots(
+		ctx context.Context,
+		userId string,
+		playlist *myncer_pb.MusicSource, /*const,@nullable*/ // nil indicates no filtering
+		runId string, // empty indicates no filtering
+	) ([]*myncer_pb.PlaylistSnapshot, error)
+}
+
+func NewPlaylistSnapshotStore(db *sql.DB /*const*/) PlaylistSnapshotStore {
+	return &playlistSnapshotStoreImpl{db: db}
+}
+
+type playlistSnapshotStoreImpl struct {
+	db *sql.DB
+}
+
+var _ PlaylistSnapshotStore = (*playlistSnapshotStoreImpl)(nil)
+
+func (s *playlistSnapshotStoreImpl) AddPlaylistSnapshot(
+	ctx context.Context,
+	snapshot *myncer_pb.PlaylistSnapshot, /*const*/
+) error {
+	protoBytes, err := proto.Marshal(snapshot)
+	if err != nil {
+		return WrappedError(err, "failed to marshal playlist snapshot proto")
+	}
+	if _, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO playlist_snapshots (id, user_id, playlist_key, run_id, data)
+		VALUES ($1, $2, $3, NULLIF($4, '')::uuid, $5)`,
+		snapshot.GetId(),
+		snapshot.GetUserId(),
+		GetPlaylistLockKey(snapshot.GetPlaylist()),
+		snapshot.GetRunId(),
+		protoBytes,
+	); err != nil {
+		return WrappedError(err, "failed to add playlist snapshot into sql")
+	}
+	return nil
+}
+
+func (s *playlistSnapshotStoreImpl) GetPlaylistSnapshot(
+	ctx context.Context,
+	id string,
+) (*myncer_pb.PlaylistSnapshot, error) {
+	snapshot, err := scanPlaylistSnapshot(
+		s.db.QueryRowContext(ctx, `SELECT data, created_at FROM playlist_snapshots WHERE id = $1`, id),
+	)
+	if err == sql.ErrNoRows {
+		return nil, NewError("playlist snapshot not found")
+	}
+	if err != nil {
+		return nil, WrappedError(err, "failed to get playlist snapshot from sql")
+	}
+	return snapshot, nil
+}
+
+func (s *playlistSnapshotStoreImpl) GetPlaylistSnapshots(
+	ctx context.Context,
+	userId string,
+	playlist *myncer_pb.MusicSource, /*const,@nullable*/
+	runId string,
+) ([]*myncer_pb.PlaylistSnapshot, error) {
+	conditions := []string{"user_id = $1"}
+	args := []any{userId}
+	if playlist != nil {
+		args = append(args, GetPlaylistLockKey(playlist))
+		conditions = append(conditions, fmt.Sprintf("playlist_key = $%d", len(args)))
+	}
+	if runId != "" {
+		args = append(args, runId)
+		conditions = append(conditions, fmt.Sprintf("run_id = $%d", len(args)))
+	}
+	rows, err := s.db.QueryContext(
+		ctx,
+		"SELECT data, created_at FROM playlist_snapshots"+makeWhereAnd(conditions)+" ORDER BY created_at DESC",
+		args...,
+	)
+	if err != nil {
+		return nil, WrappedError(err, "failed to query playlist snapshots from sql")
+	}
+	defer rows.Close()
+
+	r := []*myncer_pb.PlaylistSnapshot{}
+	for rows.Next() {
+		snapshot, err := scanPlaylistSnapshot(rows)
+		if err != nil {
+			return nil, WrappedError(err, "failed to scan playlist snapshot row")
+		}
+		r = append(r, snapshot)
+	}
+	return r, rows.Err()
+}
+
+func scanPlaylistSnapshot(row interface{ Scan(dest ...any) error }) (*myncer_pb.PlaylistSnapshot, error) {
+	var (
+		protoBytes []byte
+		createdAt  time.Time
+		snapshot   myncer_pb.PlaylistSnapshot
+	)
+	if err := row.Scan(&protoBytes, &createdAt); err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(protoBytes, &snapshot); err != nil {
+		return nil, WrappedError(err, "failed to unmarshal playlist snapshot proto")
+	}
+	snapshot.CreatedAt = timestamppb.New(createdAt)
+	return &snapshot, nil
+}
@@ -0,0 +1,97 @@
+package core
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+const (
+	cDefaultInitialBackoff = 30 * time.Second
+	cDefaultMaxBackoff     = time.Hour
+	// Upper bound on attempts to keep a misconfigured sync from hammering datasources.
+	CMaxSyncRunAttempts = 10
+)
+
+// RetryableError marks errors that may go away if the operation is retried, such as rate limits or
+// server errors from datasources.
+// Errors that are not wrapped in a RetryableError are considered permanent.
+type RetryableError struct {
+	err error
+}
+
+func NewRetryableError(err error) error {
+	return &RetryableError{err: err}
+}
+
+func (r *RetryableError) Error() string {
+	return r.err.Error()
+}
+
+func (r *RetryableError) Unwrap() error {
+	return r.err
+}
+
+func IsRetryableError(err error) bool {
+	var retryableErr *RetryableError
+	return errors.As(err, &retryableErr)
+}
+
+// IsRetryableHttpStatus returns true for rate limiting and server side failures.
+func IsRetryableHttpStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// GetMaxSyncRunAttempts returns how many times a sync run is attempted in total under the policy.
+func GetMaxSyncRunAttempts(policy *myncer_pb.RetryPolicy /*const,@nullable*/) int {
+	if policy.GetMaxAttempts() <= 0 {
+		// No policy means no retries.
+		return 1
+	}
+	return min(int(policy.GetMaxAttempts()), CMaxSyncRunAttempts)
+}
+
+// GetRetryBackoff returns how long to wait before retrying after `attempt` (1-indexed) failed.
+// The backoff doubles with each attempt up to the policy's maximum, and half of it is randomized so
+// that syncs which failed together don't retry together.
+func GetRetryBackoff(
+	policy *myncer_pb.RetryPolicy, /*const,@nullable*/
+	attempt int,
+	randInt64N func(n int64) int64, // e.g. rand.Int64N
+) time.Duration {
+	initialBackoff := cDefaultInitialBackoff
+	if policy.GetInitialBackoffSeconds() > 0 {
+		initialBackoff = time.Duration(policy.GetInitialBackoffSeconds()) * time.Second
+	}
+	maxBackoff := cDefaultMaxBackoff
+	if policy.GetMaxBackoffSeconds() > 0 {
+		maxBackoff = time.Duration(policy.GetMaxBackoffSeconds()) * time.Second
+	}
+
+	backoff := initialBackoff
+	for i := 1; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, maxBackoff)
+	return backoff/2 + time.Duration(randInt64N(int64(backoff/2)+1))
+}
+
+// ValidateRetryPolicy checks a user provided retry policy.
+func ValidateRetryPolicy(policy *myncer_pb.RetryPolicy /*const,@nullable*/) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.GetMaxAttempts() < 0 || policy.GetMaxAttempts() > CMaxSyncRunAttempts {
+		return NewError("max attempts must be between 0 and %d", CMaxSyncRunAttempts)
+	}
+	if policy.GetInitialBackoffSeconds() < 0 || policy.GetMaxBackoffSeconds() < 0 {
+		return NewError("backoff must not be negative")
+	}
+	if policy.GetMaxBackoffSeconds() > 0 &&
+		policy.GetInitialBackoffSeconds() > policy.GetMaxBackoffSeconds() {
+		return NewError("initial backoff must not exceed max backoff")
+	}
+	return nil
+}
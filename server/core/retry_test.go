@@ -0,0 +1,56 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRetryBackoff(t *testing.T) {
+	// Always picks the largest jitter so the expected backoff is the full doubled value.
+	maxJitter := func(n int64) int64 { return n - 1 }
+	testCases := []struct {
+		name     string
+		policy   *myncer_pb.RetryPolicy
+		attempt  int
+		expected time.Duration
+	}{
+		{
+			name:     "default policy first attempt",
+			policy:   nil,
+			attempt:  1,
+			expected: 30 * time.Second,
+		},
+		{
+			name:     "doubles per attempt",
+			policy:   &myncer_pb.RetryPolicy{InitialBackoffSeconds: 10, MaxBackoffSeconds: 600},
+			attempt:  3,
+			expected: 40 * time.Second,
+		},
+		{
+			name:     "capped at max backoff",
+			policy:   &myncer_pb.RetryPolicy{InitialBackoffSeconds: 10, MaxBackoffSeconds: 60},
+			attempt:  8,
+			expected: 60 * time.Second,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(
+			tt.name,
+			func(t *testing.T) {
+				assert.Equal(t, tt.expected, GetRetryBackoff(tt.policy, tt.attempt, maxJitter))
+				// Without jitter only half of the backoff remains.
+				noJitter := func(int64) int64 { return 0 }
+				assert.Equal(t, tt.expected/2, GetRetryBackoff(tt.policy, tt.attempt, noJitter))
+			},
+		)
+	}
+}
+
+func TestGetMaxSyncRunAttempts(t *testing.T) {
+	assert.Equal(t, 1, GetMaxSyncRunAttempts(nil))
+	assert.Equal(t, 3, GetMaxSyncRunAttempts(&myncer_pb.RetryPolicy{MaxAttempts: 3}))
+	assert.Equal(t, CMaxSyncRunAttempts, GetMaxSyncRunAttempts(&myncer_pb.RetryPolicy{MaxAttempts: 100}))
+}
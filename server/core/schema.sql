@@ -28,6 +28,11 @@ CREATE TABLE IF NOT EXISTS syncs (
   updated_at TIMESTAMPTZ DEFAULT now()
 );
 
+-- Mirrors Sync.schedule.next_run_at so the scheduler can find due syncs.
+-- NULL for syncs that are only run manually.
+ALTER TABLE syncs ADD COLUMN IF NOT EXISTS next_run_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS syncs_next_run_at_idx ON syncs (next_run_at);
+
 CREATE TABLE IF NOT EXISTS sync_runs (
   run_id UUID PRIMARY KEY,
   sync_id UUID NOT NULL REFERENCES syncs(id) ON DELETE CASCADE,
@@ -37,6 +42,50 @@ CREATE TABLE IF NOT EXISTS sync_runs (
   updated_at TIMESTAMPTZ DEFAULT now()
 );
 
+CREATE TABLE IF NOT EXISTS sync_jobs (
+  id UUID PRIMARY KEY,
+  -- The sync run this job executes.
+  run_id UUID NOT NULL UNIQUE REFERENCES sync_runs(run_id) ON DELETE CASCADE,
+  sync_id UUID NOT NULL REFERENCES syncs(id) ON DELETE CASCADE,
+  -- The user the sync is run on behalf of.
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  -- One of: queued, running, completed, failed, cancelled.
+  status VARCHAR(32) NOT NULL,
+  -- Number of times a worker has claimed this job.
+  attempts INT NOT NULL DEFAULT 0,
+  -- The job is not claimed by workers before this time.
+  available_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  -- The worker currently running the job.
+  worker_id VARCHAR(256),
+  -- Last time the worker reported it is still running the job.
+  heartbeat_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS sync_jobs_status_available_at_idx ON sync_jobs (status, available_at);
+-- Set when a user asks for the run to stop. Picked up by the worker on its next heartbeat.
+ALTER TABLE sync_jobs ADD COLUMN IF NOT EXISTS cancel_requested BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS sync_run_events (
+  -- Increasing so that watchers can resume after the last event they saw.
+  id BIGSERIAL PRIMARY KEY,
+  run_id UUID NOT NULL REFERENCES sync_runs(run_id) ON DELETE CASCADE,
+  -- Source of truth: Serialized SyncRunEvent proto.
+  data BYTEA NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS sync_run_events_run_id_id_idx ON sync_run_events (run_id, id);
+
+CREATE TABLE IF NOT EXISTS sync_baselines (
+  sync_id UUID PRIMARY KEY REFERENCES syncs(id) ON DELETE CASCADE,
+  -- Source of truth: Serialized SyncBaseline proto.
+  data BYTEA NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
 CREATE TABLE IF NOT EXISTS songs (
   -- Unique myncer song id.
   id UUID PRIMARY KEY,
@@ -51,3 +100,55 @@ CREATE TABLE IF NOT EXISTS songs (
   created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
   updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
 );
+
+CREATE TABLE IF NOT EXISTS playlist_snapshots (
+  id UUID PRIMARY KEY,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  -- Playlist lock key of the playlist, see GetPlaylistLockKey.
+  playlist_key VARCHAR(512) NOT NULL,
+  -- Not a foreign key so that snapshots outlive the runs that took them.
+  run_id UUID,
+  -- Source of truth: Serialized PlaylistSnapshot proto.
+  data BYTEA NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS playlist_snapshots_user_id_created_at_idx ON playlist_snapshots (user_id, created_at);
+
+CREATE TABLE IF NOT EXISTS library_transfers (
+  id UUID PRIMARY KEY,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  -- Source of truth: Serialized LibraryTransfer proto.
+  data BYTEA NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS library_transfers_user_id_created_at_idx ON library_transfers (user_id, created_at);
+
+CREATE TABLE IF NOT EXISTS song_resolutions (
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  -- Datasource and datasource song id of the song that was searched for.
+  source_datasource VARCHAR(256) NOT NULL,
+  source_song_id VARCHAR(256) NOT NULL,
+  -- Datasource the song was searched for on.
+  destination_datasource VARCHAR(256) NOT NULL,
+  -- Source of truth: Serialized SongResolution proto.
+  data BYTEA NOT NULL,
+  resolved_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (user_id, source_datasource, source_song_id, destination_datasource)
+);
+
+CREATE TABLE IF NOT EXISTS song_overrides (
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  -- Datasource and datasource song id of the overridden song.
+  source_datasource VARCHAR(256) NOT NULL,
+  source_song_id VARCHAR(256) NOT NULL,
+  -- Datasource the override applies to.
+  destination_datasource VARCHAR(256) NOT NULL,
+  -- Source of truth: Serialized SongOverride proto.
+  data BYTEA NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (user_id, source_datasource, source_song_id, destination_datasource)
+);
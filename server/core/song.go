@@ -1,7 +1,6 @@
 package core
 
 import (
-	"context"
 	"encoding/json"
 	"strings"
 
@@ -15,14 +14,16 @@ type Song interface {
 	GetArtistNames() []string
 	GetAlbum() string
 	GetId() string
-	GetIdByDatasource(
-		ctx context.Context,
-		userInfo *myncer_pb.User, /*const*/
-		datasource myncer_pb.Datasource,
-	) (string, error)
 	GetSpec() *myncer_pb.Song
 }
 
+// A song found by searching a datasource.
+type SearchCandidate struct {
+	Song Song
+	// How similar the song is to the song searched for, from 0 to 100.
+	Score float64
+}
+
 func NewSongList(songs []Song /*const*/) *SongList {
 	return &SongList{songs: songs}
 }
@@ -47,6 +48,14 @@ func (sl *SongList) GetSongs() []Song {
 	return sl.songs
 }
 
+func (sl *SongList) GetSpecs() []*myncer_pb.Song {
+	r := []*myncer_pb.Song{}
+	for _, s := range sl.songs {
+		r = append(r, s.GetSpec())
+	}
+	return r
+}
+
 // GetSongId returns a deterministic UUID hash of the song name, artist(s), and album name.
 func GetSongId(
 	songName string,
@@ -60,3 +69,35 @@ func GetSongId(
 	// Use a fixed namespace UUID (can be any UUID — here we use the URL namespace).
 	return uuid.NewSHA1(uuid.NameSpaceURL, data).String()
 }
+
+// DiffSongSpecs returns the songs `after` has more often than `before` and the songs `before` has
+// more often than `after`.
+// Songs are compared by datasource and datasource song id.
+func DiffSongSpecs(
+	before []*myncer_pb.Song, /*const*/
+	after []*myncer_pb.Song, /*const*/
+) (added []*myncer_pb.Song, removed []*myncer_pb.Song) {
+	getKey := func(song *myncer_pb.Song) string {
+		return song.GetDatasource().String() + ":" + song.GetDatasourceSongId()
+	}
+	unmatchedBefore := map[string]int{}
+	for _, song := range before {
+		unmatchedBefore[getKey(song)]++
+	}
+	added = []*myncer_pb.Song{}
+	for _, song := range after {
+		if unmatchedBefore[getKey(song)] > 0 {
+			unmatchedBefore[getKey(song)]--
+			continue
+		}
+		added = append(added, song)
+	}
+	removed = []*myncer_pb.Song{}
+	for _, song := range before {
+		if unmatchedBefore[getKey(song)] > 0 {
+			unmatchedBefore[getKey(song)]--
+			removed = append(removed, song)
+		}
+	}
+	return added, removed
+}
@@ -0,0 +1,165 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SongOverrideStore interface {
+	// Replaces the existing override of the source song for the destination datasource, if any.
+	SetSongOverride(ctx context.Context, songOverride *myncer_pb.SongOverride /*const*/) error
+	// Returns nil if the song has no override for the datasource.
+	GetSongOverride(
+		ctx context.Context,
+		userId string,
+		sourceSong *myncer_pb.Song, /*const*/
+		destinationDatasource myncer_pb.Datasource,
+	) (*myncer_pb.SongOverride /*@nullable*/, error)
+	// Returns the overrides of the user, most recently updated first.
+	GetSongOverrides(ctx context.Context, userId string) ([]*myncer_pb.SongOverride, error)
+	DeleteSongOverride(
+		ctx context.Context,
+		userId string,
+		sourceSong *myncer_pb.Song, /*const*/
+		destinationDatasource myncer_pb.Datasource,
+	) error
+}
+
+func NewSongOverrideStore(db *sql.DB /*const*/) SongOverrideStore {
+	return &songOverrideStoreImpl{db: db}
+}
+
+type songOverrideStoreImpl struct {
+	db *sql.DB
+}
+
+var _ SongOverrideStore = (*songOverrideStoreImpl)(nil)
+
+func (s *songOverrideStoreImpl) SetSongOverride(
+	ctx context.Context,
+	songOverride *myncer_pb.SongOverride, /*const*/
+) error {
+	protoBytes, err := proto.Marshal(songOverride)
+	if err != nil {
+		return WrappedError(err, "failed to marshal song override proto")
+	}
+	if _, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO song_overrides
+		(user_id, source_datasource, source_song_id, destination_datasource, data)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, source_datasource, source_song_id, destination_datasource)
+		DO UPDATE SET data = EXCLUDED.data, updated_at = now()`,
+		songOverride.GetUserId(),
+		songOverride.GetSourceSong().GetDatasource().String(),
+		songOverride.GetSourceSong().GetDatasourceSongId(),
+		songOverride.GetDestinationDatasource().String(),
+		protoBytes,
+	); err != nil {
+		return WrappedError(err, "failed to set song override in sql")
+	}
+	return nil
+}
+
+func (s *songOverrideStoreImpl) GetSongOverride(
+	ctx context.Context,
+	userId string,
+	sourceSong *myncer_pb.Song, /*const*/
+	destinationDatasource myncer_pb.Datasource,
+) (*myncer_pb.SongOverride /*@nullable*/, error) {
+	songOverride, err := scanSongOverride(
+		s.db.QueryRowContext(
+			ctx,
+			`SELECT data, created_at, updated_at FROM song_overrides
+			WHERE user_id = $1 AND source_datasource = $2 AND source_song_id = $3 AND destination_datasource = $4`,
+			userId,
+			sourceSong.GetDatasource().String(),
+			sourceSong.GetDatasourceSongId(),
+			destinationDatasource.String(),
+		),
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, WrappedError(err, "failed to get song override from sql")
+	}
+	return songOverride, nil
+}
+
+func (s *songOverrideStoreImpl) GetSongOverrides(
+	ctx context.Context,
+	userId string,
+) ([]*myncer_pb.SongOverride, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT data, created_at, updated_at FROM song_overrides WHERE user_id = $1 ORDER BY updated_at DESC`,
+		userId,
+	)
+	if err != nil {
+		return nil, WrappedError(err, "failed to query song overrides from sql")
+	}
+	defer rows.Close()
+
+	r := []*myncer_pb.SongOverride{}
+	for rows.Next() {
+		songOverride, err := scanSongOverride(rows)
+		if err != nil {
+			return nil, WrappedError(err, "failed to scan song override row")
+		}
+		r = append(r, songOverride)
+	}
+	return r, rows.Err()
+}
+
+func (s *songOverrideStoreImpl) DeleteSongOverride(
+	ctx context.Context,
+	userId string,
+	sourceSong *myncer_pb.Song, /*const*/
+	destinationDatasource myncer_pb.Datasource,
+) error {
+	res, err := s.db.ExecContext(
+		ctx,
+		`DELETE FROM song_overrides
+		WHERE user_id = $1 AND source_datasource = $2 AND source_song_id = $3 AND destination_datasource = $4`,
+		userId,
+		sourceSong.GetDatasource().String(),
+		sourceSong.GetDatasourceSongId(),
+		destinationDatasource.String(),
+	)
+	if err != nil {
+		return WrappedError(err, "failed to delete song override from sql")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return WrappedError(err, "failed to check rows affected after delete")
+	}
+	if rowsAffected == 0 {
+		return NewError("song override not found")
+	}
+	return nil
+}
+
+func scanSongOverride(row interface{ Scan(dest ...any) error }) (*myncer_pb.SongOverride, error) {
+	var (
+		protoBytes   []byte
+		createdAt    time.Time
+		updatedAt    time.Time
+		songOverride myncer_pb.SongOverride
+	)
+	if err := row.Scan(&protoBytes, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(protoBytes, &songOverride); err != nil {
+		return nil, WrappedError(err, "failed to unmarshal song override proto")
+	}
+	songOverride.CreatedAt = timestamppb.New(createdAt)
+	songOverride.UpdatedAt = timestamppb.New(updatedAt)
+	return &songOverride, nil
+}
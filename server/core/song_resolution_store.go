@@ -0,0 +1,141 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SongResolutionStore interface {
+	// Replaces the existing resolution of the source song on the destination datasource, if any.
+	SetSongResolution(ctx context.Context, resolution *myncer_pb.SongResolution /*const*/) error
+	// Returns nil if the song was not resolved on the datasource yet.
+	GetSongResolution(
+		ctx context.Context,
+		userId string,
+		sourceSong *myncer_pb.Song, /*const*/
+		destinationDatasource myncer_pb.Datasource,
+	) (*myncer_pb.SongResolution /*@nullable*/, error)
+	DeleteSongResolution(
+		ctx context.Context,
+		userId string,
+		sourceSong *myncer_pb.Song, /*const*/
+		destinationDatasource myncer_pb.Datasource,
+	) error
+	DeleteSongResolutions(
+		ctx context.Context,
+		userId string,
+		destinationDatasource myncer_pb.Datasource, // unspecified indicates no filtering
+	) error
+}
+
+func NewSongResolutionStore(db *sql.DB /*const*/) SongResolutionStore {
+	return &songResolutionStoreImpl{db: db}
+}
+
+type songResolutionStoreImpl struct {
+	db *sql.DB
+}
+
+var _ SongResolutionStore = (*songResolutionStoreImpl)(nil)
+
+func (s *songResolutionStoreImpl) SetSongResolution(
+	ctx context.Context,
+	resolution *myncer_pb.SongResolution, /*const*/
+) error {
+	protoBytes, err := proto.Marshal(resolution)
+	if err != nil {
+		return WrappedError(err, "failed to marshal song resolution proto")
+	}
+	if _, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO song_resolutions
+		(user_id, source_datasource, source_song_id, destination_datasource, data, resolved_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id, source_datasource, source_song_id, destination_datasource)
+		DO UPDATE SET data = EXCLUDED.data, resolved_at = EXCLUDED.resolved_at`,
+		resolution.GetUserId(),
+		resolution.GetSourceSong().GetDatasource().String(),
+		resolution.GetSourceSong().GetDatasourceSongId(),
+		resolution.GetDestinationSong().GetDatasource().String(),
+		protoBytes,
+		resolution.GetResolvedAt().AsTime(),
+	); err != nil {
+		return WrappedError(err, "failed to set song resolution in sql")
+	}
+	return nil
+}
+
+func (s *songResolutionStoreImpl) GetSongResolution(
+	ctx context.Context,
+	userId string,
+	sourceSong *myncer_pb.Song, /*const*/
+	destinationDatasource myncer_pb.Datasource,
+) (*myncer_pb.SongResolution /*@nullable*/, error) {
+	var (
+		protoBytes []byte
+		resolvedAt time.Time
+		resolution myncer_pb.SongResolution
+	)
+	err := s.db.QueryRowContext(
+		ctx,
+		`SELECT data, resolved_at FROM song_resolutions
+		WHERE user_id = $1 AND source_datasource = $2 AND source_song_id = $3 AND destination_datasource = $4`,
+		userId,
+		sourceSong.GetDatasource().String(),
+		sourceSong.GetDatasourceSongId(),
+		destinationDatasource.String(),
+	).Scan(&protoBytes, &resolvedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, WrappedError(err, "failed to get song resolution from sql")
+	}
+	if err := proto.Unmarshal(protoBytes, &resolution); err != nil {
+		return nil, WrappedError(err, "failed to unmarshal song resolution proto")
+	}
+	resolution.ResolvedAt = timestamppb.New(resolvedAt)
+	return &resolution, nil
+}
+
+func (s *songResolutionStoreImpl) DeleteSongResolution(
+	ctx context.Context,
+	userId string,
+	sourceSong *myncer_pb.Song, /*const*/
+	destinationDatasource myncer_pb.Datasource,
+) error {
+	if _, err := s.db.ExecContext(
+		ctx,
+		`DELETE FROM song_resolutions
+		WHERE user_id = $1 AND source_datasource = $2 AND source_song_id = $3 AND destination_datasource = $4`,
+		userId,
+		sourceSong.GetDatasource().String(),
+		sourceSong.GetDatasourceSongId(),
+		destinationDatasource.String(),
+	); err != nil {
+		return WrappedError(err, "failed to delete song resolution from sql")
+	}
+	return nil
+}
+
+func (s *songResolutionStoreImpl) DeleteSongResolutions(
+	ctx context.Context,
+	userId string,
+	destinationDatasource myncer_pb.Datasource,
+) error {
+	query := `DELETE FROM song_resolutions WHERE user_id = $1`
+	args := []any{userId}
+	if destinationDatasource != myncer_pb.Datasource_DATASOURCE_UNSPECIFIED {
+		query += ` AND destination_datasource = $2`
+		args = append(args, destinationDatasource.String())
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return WrappedError(err, "failed to delete song resolutions from sql")
+	}
+	return nil
+}
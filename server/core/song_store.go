@@ -22,7 +22,7 @@ func NewSongStore(db *sql.DB) SongStore {
 	}
 }
 
-type songStoreImpl struct{
+type songStoreImpl struct {
 	db *sql.DB
 }
 
@@ -33,7 +33,7 @@ func (s *songStoreImpl) AddSong(ctx context.Context, song *myncer_pb.Song /*cons
 	}
 	if _, err := s.db.ExecContext(
 		ctx,
-		`INSERT INTO songs (id, data, datasource, datasource_song_id) VALUES (?, ?, ?, ?)`,
+		`INSERT INTO songs (id, data, datasource, datasource_song_id) VALUES ($1, $2, $3, $4)`,
 		song.GetId(),
 		protoBytes,
 		song.GetDatasource().String(),
@@ -101,10 +101,10 @@ func (s *songStoreImpl) getSongsInternal(
 	for rows.Next() {
 		var (
 			protoBytes []byte
-			createdAt time.Time
-			updatedAt time.Time
+			createdAt  time.Time
+			updatedAt  time.Time
 		)
-		if err := rows.Scan(&protoBytes, createdAt, updatedAt); err != nil {
+		if err := rows.Scan(&protoBytes, &createdAt, &updatedAt); err != nil {
 			return nil, WrappedError(err, "failed to scan song row from sql")
 		}
 		song := &myncer_pb.Song{}
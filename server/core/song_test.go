@@ -3,6 +3,7 @@ package core
 import (
 	"testing"
 
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -31,3 +32,52 @@ func TestGetSongId(t *testing.T) {
 		)
 	}
 }
+
+func TestDiffSongSpecs(t *testing.T) {
+	newSongs := func(ids ...string) []*myncer_pb.Song {
+		r := []*myncer_pb.Song{}
+		for _, id := range ids {
+			r = append(r, &myncer_pb.Song{Datasource: myncer_pb.Datasource_DATASOURCE_SPOTIFY, DatasourceSongId: id})
+		}
+		return r
+	}
+	testCases := []struct {
+		name            string
+		before          []*myncer_pb.Song
+		after           []*myncer_pb.Song
+		expectedAdded   []*myncer_pb.Song
+		expectedRemoved []*myncer_pb.Song
+	}{
+		{
+			name:            "unchanged",
+			before:          newSongs("1", "2"),
+			after:           newSongs("2", "1"),
+			expectedAdded:   newSongs(),
+			expectedRemoved: newSongs(),
+		},
+		{
+			name:            "added and removed",
+			before:          newSongs("1", "2"),
+			after:           newSongs("2", "3"),
+			expectedAdded:   newSongs("3"),
+			expectedRemoved: newSongs("1"),
+		},
+		{
+			name:            "duplicates are counted",
+			before:          newSongs("1", "1", "2"),
+			after:           newSongs("1", "2", "2"),
+			expectedAdded:   newSongs("2"),
+			expectedRemoved: newSongs("1"),
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(
+			tt.name,
+			func(t *testing.T) {
+				added, removed := DiffSongSpecs(tt.before, tt.after)
+				assert.Equal(t, tt.expectedAdded, added)
+				assert.Equal(t, tt.expectedRemoved, removed)
+			},
+		)
+	}
+}
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SyncBaselineStore interface {
+	// Returns nil if the sync has no baseline yet.
+	GetSyncBaseline(ctx context.Context, syncId string) (*myncer_pb.SyncBaseline /*@nullable*/, error)
+	// Replaces the baseline of the sync.
+	SetSyncBaseline(ctx context.Context, baseline *myncer_pb.SyncBaseline /*const*/) error
+}
+
+func NewSyncBaselineStore(db *sql.DB /*const*/) SyncBaselineStore {
+	return &syncBaselineStoreImpl{db: db}
+}
+
+type syncBaselineStoreImpl struct {
+	db *sql.DB
+}
+
+var _ SyncBaselineStore = (*syncBaselineStoreImpl)(nil)
+
+func (s *syncBaselineStoreImpl) GetSyncBaseline(
+	ctx context.Context,
+	syncId string,
+) (*myncer_pb.SyncBaseline /*@nullable*/, error) {
+	var (
+		protoBytes []byte
+		createdAt  time.Time
+		updatedAt  time.Time
+		baseline   myncer_pb.SyncBaseline
+	)
+	err := s.db.QueryRowContext(
+		ctx,
+		`SELECT data, created_at, updated_at FROM sync_baselines WHERE sync_id = $1`,
+		syncId,
+	).Scan(&protoBytes, &createdAt, &updatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, WrappedError(err, "failed to get sync baseline from sql")
+	}
+	if err := proto.Unmarshal(protoBytes, &baseline); err != nil {
+		return nil, WrappedError(err, "failed to unmarshal sync baseline proto")
+	}
+	baseline.CreatedAt = timestamppb.New(createdAt)
+	baseline.UpdatedAt = timestamppb.New(updatedAt)
+	return &baseline, nil
+}
+
+func (s *syncBaselineStoreImpl) SetSyncBaseline(
+	ctx context.Context,
+	baseline *myncer_pb.SyncBaseline, /*const*/
+) error {
+	protoBytes, err := proto.Marshal(baseline)
+	if err != nil {
+		return WrappedError(err, "failed to marshal sync baseline proto")
+	}
+	if _, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO sync_baselines (sync_id, data) VALUES ($1, $2)
+		ON CONFLICT (sync_id) DO UPDATE SET data = EXCLUDED.data, updated_at = now()`,
+		baseline.GetSyncId(),
+		protoBytes,
+	); err != nil {
+		return WrappedError(err, "failed to set sync baseline in sql")
+	}
+	return nil
+}
@@ -7,9 +7,11 @@ import (
 )
 
 type SyncEngine interface {
+	// Executes a pending sync run, recording its progress and outcome on `syncRun`.
 	RunSync(
 		ctx context.Context,
 		userInfo *myncer_pb.User, /*const*/
 		sync *myncer_pb.Sync, /*const*/
+		syncRun *myncer_pb.SyncRun,
 	) error
 }
@@ -0,0 +1,322 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+// BuildSyncGraph returns the graph of the syncs over the playlists they read from and write to,
+// along with the problems between the syncs.
+// Two kinds of problems are found:
+//   - Cycles of syncs, where each run of one sync undoes the other. Merge syncs writing back to
+//     their own sources are cycles by design, so a cycle needs edges of at least two syncs.
+//   - Playlists overwritten by more than one sync, where each run replaces the other's songs.
+func BuildSyncGraph(syncs []*myncer_pb.Sync /*const*/) *myncer_pb.SyncGraph {
+	// Sorted so that the graph doesn't depend on the order the syncs come in.
+	syncs = slices.Clone(syncs)
+	slices.SortFunc(syncs, func(a, b *myncer_pb.Sync) int { return strings.Compare(a.GetId(), b.GetId()) })
+
+	graph := &myncer_pb.SyncGraph{}
+	nodeKeys := NewSet[string]()
+	addNode := func(node *myncer_pb.MusicSource) {
+		if !nodeKeys.Contains(GetPlaylistLockKey(node)) {
+			nodeKeys.Add(GetPlaylistLockKey(node))
+			graph.Nodes = append(graph.Nodes, node)
+		}
+	}
+	for _, sync := range syncs {
+		for _, edge := range GetSyncGraphEdges(sync) {
+			addNode(edge.GetSource())
+			addNode(edge.GetDestination())
+			graph.Edges = append(graph.Edges, edge)
+		}
+	}
+	graph.Issues = append(getSyncCycleIssues(graph.GetEdges()), getOverwriteIssues(graph.GetEdges())...)
+	return graph
+}
+
+// ValidateSyncGraph returns an error if the sync would take part in any problem with the existing
+// syncs. See BuildSyncGraph for the problems found.
+// Problems that only involve the existing syncs are left alone.
+func ValidateSyncGraph(
+	existingSyncs []*myncer_pb.Sync, /*const*/
+	sync *myncer_pb.Sync, /*const*/
+) error {
+	graph := BuildSyncGraph(append(slices.Clone(existingSyncs), sync))
+	for _, issue := range graph.GetIssues() {
+		if slices.Contains(issue.GetSyncIds(), sync.GetId()) {
+			return NewError("%s", issue.GetMessage())
+		}
+	}
+	return nil
+}
+
+// GetSyncGraphEdges returns the edges the sync adds to the sync graph.
+func GetSyncGraphEdges(sync *myncer_pb.Sync /*const*/) []*myncer_pb.SyncGraphEdge {
+	newEdge := func(source, destination *myncer_pb.MusicSource, overwrites bool) *myncer_pb.SyncGraphEdge {
+		return &myncer_pb.SyncGraphEdge{
+			SyncId:      sync.GetId(),
+			Source:      source,
+			Destination: destination,
+			Overwrites:  overwrites,
+		}
+	}
+	r := []*myncer_pb.SyncGraphEdge{}
+	switch v := sync.GetSyncVariant().(type) {
+	case *myncer_pb.Sync_OneWaySync:
+		r = append(
+			r,
+			newEdge(v.OneWaySync.GetSource(), v.OneWaySync.GetDestination(), isOverwritingOneWaySync(v.OneWaySync)),
+		)
+	case *myncer_pb.Sync_FanOutSync:
+		for _, destination := range v.FanOutSync.GetDestinations() {
+			oneWaySync := GetFanOutOneWaySync(v.FanOutSync, destination)
+			r = append(r, newEdge(oneWaySync.GetSource(), destination, isOverwritingOneWaySync(oneWaySync)))
+		}
+	case *myncer_pb.Sync_PlaylistMergeSync:
+		mergeSync := v.PlaylistMergeSync
+		if mergeSync.GetMode() == myncer_pb.PlaylistMergeSyncMode_PLAYLIST_MERGE_SYNC_MODE_UNSPECIFIED {
+			for _, source := range mergeSync.GetSources() {
+				r = append(r, newEdge(source, mergeSync.GetDestination(), mergeSync.GetOverwriteExisting()))
+			}
+			break
+		}
+		// Every playlist is written with the songs of every other playlist.
+		targets := GetPlaylistMergeSyncTargets(mergeSync)
+		for _, source := range targets {
+			for _, destination := range targets {
+				if GetPlaylistLockKey(source) != GetPlaylistLockKey(destination) {
+					r = append(r, newEdge(source, destination, false /*overwrites*/))
+				}
+			}
+		}
+	}
+	return r
+}
+
+// Clearing the destination and removing the songs the source doesn't have both make the
+// destination a copy of the source.
+func isOverwritingOneWaySync(sync *myncer_pb.OneWaySync /*const*/) bool {
+	if sync.GetMode() == myncer_pb.OneWaySyncMode_ONE_WAY_SYNC_MODE_DIFF {
+		return sync.GetRemoveExtraSongs()
+	}
+	return sync.GetOverwriteExisting()
+}
+
+// Reports one issue per strongly connected component of the graph with edges of more than one
+// sync. Every edge of a component lies on a cycle, so such a component always has a cycle through
+// edges of different syncs.
+func getSyncCycleIssues(edges []*myncer_pb.SyncGraphEdge /*const*/) []*myncer_pb.SyncGraphIssue {
+	nodes := []string{}
+	edgesBySource := map[string][]*myncer_pb.SyncGraphEdge{}
+	for _, edge := range edges {
+		key := GetPlaylistLockKey(edge.GetSource())
+		if _, ok := edgesBySource[key]; !ok {
+			nodes = append(nodes, key)
+		}
+		edgesBySource[key] = append(edgesBySource[key], edge)
+	}
+	componentByNode := getStronglyConnectedComponents(edgesBySource, nodes)
+
+	// Keyed by component, in the order of their first edge.
+	components := []int{}
+	edgesByComponent := map[int][]*myncer_pb.SyncGraphEdge{}
+	for _, edge := range edges {
+		component := componentByNode[GetPlaylistLockKey(edge.GetSource())]
+		if componentByNode[GetPlaylistLockKey(edge.GetDestination())] != component {
+			continue
+		}
+		if _, ok := edgesByComponent[component]; !ok {
+			components = append(components, component)
+		}
+		edgesByComponent[component] = append(edgesByComponent[component], edge)
+	}
+
+	issues := []*myncer_pb.SyncGraphIssue{}
+	for _, component := range components {
+		componentEdges := edgesByComponent[component]
+		syncIds := getSortedSyncIds(componentEdges)
+		if len(syncIds) < 2 {
+			continue
+		}
+		cycle := findSyncCycle(
+			edgesBySource,
+			componentEdges,
+			func(edge *myncer_pb.SyncGraphEdge) bool {
+				return componentByNode[GetPlaylistLockKey(edge.GetDestination())] == component
+			},
+		)
+		path := []string{GetPlaylistLockKey(cycle[0].GetSource())}
+		for _, e := range cycle {
+			path = append(path, GetPlaylistLockKey(e.GetDestination()))
+		}
+		issues = append(
+			issues,
+			&myncer_pb.SyncGraphIssue{
+				SyncIds: syncIds,
+				Message: fmt.Sprintf("syncs form a cycle: %s", strings.Join(path, " -> ")),
+			},
+		)
+	}
+	return issues
+}
+
+// Returns the edges of a cycle through edges of more than one sync, made of the edges of a strongly
+// connected component. The shortest cycle through each edge is tried in turn, falling back to
+// joining edges of two syncs, which may visit playlists more than once.
+func findSyncCycle(
+	edgesBySource map[string][]*myncer_pb.SyncGraphEdge, /*const*/
+	componentEdges []*myncer_pb.SyncGraphEdge, /*const*/
+	isInComponent func(edge *myncer_pb.SyncGraphEdge) bool,
+) []*myncer_pb.SyncGraphEdge {
+	for _, edge := range componentEdges {
+		cycle := append(
+			[]*myncer_pb.SyncGraphEdge{edge},
+			findSyncPath(edgesBySource, edge.GetDestination(), edge.GetSource(), isInComponent)...,
+		)
+		if len(getSortedSyncIds(cycle)) > 1 {
+			return cycle
+		}
+	}
+	first := componentEdges[0]
+	second := componentEdges[slices.IndexFunc(
+		componentEdges,
+		func(edge *myncer_pb.SyncGraphEdge) bool { return edge.GetSyncId() != first.GetSyncId() },
+	)]
+	cycle := []*myncer_pb.SyncGraphEdge{first}
+	cycle = append(cycle, findSyncPath(edgesBySource, first.GetDestination(), second.GetSource(), isInComponent)...)
+	cycle = append(cycle, second)
+	return append(cycle, findSyncPath(edgesBySource, second.GetDestination(), first.GetSource(), isInComponent)...)
+}
+
+// Returns the strongly connected component of every playlist with an outgoing edge, using
+// Tarjan's algorithm. Components are numbered from 0.
+func getStronglyConnectedComponents(
+	edgesBySource map[string][]*myncer_pb.SyncGraphEdge, /*const*/
+	nodes []string, /*const*/
+) map[string]int {
+	index := map[string]int{}
+	lowLink := map[string]int{}
+	stack := []string{}
+	onStack := NewSet[string]()
+	componentByNode := map[string]int{}
+	numComponents := 0
+	var visit func(node string)
+	visit = func(node string) {
+		index[node] = len(index)
+		lowLink[node] = index[node]
+		stack = append(stack, node)
+		onStack.Add(node)
+		for _, edge := range edgesBySource[node] {
+			next := GetPlaylistLockKey(edge.GetDestination())
+			if _, ok := index[next]; !ok {
+				visit(next)
+				lowLink[node] = min(lowLink[node], lowLink[next])
+			} else if onStack.Contains(next) {
+				lowLink[node] = min(lowLink[node], index[next])
+			}
+		}
+		if lowLink[node] != index[node] {
+			return
+		}
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack.Delete(top)
+			componentByNode[top] = numComponents
+			if top == node {
+				break
+			}
+		}
+		numComponents++
+	}
+	for _, node := range nodes {
+		if _, ok := index[node]; !ok {
+			visit(node)
+		}
+	}
+	return componentByNode
+}
+
+// Returns the edges of a shortest path between the playlists that only follows the allowed
+// edges, or nil if there is none. The path is empty if the playlists are the same.
+func findSyncPath(
+	edgesBySource map[string][]*myncer_pb.SyncGraphEdge, /*const*/
+	from *myncer_pb.MusicSource, /*const*/
+	to *myncer_pb.MusicSource, /*const*/
+	isAllowed func(edge *myncer_pb.SyncGraphEdge) bool,
+) []*myncer_pb.SyncGraphEdge {
+	fromKey, toKey := GetPlaylistLockKey(from), GetPlaylistLockKey(to)
+	previousEdges := map[string]*myncer_pb.SyncGraphEdge{}
+	visited := NewSet(fromKey)
+	queue := []string{fromKey}
+	for len(queue) > 0 && !visited.Contains(toKey) {
+		node := queue[0]
+		queue = queue[1:]
+		for _, edge := range edgesBySource[node] {
+			next := GetPlaylistLockKey(edge.GetDestination())
+			if visited.Contains(next) || !isAllowed(edge) {
+				continue
+			}
+			visited.Add(next)
+			previousEdges[next] = edge
+			queue = append(queue, next)
+		}
+	}
+	if !visited.Contains(toKey) {
+		return nil
+	}
+	path := []*myncer_pb.SyncGraphEdge{}
+	for node := toKey; node != fromKey; {
+		edge := previousEdges[node]
+		path = append(path, edge)
+		node = GetPlaylistLockKey(edge.GetSource())
+	}
+	slices.Reverse(path)
+	return path
+}
+
+func getOverwriteIssues(edges []*myncer_pb.SyncGraphEdge /*const*/) []*myncer_pb.SyncGraphIssue {
+	// Keyed by playlist lock key, in the order the playlists are first overwritten.
+	keys := []string{}
+	edgesByDestination := map[string][]*myncer_pb.SyncGraphEdge{}
+	for _, edge := range edges {
+		if !edge.GetOverwrites() {
+			continue
+		}
+		key := GetPlaylistLockKey(edge.GetDestination())
+		if _, ok := edgesByDestination[key]; !ok {
+			keys = append(keys, key)
+		}
+		edgesByDestination[key] = append(edgesByDestination[key], edge)
+	}
+
+	issues := []*myncer_pb.SyncGraphIssue{}
+	for _, key := range keys {
+		syncIds := getSortedSyncIds(edgesByDestination[key])
+		if len(syncIds) < 2 {
+			continue
+		}
+		issues = append(
+			issues,
+			&myncer_pb.SyncGraphIssue{
+				SyncIds: syncIds,
+				Message: fmt.Sprintf("more than one sync overwrites %s", key),
+			},
+		)
+	}
+	return issues
+}
+
+func getSortedSyncIds(edges []*myncer_pb.SyncGraphEdge /*const*/) []string {
+	syncIds := NewSet[string]()
+	for _, edge := range edges {
+		syncIds.Add(edge.GetSyncId())
+	}
+	r := syncIds.ToArray()
+	slices.Sort(r)
+	return r
+}
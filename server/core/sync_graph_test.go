@@ -0,0 +1,118 @@
+package core
+
+import (
+	"fmt"
+	"testing"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSyncGraph(t *testing.T) {
+	newPlaylist := func(id string) *myncer_pb.MusicSource {
+		return &myncer_pb.MusicSource{Datasource: myncer_pb.Datasource_DATASOURCE_SPOTIFY, PlaylistId: id}
+	}
+	newOneWaySync := func(id string, source string, destination string, overwriteExisting bool) *myncer_pb.Sync {
+		return &myncer_pb.Sync{
+			Id: id,
+			SyncVariant: &myncer_pb.Sync_OneWaySync{
+				OneWaySync: &myncer_pb.OneWaySync{
+					Source:            newPlaylist(source),
+					Destination:       newPlaylist(destination),
+					OverwriteExisting: overwriteExisting,
+				},
+			},
+		}
+	}
+	newMergeSync := func(id string, mode myncer_pb.PlaylistMergeSyncMode, sources ...string) *myncer_pb.Sync {
+		mergeSync := &myncer_pb.PlaylistMergeSync{Mode: mode}
+		for _, source := range sources {
+			mergeSync.Sources = append(mergeSync.Sources, newPlaylist(source))
+		}
+		return &myncer_pb.Sync{
+			Id:          id,
+			SyncVariant: &myncer_pb.Sync_PlaylistMergeSync{PlaylistMergeSync: mergeSync},
+		}
+	}
+	largeMergeSources := []string{}
+	for i := range 30 {
+		largeMergeSources = append(largeMergeSources, fmt.Sprintf("source-%d", i))
+	}
+
+	testCases := []struct {
+		name          string
+		existingSyncs []*myncer_pb.Sync
+		sync          *myncer_pb.Sync
+		expectedError string
+	}{
+		{
+			name:          "chain",
+			existingSyncs: []*myncer_pb.Sync{newOneWaySync("1", "a", "b", false)},
+			sync:          newOneWaySync("2", "b", "c", false),
+		},
+		{
+			name: "cycle",
+			existingSyncs: []*myncer_pb.Sync{
+				newOneWaySync("1", "a", "b", false),
+				newOneWaySync("2", "b", "c", false),
+			},
+			sync:          newOneWaySync("3", "c", "a", false),
+			expectedError: "syncs form a cycle: playlist:DATASOURCE_SPOTIFY:a -> playlist:DATASOURCE_SPOTIFY:b -> playlist:DATASOURCE_SPOTIFY:c -> playlist:DATASOURCE_SPOTIFY:a",
+		},
+		{
+			name: "bidirectional merge on its own",
+			sync: newMergeSync("1", myncer_pb.PlaylistMergeSyncMode_PLAYLIST_MERGE_SYNC_MODE_BIDIRECTIONAL, "a", "b"),
+		},
+		{
+			name: "one-way sync between bidirectional merge playlists",
+			existingSyncs: []*myncer_pb.Sync{
+				newMergeSync("1", myncer_pb.PlaylistMergeSyncMode_PLAYLIST_MERGE_SYNC_MODE_BIDIRECTIONAL, "a", "b"),
+			},
+			sync:          newOneWaySync("2", "a", "b", false),
+			expectedError: "syncs form a cycle",
+		},
+		{
+			name: "one-way sync between playlists of a large bidirectional merge",
+			existingSyncs: []*myncer_pb.Sync{
+				newMergeSync(
+					"1",
+					myncer_pb.PlaylistMergeSyncMode_PLAYLIST_MERGE_SYNC_MODE_BIDIRECTIONAL,
+					largeMergeSources...,
+				),
+			},
+			sync:          newOneWaySync("2", "source-0", "source-29", false),
+			expectedError: "syncs form a cycle: playlist:DATASOURCE_SPOTIFY:source-0 -> playlist:DATASOURCE_SPOTIFY:source-29 -> playlist:DATASOURCE_SPOTIFY:source-0",
+		},
+		{
+			name: "cycle among existing syncs is ignored",
+			existingSyncs: []*myncer_pb.Sync{
+				newOneWaySync("1", "a", "b", false),
+				newOneWaySync("2", "b", "a", false),
+			},
+			sync: newOneWaySync("3", "c", "d", false),
+		},
+		{
+			name:          "writers that don't overwrite",
+			existingSyncs: []*myncer_pb.Sync{newOneWaySync("1", "a", "c", false)},
+			sync:          newOneWaySync("2", "b", "c", true),
+		},
+		{
+			name:          "multiple overwriting writers",
+			existingSyncs: []*myncer_pb.Sync{newOneWaySync("1", "a", "c", true)},
+			sync:          newOneWaySync("2", "b", "c", true),
+			expectedError: "more than one sync overwrites playlist:DATASOURCE_SPOTIFY:c",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateSyncGraph(tc.existingSyncs, tc.sync)
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tc.expectedError)
+			}
+		})
+	}
+}
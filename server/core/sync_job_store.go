@@ -0,0 +1,338 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	CSyncRunInProgressError = NewError("sync already has a queued or running sync run")
+)
+
+type SyncJobStatus string
+
+const (
+	SyncJobStatus_Queued    SyncJobStatus = "queued"
+	SyncJobStatus_Running   SyncJobStatus = "running"
+	SyncJobStatus_Completed SyncJobStatus = "completed"
+	SyncJobStatus_Failed    SyncJobStatus = "failed"
+	SyncJobStatus_Cancelled SyncJobStatus = "cancelled"
+)
+
+// A queued request to execute a single sync run.
+type SyncJob struct {
+	Id     string
+	RunId  string
+	SyncId string
+	UserId string
+	// Number of times a worker has claimed the job, including the current claim.
+	Attempts int
+}
+
+type SyncJobStore interface {
+	// Creates a pending sync run of the kind for the sync and queues a job to execute it.
+	// Returns CSyncRunInProgressError if the sync already has a queued or running job.
+	EnqueueSyncJob(
+		ctx context.Context,
+		sync *myncer_pb.Sync, /*const*/
+		kind myncer_pb.SyncRunKind,
+	) (*myncer_pb.SyncRun, error)
+	// Claims the oldest available job for the worker.
+	// Returns nil if there are no jobs available.
+	ClaimSyncJob(ctx context.Context, workerId string) (*SyncJob /*@nullable*/, error)
+	// Records that the worker is still running the job.
+	// Returns true if cancellation of the job has been requested.
+	HeartbeatSyncJob(ctx context.Context, jobId string, workerId string) (bool, error)
+	// Requests cancellation of the job running the sync run.
+	// Queued jobs are cancelled immediately, running jobs are stopped by their worker.
+	// Returns the status of the job after the request.
+	RequestSyncJobCancellation(ctx context.Context, runId string) (SyncJobStatus, error)
+	FinishSyncJob(ctx context.Context, jobId string, status SyncJobStatus) error
+	// Puts a claimed job back in the queue without counting the claim as an attempt.
+	// The job is not claimed again for `delay`.
+	RequeueSyncJob(ctx context.Context, jobId string, delay time.Duration) error
+	// Finds running jobs whose worker stopped heartbeating before `staleBefore`.
+	// Jobs whose cancellation was requested are marked as cancelled. Of the rest, jobs that have been
+	// claimed fewer than `maxAttempts` times are put back in the queue and the others are marked as
+	// failed.
+	RecoverStaleSyncJobs(
+		ctx context.Context,
+		staleBefore time.Time,
+		maxAttempts int,
+	) (requeued []*SyncJob, cancelled []*SyncJob, failed []*SyncJob, err error)
+}
+
+func NewSyncJobStore(db *sql.DB) SyncJobStore {
+	return &syncJobStoreImpl{db: db}
+}
+
+type syncJobStoreImpl struct {
+	db *sql.DB
+}
+
+var _ SyncJobStore = (*syncJobStoreImpl)(nil)
+
+func (s *syncJobStoreImpl) EnqueueSyncJob(
+	ctx context.Context,
+	sync *myncer_pb.Sync, /*const*/
+	kind myncer_pb.SyncRunKind,
+) (*myncer_pb.SyncRun, error) {
+	syncRun := &myncer_pb.SyncRun{
+		SyncId:     sync.GetId(),
+		RunId:      uuid.NewString(),
+		SyncStatus: myncer_pb.SyncStatus_SYNC_STATUS_PENDING,
+		Kind:       kind,
+	}
+	protoBytes, err := proto.Marshal(syncRun)
+	if err != nil {
+		return nil, WrappedError(err, "failed to marshal sync run proto")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil /*opts*/)
+	if err != nil {
+		return nil, WrappedError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	// Serializes concurrent enqueues of the same sync until the transaction ends.
+	// The lock must not be the one workers hold while running the sync, or enqueues would wait for
+	// the run to finish instead of failing right away.
+	if _, err := tx.ExecContext(
+		ctx,
+		`SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`,
+		GetSyncEnqueueLockKey(sync.GetId()),
+	); err != nil {
+		return nil, WrappedError(err, "failed to lock sync for enqueue")
+	}
+	var inProgress bool
+	if err := tx.QueryRowContext(
+		ctx,
+		`SELECT EXISTS (SELECT 1 FROM sync_jobs WHERE sync_id = $1 AND status IN ($2, $3))`,
+		sync.GetId(),
+		SyncJobStatus_Queued,
+		SyncJobStatus_Running,
+	).Scan(&inProgress); err != nil {
+		return nil, WrappedError(err, "failed to check for in progress sync jobs")
+	}
+	if inProgress {
+		return nil, CSyncRunInProgressError
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO sync_runs (run_id, sync_id, data) VALUES ($1, $2, $3)`,
+		syncRun.GetRunId(),
+		syncRun.GetSyncId(),
+		protoBytes,
+	); err != nil {
+		return nil, WrappedError(err, "failed to add sync run into sql")
+	}
+	if _, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO sync_jobs (id, run_id, sync_id, user_id, status) VALUES ($1, $2, $3, $4, $5)`,
+		uuid.NewString(),
+		syncRun.GetRunId(),
+		sync.GetId(),
+		sync.GetUserId(),
+		SyncJobStatus_Queued,
+	); err != nil {
+		return nil, WrappedError(err, "failed to add sync job into sql")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, WrappedError(err, "failed to commit sync job")
+	}
+	return syncRun, nil
+}
+
+func (s *syncJobStoreImpl) ClaimSyncJob(
+	ctx context.Context,
+	workerId string,
+) (*SyncJob /*@nullable*/, error) {
+	// SKIP LOCKED lets concurrent workers claim different jobs without blocking on each other.
+	row := s.db.QueryRowContext(
+		ctx,
+		`UPDATE sync_jobs
+		SET status = $1, worker_id = $2, heartbeat_at = now(), attempts = attempts + 1, updated_at = now()
+		WHERE id = (
+			SELECT id FROM sync_jobs
+			WHERE status = $3 AND available_at <= now()
+			ORDER BY available_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, run_id, sync_id, user_id, attempts`,
+		SyncJobStatus_Running,
+		workerId,
+		SyncJobStatus_Queued,
+	)
+	job, err := scanSyncJob(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, WrappedError(err, "failed to claim sync job")
+	}
+	return job, nil
+}
+
+func (s *syncJobStoreImpl) HeartbeatSyncJob(
+	ctx context.Context,
+	jobId string,
+	workerId string,
+) (bool, error) {
+	var cancelRequested bool
+	err := s.db.QueryRowContext(
+		ctx,
+		`UPDATE sync_jobs SET heartbeat_at = now()
+		WHERE id = $1 AND worker_id = $2 AND status = $3
+		RETURNING cancel_requested`,
+		jobId,
+		workerId,
+		SyncJobStatus_Running,
+	).Scan(&cancelRequested)
+	if err == sql.ErrNoRows {
+		return false, NewError("sync job %s is no longer owned by worker %s", jobId, workerId)
+	}
+	if err != nil {
+		return false, WrappedError(err, "failed to heartbeat sync job")
+	}
+	return cancelRequested, nil
+}
+
+func (s *syncJobStoreImpl) RequestSyncJobCancellation(
+	ctx context.Context,
+	runId string,
+) (SyncJobStatus, error) {
+	var status SyncJobStatus
+	err := s.db.QueryRowContext(
+		ctx,
+		`UPDATE sync_jobs
+		SET status = CASE WHEN status = $1 THEN $2 ELSE status END,
+			cancel_requested = true,
+			updated_at = now()
+		WHERE run_id = $3 AND status IN ($1, $4)
+		RETURNING status`,
+		SyncJobStatus_Queued,
+		SyncJobStatus_Cancelled,
+		runId,
+		SyncJobStatus_Running,
+	).Scan(&status)
+	if err == sql.ErrNoRows {
+		return "", NewError("sync run %s is not queued or running", runId)
+	}
+	if err != nil {
+		return "", WrappedError(err, "failed to request sync job cancellation")
+	}
+	return status, nil
+}
+
+func (s *syncJobStoreImpl) FinishSyncJob(ctx context.Context, jobId string, status SyncJobStatus) error {
+	if _, err := s.db.ExecContext(
+		ctx,
+		`UPDATE sync_jobs SET status = $1, updated_at = now() WHERE id = $2`,
+		status,
+		jobId,
+	); err != nil {
+		return WrappedError(err, "failed to finish sync job")
+	}
+	return nil
+}
+
+func (s *syncJobStoreImpl) RequeueSyncJob(ctx context.Context, jobId string, delay time.Duration) error {
+	if _, err := s.db.ExecContext(
+		ctx,
+		`UPDATE sync_jobs
+		SET status = $1, worker_id = NULL, heartbeat_at = NULL, attempts = attempts - 1,
+			available_at = $2, updated_at = now()
+		WHERE id = $3`,
+		SyncJobStatus_Queued,
+		time.Now().Add(delay),
+		jobId,
+	); err != nil {
+		return WrappedError(err, "failed to requeue sync job")
+	}
+	return nil
+}
+
+func (s *syncJobStoreImpl) RecoverStaleSyncJobs(
+	ctx context.Context,
+	staleBefore time.Time,
+	maxAttempts int,
+) ([]*SyncJob, []*SyncJob, []*SyncJob, error) {
+	requeued, err := s.updateSyncJobs(
+		ctx,
+		`UPDATE sync_jobs
+		SET status = $1, worker_id = NULL, heartbeat_at = NULL, available_at = now(), updated_at = now()
+		WHERE status = $2 AND heartbeat_at < $3 AND attempts < $4 AND NOT cancel_requested
+		RETURNING id, run_id, sync_id, user_id, attempts`,
+		SyncJobStatus_Queued,
+		SyncJobStatus_Running,
+		staleBefore,
+		maxAttempts,
+	)
+	if err != nil {
+		return nil, nil, nil, WrappedError(err, "failed to requeue stale sync jobs")
+	}
+	cancelled, err := s.updateSyncJobs(
+		ctx,
+		`UPDATE sync_jobs
+		SET status = $1, updated_at = now()
+		WHERE status = $2 AND heartbeat_at < $3 AND cancel_requested
+		RETURNING id, run_id, sync_id, user_id, attempts`,
+		SyncJobStatus_Cancelled,
+		SyncJobStatus_Running,
+		staleBefore,
+	)
+	if err != nil {
+		return nil, nil, nil, WrappedError(err, "failed to cancel stale sync jobs")
+	}
+	failed, err := s.updateSyncJobs(
+		ctx,
+		`UPDATE sync_jobs
+		SET status = $1, updated_at = now()
+		WHERE status = $2 AND heartbeat_at < $3 AND NOT cancel_requested
+		RETURNING id, run_id, sync_id, user_id, attempts`,
+		SyncJobStatus_Failed,
+		SyncJobStatus_Running,
+		staleBefore,
+	)
+	if err != nil {
+		return nil, nil, nil, WrappedError(err, "failed to fail stale sync jobs")
+	}
+	return requeued, cancelled, failed, nil
+}
+
+func (s *syncJobStoreImpl) updateSyncJobs(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]*SyncJob, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, WrappedError(err, "failed to update sync jobs in sql")
+	}
+	defer rows.Close()
+
+	r := []*SyncJob{}
+	for rows.Next() {
+		job, err := scanSyncJob(rows)
+		if err != nil {
+			return nil, WrappedError(err, "failed to scan sync job row")
+		}
+		r = append(r, job)
+	}
+	return r, rows.Err()
+}
+
+func scanSyncJob(row interface{ Scan(dest ...any) error }) (*SyncJob, error) {
+	job := &SyncJob{}
+	if err := row.Scan(&job.Id, &job.RunId, &job.SyncId, &job.UserId, &job.Attempts); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
@@ -0,0 +1,82 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/hansbala/myncer/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSyncEnqueueLockKey(t *testing.T) {
+	assert.NotEqual(t, core.GetSyncLockKey("sync-id"), core.GetSyncEnqueueLockKey("sync-id"))
+}
+
+func TestEnqueueSyncJobWhileSyncIsLocked(t *testing.T) {
+	db := testutil.GetTestDatabase(t)
+	sync := testutil.CreateTestSync(t, db, testutil.CreateTestUser(t, db))
+
+	// Held by the worker running the sync.
+	lock, err := db.LockStore.TryLock(context.Background(), core.GetSyncLockKey(sync.GetId()))
+	require.NoError(t, err)
+	require.NotNil(t, lock)
+	defer lock.Unlock(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	syncRun, err := db.SyncJobStore.EnqueueSyncJob(
+		ctx,
+		sync,
+		myncer_pb.SyncRunKind_SYNC_RUN_KIND_UNSPECIFIED,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, sync.GetId(), syncRun.GetSyncId())
+
+	_, err = db.SyncJobStore.EnqueueSyncJob(
+		ctx,
+		sync,
+		myncer_pb.SyncRunKind_SYNC_RUN_KIND_UNSPECIFIED,
+	)
+	assert.ErrorIs(t, err, core.CSyncRunInProgressError)
+}
+
+func TestRecoverStaleSyncJobsWithCancellationRequested(t *testing.T) {
+	db := testutil.GetTestDatabase(t)
+	ctx := context.Background()
+	sync := testutil.CreateTestSync(t, db, testutil.CreateTestUser(t, db))
+	syncRun, err := db.SyncJobStore.EnqueueSyncJob(ctx, sync, myncer_pb.SyncRunKind_SYNC_RUN_KIND_UNSPECIFIED)
+	require.NoError(t, err)
+	// Claimed by a worker that then stopped responding.
+	_, err = db.DB.ExecContext(
+		ctx,
+		`UPDATE sync_jobs SET status = $1, worker_id = 'worker', heartbeat_at = now(), attempts = 1
+		WHERE run_id = $2`,
+		core.SyncJobStatus_Running,
+		syncRun.GetRunId(),
+	)
+	require.NoError(t, err)
+	status, err := db.SyncJobStore.RequestSyncJobCancellation(ctx, syncRun.GetRunId())
+	require.NoError(t, err)
+	require.Equal(t, core.SyncJobStatus_Running, status)
+
+	requeued, cancelled, failed, err := db.SyncJobStore.RecoverStaleSyncJobs(
+		ctx,
+		time.Now().Add(time.Minute),
+		3, /*maxAttempts*/
+	)
+	require.NoError(t, err)
+	getRunIds := func(jobs []*core.SyncJob) []string {
+		r := []string{}
+		for _, job := range jobs {
+			r = append(r, job.RunId)
+		}
+		return r
+	}
+	assert.NotContains(t, getRunIds(requeued), syncRun.GetRunId())
+	assert.Contains(t, getRunIds(cancelled), syncRun.GetRunId())
+	assert.NotContains(t, getRunIds(failed), syncRun.GetRunId())
+}
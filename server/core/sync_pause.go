@@ -0,0 +1,78 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Syncs are paused after this many failed runs in a row so that broken syncs stop calling the
+	// datasources.
+	CMaxConsecutiveSyncFailures = 5
+)
+
+var (
+	CSyncPausedError = NewError("sync is paused")
+)
+
+func IsSyncPaused(sync *myncer_pb.Sync /*const*/) bool {
+	return sync.GetPause() != nil
+}
+
+// PauseSync pauses the sync. `automatic` is true if the server is pausing the sync on its own.
+func PauseSync(sync *myncer_pb.Sync, reason string, automatic bool, now time.Time) {
+	sync.Pause = &myncer_pb.SyncPause{
+		PausedAt:  timestamppb.New(now),
+		Reason:    reason,
+		Automatic: automatic,
+	}
+}
+
+// ResumeSync resumes the sync and gives it a clean slate of failures.
+// Scheduled runs missed while paused are not caught up on; the next run is computed from `now`.
+func ResumeSync(sync *myncer_pb.Sync, now time.Time) {
+	sync.Pause = nil
+	sync.ConsecutiveFailures = 0
+	if sync.GetSchedule() != nil {
+		lastRunAt := sync.GetSchedule().GetLastRunAt()
+		sync.Schedule = NewSyncSchedule(sync.GetSchedule().GetInterval(), now)
+		if sync.GetSchedule() != nil {
+			sync.Schedule.LastRunAt = lastRunAt
+		}
+	}
+}
+
+// RecordSyncRunOutcome counts the failures of the sync's runs in a row and pauses the sync once
+// there are too many.
+// Only finished runs that change playlists are recorded; previews and runs that are being retried
+// are not.
+// Returns true if the sync was paused.
+func RecordSyncRunOutcome(
+	sync *myncer_pb.Sync,
+	syncRun *myncer_pb.SyncRun, /*const*/
+	now time.Time,
+) bool {
+	switch syncRun.GetSyncStatus() {
+	case myncer_pb.SyncStatus_SYNC_STATUS_COMPLETED:
+		sync.ConsecutiveFailures = 0
+	case myncer_pb.SyncStatus_SYNC_STATUS_FAILED:
+		sync.ConsecutiveFailures++
+	}
+	if sync.GetConsecutiveFailures() < CMaxConsecutiveSyncFailures || IsSyncPaused(sync) {
+		return false
+	}
+	PauseSync(
+		sync,
+		fmt.Sprintf(
+			"paused after %d failed runs in a row, last error: %s",
+			sync.GetConsecutiveFailures(),
+			syncRun.GetErrorMessage(),
+		),
+		true, /*automatic*/
+		now,
+	)
+	return true
+}
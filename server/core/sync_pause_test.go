@@ -0,0 +1,66 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordSyncRunOutcome(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0 /*hour*/, 0 /*min*/, 0 /*sec*/, 0 /*nsec*/, time.UTC)
+	testCases := []struct {
+		name                        string
+		consecutiveFailures         int32
+		status                      myncer_pb.SyncStatus
+		expectedConsecutiveFailures int32
+		expectedPaused              bool
+	}{
+		{
+			name:                        "completed run resets failures",
+			consecutiveFailures:         3,
+			status:                      myncer_pb.SyncStatus_SYNC_STATUS_COMPLETED,
+			expectedConsecutiveFailures: 0,
+		},
+		{
+			name:                        "failed run below the limit",
+			consecutiveFailures:         CMaxConsecutiveSyncFailures - 2,
+			status:                      myncer_pb.SyncStatus_SYNC_STATUS_FAILED,
+			expectedConsecutiveFailures: CMaxConsecutiveSyncFailures - 1,
+		},
+		{
+			name:                        "failed run reaching the limit pauses",
+			consecutiveFailures:         CMaxConsecutiveSyncFailures - 1,
+			status:                      myncer_pb.SyncStatus_SYNC_STATUS_FAILED,
+			expectedConsecutiveFailures: CMaxConsecutiveSyncFailures,
+			expectedPaused:              true,
+		},
+		{
+			name:                        "cancelled run is not counted",
+			consecutiveFailures:         2,
+			status:                      myncer_pb.SyncStatus_SYNC_STATUS_CANCELLED,
+			expectedConsecutiveFailures: 2,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(
+			tt.name,
+			func(t *testing.T) {
+				sync := &myncer_pb.Sync{ConsecutiveFailures: tt.consecutiveFailures}
+				paused := RecordSyncRunOutcome(
+					sync,
+					&myncer_pb.SyncRun{SyncStatus: tt.status, ErrorMessage: "token expired"},
+					now,
+				)
+				assert.Equal(t, tt.expectedPaused, paused)
+				assert.Equal(t, tt.expectedPaused, IsSyncPaused(sync))
+				assert.Equal(t, tt.expectedConsecutiveFailures, sync.GetConsecutiveFailures())
+				if tt.expectedPaused {
+					assert.True(t, sync.GetPause().GetAutomatic())
+					assert.Contains(t, sync.GetPause().GetReason(), "token expired")
+				}
+			},
+		)
+	}
+}
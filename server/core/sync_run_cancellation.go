@@ -0,0 +1,34 @@
+package core
+
+import (
+	"context"
+)
+
+var (
+	CSyncRunCancelledError = NewError("sync run was cancelled")
+)
+
+type syncRunCancellationCtxType struct{}
+
+// WithSyncRunCancellation attaches a signal to the context which asks the sync run executing under
+// it to stop once closed.
+// Unlike context cancellation, in-flight datasource calls are allowed to finish so the run stops at a
+// point where its progress can be recorded.
+func WithSyncRunCancellation(ctx context.Context, cancelled <-chan struct{}) context.Context {
+	return context.WithValue(ctx, syncRunCancellationCtxType{}, cancelled)
+}
+
+// CheckSyncRunCancelled returns CSyncRunCancelledError if the sync run executing under the context
+// was asked to stop.
+func CheckSyncRunCancelled(ctx context.Context) error {
+	cancelled, ok := ctx.Value(syncRunCancellationCtxType{}).(<-chan struct{})
+	if !ok {
+		return nil
+	}
+	select {
+	case <-cancelled:
+		return CSyncRunCancelledError
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,119 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SyncRunEventStore interface {
+	// Adds the events in order with a single insert.
+	AddSyncRunEvents(ctx context.Context, events []*myncer_pb.SyncRunEvent /*const*/) error
+	// Returns the events of the run added after the event with id `afterId`, oldest first, along with
+	// the id of the last returned event.
+	// If there are no new events, `afterId` is returned as the last id.
+	GetSyncRunEvents(
+		ctx context.Context,
+		runId string,
+		afterId int64,
+	) (events []*myncer_pb.SyncRunEvent, lastId int64, err error)
+	// Returns the id of the latest event of the run, or 0 if it has none.
+	GetLatestSyncRunEventId(ctx context.Context, runId string) (int64, error)
+}
+
+func NewSyncRunEventStore(db *sql.DB /*const*/) SyncRunEventStore {
+	return &syncRunEventStoreImpl{db: db}
+}
+
+type syncRunEventStoreImpl struct {
+	db *sql.DB
+}
+
+var _ SyncRunEventStore = (*syncRunEventStoreImpl)(nil)
+
+func (s *syncRunEventStoreImpl) AddSyncRunEvents(
+	ctx context.Context,
+	events []*myncer_pb.SyncRunEvent, /*const*/
+) error {
+	if len(events) == 0 {
+		return nil
+	}
+	values := []string{}
+	args := []any{}
+	for _, event := range events {
+		protoBytes, err := proto.Marshal(event)
+		if err != nil {
+			return WrappedError(err, "failed to marshal sync run event proto")
+		}
+		values = append(values, fmt.Sprintf("(%s)", makePlaceholders(len(args), []any{nil, nil})))
+		args = append(args, event.GetRunId(), protoBytes)
+	}
+	// Ids are assigned in the order of the values, which keeps the events in order.
+	if _, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO sync_run_events (run_id, data) VALUES `+strings.Join(values, ", "),
+		args...,
+	); err != nil {
+		return WrappedError(err, "failed to add sync run events into sql")
+	}
+	return nil
+}
+
+func (s *syncRunEventStoreImpl) GetSyncRunEvents(
+	ctx context.Context,
+	runId string,
+	afterId int64,
+) ([]*myncer_pb.SyncRunEvent, int64, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT id, data, created_at FROM sync_run_events WHERE run_id = $1 AND id > $2 ORDER BY id`,
+		runId,
+		afterId,
+	)
+	if err != nil {
+		return nil, 0, WrappedError(err, "failed to query sync run events from sql")
+	}
+	defer rows.Close()
+
+	events := []*myncer_pb.SyncRunEvent{}
+	lastId := afterId
+	for rows.Next() {
+		var (
+			id         int64
+			protoBytes []byte
+			createdAt  time.Time
+			event      myncer_pb.SyncRunEvent
+		)
+		if err := rows.Scan(&id, &protoBytes, &createdAt); err != nil {
+			return nil, 0, WrappedError(err, "failed to scan sync run event row")
+		}
+		if err := proto.Unmarshal(protoBytes, &event); err != nil {
+			return nil, 0, WrappedError(err, "failed to unmarshal sync run event proto")
+		}
+		event.CreatedAt = timestamppb.New(createdAt)
+		events = append(events, &event)
+		lastId = id
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, WrappedError(err, "failed to iterate sync run event rows")
+	}
+	return events, lastId, nil
+}
+
+func (s *syncRunEventStoreImpl) GetLatestSyncRunEventId(ctx context.Context, runId string) (int64, error) {
+	var id int64
+	if err := s.db.QueryRowContext(
+		ctx,
+		`SELECT COALESCE(MAX(id), 0) FROM sync_run_events WHERE run_id = $1`,
+		runId,
+	).Scan(&id); err != nil {
+		return 0, WrappedError(err, "failed to get latest sync run event id")
+	}
+	return id, nil
+}
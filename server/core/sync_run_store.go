@@ -15,6 +15,7 @@ type SyncRunStore interface {
 	CreateSyncRun(ctx context.Context, syncRun *myncer_pb.SyncRun /*const*/) error
 	DeleteSyncRun(ctx context.Context, syncRunId string) error
 	UpdateSyncRun(ctx context.Context, syncRun *myncer_pb.SyncRun /*const*/) error
+	GetSyncRun(ctx context.Context, runId string) (*myncer_pb.SyncRun, error)
 	GetSyncs(
 		ctx context.Context,
 		runIds Set[string], // nil, empty indicates no filtering
@@ -85,6 +86,17 @@ func (s *syncRunStoreImpl) UpdateSyncRun(
 	return nil
 }
 
+func (s *syncRunStoreImpl) GetSyncRun(ctx context.Context, runId string) (*myncer_pb.SyncRun, error) {
+	syncRuns, err := s.GetSyncs(ctx, NewSet(runId), nil /*syncIds*/)
+	if err != nil {
+		return nil, WrappedError(err, "failed to get sync runs by id")
+	}
+	if syncRuns.IsEmpty() {
+		return nil, NewError("sync run not found")
+	}
+	return syncRuns.ToArray()[0], nil
+}
+
 func (s *syncRunStoreImpl) GetSyncs(
 	ctx context.Context,
 	runIds Set[string], // nil, empty indicates no filtering
@@ -95,7 +107,7 @@ func (s *syncRunStoreImpl) GetSyncs(
 	if runIds != nil && !runIds.IsEmpty() {
 		conditions = append(
 			conditions,
-			fmt.Sprintf("run_id IN (%s)", makePlaceholders(len(args)+1, runIds.ToArray())),
+			fmt.Sprintf("run_id IN (%s)", makePlaceholders(len(args), runIds.ToArray())),
 		)
 		for _, runId := range runIds.ToArray() {
 			args = append(args, runId)
@@ -104,7 +116,7 @@ func (s *syncRunStoreImpl) GetSyncs(
 	if syncIds != nil && !syncIds.IsEmpty() {
 		conditions = append(
 			conditions,
-			fmt.Sprintf("sync_id IN (%s)", makePlaceholders(len(args)+1, syncIds.ToArray())),
+			fmt.Sprintf("sync_id IN (%s)", makePlaceholders(len(args), syncIds.ToArray())),
 		)
 		for _, syncId := range syncIds.ToArray() {
 			args = append(args, syncId)
@@ -142,3 +154,15 @@ func (s *syncRunStoreImpl) GetSyncs(
 
 	return syncRuns, nil
 }
+
+// IsTerminalSyncStatus returns true if a run with the status will not change anymore.
+func IsTerminalSyncStatus(status myncer_pb.SyncStatus) bool {
+	switch status {
+	case myncer_pb.SyncStatus_SYNC_STATUS_COMPLETED,
+		myncer_pb.SyncStatus_SYNC_STATUS_FAILED,
+		myncer_pb.SyncStatus_SYNC_STATUS_CANCELLED:
+		return true
+	default:
+		return false
+	}
+}
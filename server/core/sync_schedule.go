@@ -0,0 +1,61 @@
+package core
+
+import (
+	"time"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetNextScheduledRunTime returns when a sync on the given interval should run next after `from`.
+// Returns false if the interval does not schedule any runs.
+func GetNextScheduledRunTime(
+	interval myncer_pb.SyncScheduleInterval,
+	from time.Time,
+) (time.Time, bool) {
+	switch interval {
+	case myncer_pb.SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_HOURLY:
+		return from.Add(time.Hour), true
+	case myncer_pb.SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_WEEKLY:
+		return from.AddDate(0 /*years*/, 0 /*months*/, 7 /*days*/), true
+	case myncer_pb.SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_BI_WEEKLY:
+		return from.AddDate(0 /*years*/, 0 /*months*/, 14 /*days*/), true
+	case myncer_pb.SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_MONTHLY:
+		return addMonth(from), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// Returns the same day of the next month, or its last day if the next month is shorter, rather
+// than letting Go roll January 31st over to March 3rd.
+func addMonth(t time.Time) time.Time {
+	firstOfNextMonth := time.Date(
+		t.Year(),
+		t.Month()+1,
+		1, /*day*/
+		t.Hour(),
+		t.Minute(),
+		t.Second(),
+		t.Nanosecond(),
+		t.Location(),
+	)
+	daysInNextMonth := firstOfNextMonth.AddDate(0 /*years*/, 1 /*months*/, -1 /*days*/).Day()
+	return firstOfNextMonth.AddDate(0 /*years*/, 0 /*months*/, min(t.Day(), daysInNextMonth)-1)
+}
+
+// NewSyncSchedule builds a schedule for the interval with its first run computed from `from`.
+// Returns nil for syncs that are only run manually.
+func NewSyncSchedule(
+	interval myncer_pb.SyncScheduleInterval,
+	from time.Time,
+) *myncer_pb.SyncSchedule /*@nullable*/ {
+	nextRunAt, ok := GetNextScheduledRunTime(interval, from)
+	if !ok {
+		return nil
+	}
+	return &myncer_pb.SyncSchedule{
+		Interval:  interval,
+		NextRunAt: timestamppb.New(nextRunAt),
+	}
+}
@@ -0,0 +1,86 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNextScheduledRunTime(t *testing.T) {
+	defaultFrom := time.Date(2025, time.January, 31, 10, 30, 0, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		interval myncer_pb.SyncScheduleInterval
+		// Defaults to defaultFrom.
+		from       time.Time
+		expected   time.Time
+		expectedOk bool
+	}{
+		{
+			name:       "manual sync",
+			interval:   myncer_pb.SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_UNSPECIFIED,
+			expectedOk: false,
+		},
+		{
+			name:       "hourly",
+			interval:   myncer_pb.SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_HOURLY,
+			expected:   time.Date(2025, time.January, 31, 11, 30, 0, 0, time.UTC),
+			expectedOk: true,
+		},
+		{
+			name:       "weekly",
+			interval:   myncer_pb.SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_WEEKLY,
+			expected:   time.Date(2025, time.February, 7, 10, 30, 0, 0, time.UTC),
+			expectedOk: true,
+		},
+		{
+			name:       "bi-weekly",
+			interval:   myncer_pb.SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_BI_WEEKLY,
+			expected:   time.Date(2025, time.February, 14, 10, 30, 0, 0, time.UTC),
+			expectedOk: true,
+		},
+		{
+			name:       "monthly",
+			interval:   myncer_pb.SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_MONTHLY,
+			from:       time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC),
+			expected:   time.Date(2025, time.February, 15, 10, 30, 0, 0, time.UTC),
+			expectedOk: true,
+		},
+		{
+			name:       "monthly into a shorter month",
+			interval:   myncer_pb.SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_MONTHLY,
+			expected:   time.Date(2025, time.February, 28, 10, 30, 0, 0, time.UTC),
+			expectedOk: true,
+		},
+		{
+			name:       "monthly into a leap February",
+			interval:   myncer_pb.SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_MONTHLY,
+			from:       time.Date(2024, time.January, 31, 10, 30, 0, 0, time.UTC),
+			expected:   time.Date(2024, time.February, 29, 10, 30, 0, 0, time.UTC),
+			expectedOk: true,
+		},
+		{
+			name:       "monthly across the year",
+			interval:   myncer_pb.SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_MONTHLY,
+			from:       time.Date(2025, time.December, 31, 10, 30, 0, 0, time.UTC),
+			expected:   time.Date(2026, time.January, 31, 10, 30, 0, 0, time.UTC),
+			expectedOk: true,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(
+			tt.name,
+			func(t *testing.T) {
+				from := tt.from
+				if from.IsZero() {
+					from = defaultFrom
+				}
+				actual, ok := GetNextScheduledRunTime(tt.interval, from)
+				assert.Equal(t, tt.expectedOk, ok)
+				assert.Equal(t, tt.expected, actual)
+			},
+		)
+	}
+}
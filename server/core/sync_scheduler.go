@@ -0,0 +1,11 @@
+package core
+
+import (
+	"context"
+)
+
+type SyncScheduler interface {
+	// Runs scheduled syncs as they become due.
+	// Blocks until the context is cancelled.
+	Start(ctx context.Context)
+}
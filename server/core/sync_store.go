@@ -16,6 +16,33 @@ type SyncStore interface {
 	DeleteSync(ctx context.Context, id string) error
 	GetSync(ctx context.Context, id string) (*myncer_pb.Sync, error)
 	GetSyncs(ctx context.Context, userInfo *myncer_pb.User /*const*/) (Set[*myncer_pb.Sync], error)
+	UpdateSync(ctx context.Context, sync *myncer_pb.Sync /*const*/) error
+	// Applies `modify` to the stored sync and saves it, keeping the sync locked in between so that
+	// changes made through ModifySync or ClaimDueSyncs at the same time aren't lost.
+	// Nothing is saved if `modify` leaves the sync unchanged or returns an error, which is returned
+	// as is.
+	// Returns the sync as stored afterwards.
+	ModifySync(
+		ctx context.Context,
+		id string,
+		modify func(sync *myncer_pb.Sync) error,
+	) (*myncer_pb.Sync, error)
+	// Claims the scheduled syncs whose next run is at or before `now`: `advance` is applied to each,
+	// and must move its next run past `now`, and the syncs are saved in the same transaction.
+	// Due syncs being claimed by another server at the same time are skipped, so every due run is
+	// claimed once.
+	// Returns the claimed syncs.
+	ClaimDueSyncs(
+		ctx context.Context,
+		now time.Time,
+		advance func(sync *myncer_pb.Sync),
+	) ([]*myncer_pb.Sync, error)
+}
+
+// Runs queries either directly on the database or within a transaction.
+type sqlQueryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
 }
 
 func NewSyncStore(db *sql.DB) SyncStore {
@@ -35,10 +62,11 @@ func (s *syncStoreImpl) CreateSync(ctx context.Context, sync *myncer_pb.Sync /*c
 	}
 	if _, err := s.db.ExecContext(
 		ctx,
-		`INSERT INTO syncs (id, user_id, data) VALUES ($1, $2, $3)`,
+		`INSERT INTO syncs (id, user_id, data, next_run_at) VALUES ($1, $2, $3, $4)`,
 		sync.GetId(),
 		sync.GetUserId(),
 		protoBytes,
+		getNextRunAt(sync),
 	); err != nil {
 		return WrappedError(err, "failed to create sync in sql")
 	}
@@ -81,6 +109,83 @@ func (s *syncStoreImpl) GetSyncs(
 	return syncs, nil
 }
 
+func (s *syncStoreImpl) UpdateSync(ctx context.Context, sync *myncer_pb.Sync /*const*/) error {
+	return updateSyncInternal(ctx, s.db, sync)
+}
+
+func (s *syncStoreImpl) ModifySync(
+	ctx context.Context,
+	id string,
+	modify func(sync *myncer_pb.Sync) error,
+) (*myncer_pb.Sync, error) {
+	tx, err := s.db.BeginTx(ctx, nil /*opts*/)
+	if err != nil {
+		return nil, WrappedError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	syncs, err := querySyncs(
+		ctx,
+		tx,
+		`SELECT data, created_at, updated_at FROM syncs WHERE id = $1 FOR UPDATE`,
+		id,
+	)
+	if err != nil {
+		return nil, WrappedError(err, "failed to lock sync")
+	}
+	if syncs.IsEmpty() {
+		return nil, NewError("sync not found")
+	}
+	sync := syncs.ToArray()[0]
+	original := proto.Clone(sync)
+	if err := modify(sync); err != nil {
+		return nil, err
+	}
+	if proto.Equal(original, sync) {
+		return sync, nil
+	}
+	if err := updateSyncInternal(ctx, tx, sync); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, WrappedError(err, "failed to commit sync modification")
+	}
+	return sync, nil
+}
+
+func (s *syncStoreImpl) ClaimDueSyncs(
+	ctx context.Context,
+	now time.Time,
+	advance func(sync *myncer_pb.Sync),
+) ([]*myncer_pb.Sync, error) {
+	tx, err := s.db.BeginTx(ctx, nil /*opts*/)
+	if err != nil {
+		return nil, WrappedError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	dueSyncs, err := querySyncs(
+		ctx,
+		tx,
+		`SELECT data, created_at, updated_at FROM syncs WHERE next_run_at <= $1 FOR UPDATE SKIP LOCKED`,
+		now,
+	)
+	if err != nil {
+		return nil, WrappedError(err, "failed to get due syncs from sql")
+	}
+	r := dueSyncs.ToArray()
+	for _, sync := range r {
+		advance(sync)
+		if err := updateSyncInternal(ctx, tx, sync); err != nil {
+			return nil, WrappedError(err, "failed to advance schedule of sync %s", sync.GetId())
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, WrappedError(err, "failed to commit claimed syncs")
+	}
+	return r, nil
+}
+
 func (s *syncStoreImpl) getSyncsInternal(
 	ctx context.Context,
 	ids Set[string], /*const,@nullable*/ // nil, empty indicates no filtering
@@ -107,7 +212,38 @@ func (s *syncStoreImpl) getSyncsInternal(
 		query += makeWhereAnd(conditions)
 	}
 
-	rows, err := s.db.QueryContext(ctx, query, args...)
+	return querySyncs(ctx, s.db, query, args...)
+}
+
+func updateSyncInternal(ctx context.Context, db sqlQueryer, sync *myncer_pb.Sync /*const*/) error {
+	protoBytes, err := proto.Marshal(sync)
+	if err != nil {
+		return WrappedError(err, "failed to marshal sync proto")
+	}
+	res, err := db.ExecContext(
+		ctx,
+		`UPDATE syncs SET data = $1, next_run_at = $2, updated_at = $3 WHERE id = $4`,
+		protoBytes,
+		getNextRunAt(sync),
+		time.Now(),
+		sync.GetId(),
+	)
+	if err != nil {
+		return WrappedError(err, "failed to update sync in sql")
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return NewError("sync not found")
+	}
+	return nil
+}
+
+func querySyncs(
+	ctx context.Context,
+	db sqlQueryer,
+	query string,
+	args ...any,
+) (Set[*myncer_pb.Sync], error) {
+	rows, err := db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, WrappedError(err, "failed to query syncs from sql")
 	}
@@ -133,3 +269,17 @@ func (s *syncStoreImpl) getSyncsInternal(
 	}
 	return r, nil
 }
+
+// Returns the value stored in the next_run_at column for the sync.
+// Paused syncs have none so that the scheduler never sees them as due.
+func getNextRunAt(sync *myncer_pb.Sync /*const*/) *time.Time /*@nullable*/ {
+	if IsSyncPaused(sync) {
+		return nil
+	}
+	nextRunAt := sync.GetSchedule().GetNextRunAt()
+	if nextRunAt == nil {
+		return nil
+	}
+	t := nextRunAt.AsTime()
+	return &t
+}
@@ -0,0 +1,46 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/hansbala/myncer/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifySync(t *testing.T) {
+	db := testutil.GetTestDatabase(t)
+	ctx := context.Background()
+	sync := testutil.CreateTestSync(t, db, testutil.CreateTestUser(t, db))
+
+	// Concurrent modifications are applied one after the other rather than overwriting each other.
+	const cNumModifications = 10
+	errs := make(chan error, cNumModifications)
+	for range cNumModifications {
+		go func() {
+			_, err := db.SyncStore.ModifySync(ctx, sync.GetId(), func(sync *myncer_pb.Sync) error {
+				sync.ConsecutiveFailures++
+				return nil
+			})
+			errs <- err
+		}()
+	}
+	for range cNumModifications {
+		require.NoError(t, <-errs)
+	}
+
+	// Modifications that fail are not saved.
+	modifyErr := core.NewError("sync is already paused")
+	_, err := db.SyncStore.ModifySync(ctx, sync.GetId(), func(sync *myncer_pb.Sync) error {
+		sync.ConsecutiveFailures = 0
+		return modifyErr
+	})
+	assert.ErrorIs(t, err, modifyErr)
+
+	sync, err = db.SyncStore.GetSync(ctx, sync.GetId())
+	require.NoError(t, err)
+	assert.Equal(t, int32(cNumModifications), sync.GetConsecutiveFailures())
+}
@@ -0,0 +1,11 @@
+package core
+
+import (
+	"context"
+)
+
+type SyncWorkerPool interface {
+	// Executes queued sync jobs and recovers jobs orphaned by dead workers.
+	// Blocks until the context is cancelled.
+	Start(ctx context.Context)
+}
@@ -3,7 +3,9 @@ package datasources
 import (
 	"context"
 	"fmt"
+	"math"
 	"net/http"
+	"slices"
 	"strings"
 
 	spotify "github.com/zmb3/spotify/v2"
@@ -17,9 +19,10 @@ import (
 )
 
 const (
-	cPageLimit       = 50
-	cSpotifyAuthUrl  = "https://accounts.spotify.com/authorize"
-	cSpotifyTokenUrl = "https://accounts.spotify.com/api/token"
+	cPageLimit         = 50
+	cRemoveTracksLimit = 100
+	cSpotifyAuthUrl    = "https://accounts.spotify.com/authorize"
+	cSpotifyTokenUrl   = "https://accounts.spotify.com/api/token"
 )
 
 func NewSpotifyClient() core.DatasourceClient {
@@ -58,7 +61,40 @@ func (s *spotifyClientImpl) GetPlaylist(
 	}
 	playlist, err := client.GetPlaylist(ctx, spotify.ID(playlistId))
 	if err != nil {
-		return nil, core.WrappedError(err, "failed to get spotify playlist with id %s", playlistId)
+		return nil, core.WrappedError(
+			classifySpotifyError(err),
+			"failed to get spotify playlist with id %s",
+			playlistId,
+		)
+	}
+	return spotifyPlaylistToProto(playlist), nil
+}
+
+func (s *spotifyClientImpl) CreatePlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	name string,
+	description string,
+	public bool,
+) (*myncer_pb.Playlist, error) {
+	client, err := s.getClient(ctx, userInfo)
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to get spotify client")
+	}
+	spotifyUser, err := client.CurrentUser(ctx)
+	if err != nil {
+		return nil, core.WrappedError(classifySpotifyError(err), "failed to get current spotify user")
+	}
+	playlist, err := client.CreatePlaylistForUser(
+		ctx,
+		spotifyUser.ID,
+		name,
+		description,
+		public,
+		false, /*collaborative*/
+	)
+	if err != nil {
+		return nil, core.WrappedError(classifySpotifyError(err), "failed to create spotify playlist %s", name)
 	}
 	return spotifyPlaylistToProto(playlist), nil
 }
@@ -66,12 +102,19 @@ func (s *spotifyClientImpl) GetPlaylist(
 func (s *spotifyClientImpl) GetPlaylistSongs(
 	ctx context.Context,
 	userInfo *myncer_pb.User, /*const*/
-	playlistId string,
+	playlist *myncer_pb.MusicSource, /*const*/
 ) ([]core.Song, error) {
 	client, err := s.getClient(ctx, userInfo)
 	if err != nil {
 		return nil, core.WrappedError(err, "failed to get spotify client")
 	}
+	switch playlist.GetKind() {
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_LIKED_TRACKS:
+		return s.getLikedSongs(ctx, client)
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_SAVED_ALBUMS:
+		return s.getSavedAlbumSongs(ctx, client)
+	}
+	playlistId := playlist.GetPlaylistId()
 	// Use GetPlaylistItems to fetch all songs in the playlist.
 	if len(playlistId) == 0 {
 		return nil, core.NewError("invalid playlist id")
@@ -91,7 +134,7 @@ func (s *spotifyClientImpl) GetPlaylistSongs(
 				core.Printf("Spotify API rate limit hit, with message: %s", spotifyErr.Message)
 			}
 			return nil, core.WrappedError(
-				err,
+				classifySpotifyError(err),
 				"failed to get playlist items for playlist %s at offset %d",
 				playlistId,
 				offset,
@@ -99,7 +142,9 @@ func (s *spotifyClientImpl) GetPlaylistSongs(
 		}
 		for _, item := range playlistTracks.Items {
 			if item.Track.Track != nil {
-				allSongs = append(allSongs, buildSongFromSpotifyTrack(ctx, item.Track.Track))
+				song := buildSongFromSpotifyTrack(ctx, item.Track.Track)
+				song.GetSpec().AddedAt = parseTimestamp(item.AddedAt)
+				allSongs = append(allSongs, song)
 			}
 		}
 		if len(playlistTracks.Items) < cPageLimit {
@@ -162,7 +207,7 @@ func (s *spotifyClientImpl) GetPlaylists(
 func (s *spotifyClientImpl) AddToPlaylist(
 	ctx context.Context,
 	userInfo *myncer_pb.User, /*const*/
-	playlistId string, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
 	songs []core.Song, /*const*/
 ) error {
 	client, err := s.getClient(ctx, userInfo)
@@ -173,8 +218,15 @@ func (s *spotifyClientImpl) AddToPlaylist(
 	for _, song := range songs {
 		trackIds = append(trackIds, spotify.ID(song.GetId()))
 	}
+	switch playlist.GetKind() {
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_LIKED_TRACKS:
+		return s.updateLikedSongs(ctx, client, trackIds, client.AddTracksToLibrary)
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_SAVED_ALBUMS:
+		return core.NewUnsupportedMusicSourceError(playlist, "adding songs")
+	}
+	playlistId := playlist.GetPlaylistId()
 	if _, err := client.AddTracksToPlaylist(ctx, spotify.ID(playlistId), trackIds...); err != nil {
-		return core.WrappedError(err, "failed to add tracks to playlist %s", playlistId)
+		return core.WrappedError(classifySpotifyError(err), "failed to add tracks to playlist %s", playlistId)
 	}
 	return nil
 }
@@ -182,16 +234,31 @@ func (s *spotifyClientImpl) AddToPlaylist(
 func (s *spotifyClientImpl) ClearPlaylist(
 	ctx context.Context,
 	userInfo *myncer_pb.User, /*const*/
-	playlistId string, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
 ) error {
 	client, err := s.getClient(ctx, userInfo)
 	if err != nil {
 		return core.WrappedError(err, "failed to get spotify client")
 	}
+	switch playlist.GetKind() {
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_LIKED_TRACKS:
+		likedSongs, err := s.getLikedSongs(ctx, client)
+		if err != nil {
+			return err
+		}
+		trackIds := []spotify.ID{}
+		for _, song := range likedSongs {
+			trackIds = append(trackIds, spotify.ID(song.GetId()))
+		}
+		return s.updateLikedSongs(ctx, client, trackIds, client.RemoveTracksFromLibrary)
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_SAVED_ALBUMS:
+		return core.NewUnsupportedMusicSourceError(playlist, "clearing")
+	}
+	playlistId := playlist.GetPlaylistId()
 	// Fetch all track URIs to remove
 	playlistTracks, err := client.GetPlaylistItems(ctx, spotify.ID(playlistId))
 	if err != nil {
-		return core.WrappedError(err, "failed to fetch playlist items")
+		return core.WrappedError(classifySpotifyError(err), "failed to fetch playlist items")
 	}
 
 	trackIDs := []spotify.ID{}
@@ -206,7 +273,231 @@ func (s *spotifyClientImpl) ClearPlaylist(
 	}
 	_, err = client.RemoveTracksFromPlaylist(ctx, spotify.ID(playlistId), trackIDs...)
 	if err != nil {
-		return core.WrappedError(err, "failed to clear playlist")
+		return core.WrappedError(classifySpotifyError(err), "failed to clear playlist")
+	}
+	return nil
+}
+
+func (s *spotifyClientImpl) RemoveFromPlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
+	songs []core.Song, /*const*/
+) error {
+	client, err := s.getClient(ctx, userInfo)
+	if err != nil {
+		return core.WrappedError(err, "failed to get spotify client")
+	}
+	trackIds := []spotify.ID{}
+	for _, song := range songs {
+		trackIds = append(trackIds, spotify.ID(song.GetId()))
+	}
+	switch playlist.GetKind() {
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_LIKED_TRACKS:
+		return s.updateLikedSongs(ctx, client, trackIds, client.RemoveTracksFromLibrary)
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_SAVED_ALBUMS:
+		return core.NewUnsupportedMusicSourceError(playlist, "removing songs")
+	}
+	playlistId := playlist.GetPlaylistId()
+	// Spotify removes every occurrence of a track, up to 100 tracks per request.
+	for i := 0; i < len(trackIds); i += cRemoveTracksLimit {
+		if err := core.CheckSyncRunCancelled(ctx); err != nil {
+			return core.WrappedError(err, "stopped removing tracks from playlist %s after %d tracks", playlistId, i)
+		}
+		end := min(i+cRemoveTracksLimit, len(trackIds))
+		if _, err := client.RemoveTracksFromPlaylist(ctx, spotify.ID(playlistId), trackIds[i:end]...); err != nil {
+			return core.WrappedError(classifySpotifyError(err), "failed to remove tracks from playlist %s", playlistId)
+		}
+	}
+	return nil
+}
+
+func (s *spotifyClientImpl) ReorderPlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
+	songs []core.Song, /*const*/
+) error {
+	if !core.IsMusicSourceReorderable(playlist) {
+		return core.NewUnsupportedMusicSourceError(playlist, "reordering")
+	}
+	client, err := s.getClient(ctx, userInfo)
+	if err != nil {
+		return core.WrappedError(err, "failed to get spotify client")
+	}
+	playlistId := playlist.GetPlaylistId()
+	currentIds, err := s.getPlaylistItemIds(ctx, client, playlistId)
+	if err != nil {
+		return core.WrappedError(err, "failed to get playlist items to reorder")
+	}
+	moves, err := getPlaylistMoves(currentIds, getSongIds(songs))
+	if err != nil {
+		return core.WrappedError(err, "failed to reorder playlist %s", playlistId)
+	}
+	for i, move := range moves {
+		if err := core.CheckSyncRunCancelled(ctx); err != nil {
+			return core.WrappedError(err, "stopped reordering playlist %s after %d moves", playlistId, i)
+		}
+		if _, err := client.ReorderPlaylistTracks(
+			ctx,
+			spotify.ID(playlistId),
+			spotify.PlaylistReorderOptions{
+				RangeStart:   spotify.Numeric(move.from),
+				RangeLength:  1,
+				InsertBefore: spotify.Numeric(move.to),
+			},
+		); err != nil {
+			return core.WrappedError(classifySpotifyError(err), "failed to reorder playlist %s", playlistId)
+		}
+	}
+	return nil
+}
+
+// Returns the track ids of every item of the playlist, in order.
+// Items that GetPlaylistSongs skips, such as episodes and removed tracks, have an empty id so that
+// the ids line up with the positions of the playlist.
+func (s *spotifyClientImpl) getPlaylistItemIds(
+	ctx context.Context,
+	client *spotify.Client,
+	playlistId string,
+) ([]string, error) {
+	ids := []string{}
+	for offset := 0; ; offset += cPageLimit {
+		page, err := client.GetPlaylistItems(
+			ctx,
+			spotify.ID(playlistId),
+			spotify.Limit(cPageLimit),
+			spotify.Offset(offset),
+		)
+		if err != nil {
+			return nil, core.WrappedError(
+				classifySpotifyError(err),
+				"failed to get playlist items for playlist %s at offset %d",
+				playlistId,
+				offset,
+			)
+		}
+		for _, item := range page.Items {
+			if item.Track.Track == nil {
+				ids = append(ids, "")
+				continue
+			}
+			ids = append(ids, item.Track.Track.ID.String())
+		}
+		if len(page.Items) < cPageLimit {
+			return ids, nil
+		}
+	}
+}
+
+// Returns the user's Liked Songs, most recently liked first.
+func (s *spotifyClientImpl) getLikedSongs(ctx context.Context, client *spotify.Client) ([]core.Song, error) {
+	allSongs := []core.Song{}
+	for offset := 0; ; offset += cPageLimit {
+		page, err := client.CurrentUsersTracks(ctx, spotify.Limit(cPageLimit), spotify.Offset(offset))
+		if err != nil {
+			return nil, core.WrappedError(
+				classifySpotifyError(err),
+				"failed to get liked songs at offset %d",
+				offset,
+			)
+		}
+		for _, item := range page.Tracks {
+			song := buildSongFromSpotifyTrack(ctx, &item.FullTrack)
+			song.GetSpec().AddedAt = parseTimestamp(item.AddedAt)
+			allSongs = append(allSongs, song)
+		}
+		if len(page.Tracks) < cPageLimit {
+			// No more pages left to get.
+			break
+		}
+	}
+	return allSongs, nil
+}
+
+// Returns the tracks of the user's saved albums, album by album.
+func (s *spotifyClientImpl) getSavedAlbumSongs(ctx context.Context, client *spotify.Client) ([]core.Song, error) {
+	allSongs := []core.Song{}
+	for offset := 0; ; offset += cPageLimit {
+		page, err := client.CurrentUsersAlbums(ctx, spotify.Limit(cPageLimit), spotify.Offset(offset))
+		if err != nil {
+			return nil, core.WrappedError(
+				classifySpotifyError(err),
+				"failed to get saved albums at offset %d",
+				offset,
+			)
+		}
+		for _, album := range page.Albums {
+			tracks, err := s.getAlbumTracks(ctx, client, &album.FullAlbum)
+			if err != nil {
+				return nil, err
+			}
+			for _, track := range tracks {
+				// Album tracks are simplified, so the ISRC isn't known.
+				song := buildSongFromSpotifyTrack(
+					ctx,
+					&spotify.FullTrack{SimpleTrack: track, Album: album.SimpleAlbum},
+				)
+				song.GetSpec().AddedAt = parseTimestamp(album.AddedAt)
+				allSongs = append(allSongs, song)
+			}
+		}
+		if len(page.Albums) < cPageLimit {
+			// No more pages left to get.
+			break
+		}
+	}
+	return allSongs, nil
+}
+
+// Returns every track of the album. Albums come with their first page of tracks, the rest are
+// fetched.
+func (s *spotifyClientImpl) getAlbumTracks(
+	ctx context.Context,
+	client *spotify.Client,
+	album *spotify.FullAlbum, /*const*/
+) ([]spotify.SimpleTrack, error) {
+	tracks := slices.Clone(album.Tracks.Tracks)
+	for len(tracks) < int(album.Tracks.Total) {
+		page, err := client.GetAlbumTracks(
+			ctx,
+			album.ID,
+			spotify.Limit(cPageLimit),
+			spotify.Offset(len(tracks)),
+		)
+		if err != nil {
+			return nil, core.WrappedError(
+				classifySpotifyError(err),
+				"failed to get tracks of album %s at offset %d",
+				album.ID,
+				len(tracks),
+			)
+		}
+		if len(page.Tracks) == 0 {
+			// The album changed since it was fetched.
+			break
+		}
+		tracks = append(tracks, page.Tracks...)
+	}
+	return tracks, nil
+}
+
+// Adds the tracks to or removes them from the user's Liked Songs, which takes up to 50 tracks per
+// request.
+func (s *spotifyClientImpl) updateLikedSongs(
+	ctx context.Context,
+	client *spotify.Client,
+	trackIds []spotify.ID, /*const*/
+	update func(ctx context.Context, ids ...spotify.ID) error,
+) error {
+	for i := 0; i < len(trackIds); i += cPageLimit {
+		if err := core.CheckSyncRunCancelled(ctx); err != nil {
+			return core.WrappedError(err, "stopped updating liked songs after %d tracks", i)
+		}
+		end := min(i+cPageLimit, len(trackIds))
+		if err := update(ctx, trackIds[i:end]...); err != nil {
+			return core.WrappedError(classifySpotifyError(err), "failed to update liked songs")
+		}
 	}
 	return nil
 }
@@ -274,7 +565,8 @@ func (s *spotifyClientImpl) Search(
 	ctx context.Context,
 	userInfo *myncer_pb.User,
 	songToSearch core.Song,
-) (core.Song, error) {
+	profile *myncer_pb.MatchingProfile, /*const,@nullable*/
+) ([]*core.SearchCandidate, error) {
 	client, err := s.getClient(ctx, userInfo)
 	if err != nil {
 		return nil, core.WrappedError(err, "failed to get spotify client")
@@ -284,19 +576,33 @@ func (s *spotifyClientImpl) Search(
 	if isrc := songToSearch.GetSpec().GetIsrc(); isrc != "" {
 		query := fmt.Sprintf("isrc:%s", isrc)
 		searchResult, err := client.Search(ctx, query, spotify.SearchTypeTrack, spotify.Limit(1))
-		if err == nil && searchResult.Tracks != nil && len(searchResult.Tracks.Tracks) > 0 {
-			return buildSongFromSpotifyTrack(ctx, &searchResult.Tracks.Tracks[0]), nil
+		if err != nil {
+			err = classifySpotifyError(err)
+			if core.IsRetryableError(err) {
+				return nil, core.WrappedError(err, "spotify search failed for isrc %s", isrc)
+			}
+			core.Warningf("Spotify search failed for isrc %s, searching by metadata. Error: %v", isrc, err)
+		} else if searchResult.Tracks != nil && len(searchResult.Tracks.Tracks) > 0 {
+			// Songs with the same ISRC are the same recording.
+			return []*core.SearchCandidate{
+				{Song: buildSongFromSpotifyTrack(ctx, &searchResult.Tracks.Tracks[0]), Score: 100.0},
+			}, nil
 		}
 	}
 
 	// If no ISRC or it fails, proceed with metadata search.
 	queries := buildSpotifyQueries(songToSearch)
-	var bestMatch core.Song
+	candidates := []*core.SearchCandidate{}
 	highestScore := 0.0
 
 	for _, query := range queries {
 		searchResult, err := client.Search(ctx, query, spotify.SearchTypeTrack, spotify.Limit(5))
 		if err != nil {
+			// Rate limits and outages would fail every other query too.
+			err = classifySpotifyError(err)
+			if core.IsRetryableError(err) {
+				return nil, core.WrappedError(err, "spotify search failed for query %q", query)
+			}
 			core.Warningf("Spotify search failed for query %q, trying next. Error: %v", query, err)
 			continue
 		}
@@ -304,30 +610,23 @@ func (s *spotifyClientImpl) Search(
 		if searchResult.Tracks != nil {
 			for _, track := range searchResult.Tracks.Tracks {
 				foundSong := buildSongFromSpotifyTrack(ctx, &track)
-				score := matching.CalculateSimilarity(songToSearch, foundSong)
-
-				if score > highestScore {
-					highestScore = score
-					bestMatch = foundSong
-				}
+				score := matching.CalculateSimilarity(songToSearch, foundSong, profile)
+				candidates = append(candidates, &core.SearchCandidate{Song: foundSong, Score: score})
+				highestScore = math.Max(highestScore, score)
 
 				// If we find a nearly perfect match, we can stop early.
-				if highestScore > 95.0 {
-					return bestMatch, nil
+				if matching.IsExactMatch(highestScore, profile, myncer_pb.Datasource_DATASOURCE_SPOTIFY) {
+					return matching.RankCandidates(candidates), nil
 				}
 			}
 		}
 		// If we found a good candidate with a specific query, don't continue with more generic ones.
-		if highestScore > 85.0 {
+		if matching.IsConfidentMatch(highestScore, profile, myncer_pb.Datasource_DATASOURCE_SPOTIFY) {
 			break
 		}
 	}
 
-	if bestMatch == nil {
-		return nil, core.NewError("no suitable track found after trying all queries for: %s", songToSearch.GetName())
-	}
-
-	return bestMatch, nil
+	return matching.RankCandidates(candidates), nil
 }
 
 func (s *spotifyClientImpl) getClient(
@@ -388,6 +687,8 @@ func buildSongFromSpotifyTrack(
 			Datasource:       myncer_pb.Datasource_DATASOURCE_SPOTIFY,
 			DatasourceSongId: track.ID.String(),
 			Isrc:             isrc,
+			Explicit:         track.Explicit,
+			DurationMs:       int64(track.Duration),
 		},
 	)
 }
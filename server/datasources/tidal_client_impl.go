@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/url"
 	"strings"
@@ -26,7 +27,6 @@ const (
 	cTidalAPIBaseURL   = "https://openapi.tidal.com/v2"
 	cTidalPageLimit    = 50
 	cTidalAcceptHeader = "application/vnd.api+json"
-	cMinimumSimilarityThreshold = 65.0
 )
 
 // TidalResourceIdentifier is a JSON:API resource identifier
@@ -42,10 +42,13 @@ type TidalRelationship struct {
 
 // TidalTrackAttributes contains the attributes of a track
 type TidalTrackAttributes struct {
-	Title   string          `json:"title"`
-	ISRC    string          `json:"isrc"`
-	Album   TidalV2Album    `json:"album"`
-	Artists []TidalV2Artist `json:"artists"`
+	Title    string          `json:"title"`
+	ISRC     string          `json:"isrc"`
+	Album    TidalV2Album    `json:"album"`
+	Artists  []TidalV2Artist `json:"artists"`
+	Explicit bool            `json:"explicit"`
+	// ISO 8601 duration, e.g. PT3M20S.
+	Duration string `json:"duration"`
 }
 
 // TidalV2TrackResource is a track resource object
@@ -105,7 +108,8 @@ type PlaylistItemIdentifier struct {
 	ID   string `json:"id"`
 	Type string `json:"type"`
 	Meta struct {
-		ItemID string `json:"itemId"`
+		ItemID  string `json:"itemId"`
+		AddedAt string `json:"addedAt"`
 	} `json:"meta"`
 }
 
@@ -169,7 +173,7 @@ func getTidalUserInfo(ctx context.Context, client *http.Client) (string, string,
 	core.Printf("Tidal: Fetching user info from %s", req.URL)
 	resp, err := client.Do(req)
 	if err != nil {
-		return "", "", core.WrappedError(err, "failed to get current user from Tidal")
+		return "", "", core.WrappedError(classifyHttpError(err), "failed to get current user from Tidal")
 	}
 	defer resp.Body.Close()
 
@@ -181,8 +185,8 @@ func getTidalUserInfo(ctx context.Context, client *http.Client) (string, string,
 	core.Printf("Tidal: Response from %s -> Status: %s", req.URL, resp.Status)
 
 	if resp.StatusCode != http.StatusOK {
-		core.Errorf("Tidal API Error for /users/me. Status: %s, Body: %s", resp.Status, string(body))
-		return "", "", core.NewError("Tidal API returned status %d for /users/me. Body: %s", resp.StatusCode, string(body))
+		core.Errorf(core.NewError("Tidal API Error for /users/me. Status: %s, Body: %s", resp.Status, string(body)))
+		return "", "", classifyHttpStatusError(resp.StatusCode, core.NewError("Tidal API returned status %d for /users/me. Body: %s", resp.StatusCode, string(body)))
 	}
 
 	var userResponse TidalMeResponse
@@ -207,9 +211,9 @@ func NewTidalClient() core.DatasourceClient {
 
 type tidalClientImpl struct {
 	// Mutex para proteger el acceso a los campos de operación
-	mu           sync.Mutex
-	httpClient   *http.Client
-	tidalUserID  string
+	mu               sync.Mutex
+	httpClient       *http.Client
+	tidalUserID      string
 	tidalCountryCode string
 }
 
@@ -262,7 +266,7 @@ func (c *tidalClientImpl) ensureUserInfo(ctx context.Context, userInfo *myncer_p
 	core.Printf("Tidal: Fetching user info from %s", req.URL)
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return core.WrappedError(err, "failed to get current user from Tidal")
+		return core.WrappedError(classifyHttpError(err), "failed to get current user from Tidal")
 	}
 	defer resp.Body.Close()
 
@@ -274,8 +278,8 @@ func (c *tidalClientImpl) ensureUserInfo(ctx context.Context, userInfo *myncer_p
 	core.Printf("Tidal: Response from %s -> Status: %s", req.URL, resp.Status)
 
 	if resp.StatusCode != http.StatusOK {
-		core.Errorf("Tidal API Error for /users/me. Status: %s, Body: %s", resp.Status, string(body))
-		return core.NewError("Tidal API returned status %d for /users/me. Body: %s", resp.StatusCode, string(body))
+		core.Errorf(core.NewError("Tidal API Error for /users/me. Status: %s, Body: %s", resp.Status, string(body)))
+		return classifyHttpStatusError(resp.StatusCode, core.NewError("Tidal API returned status %d for /users/me. Body: %s", resp.StatusCode, string(body)))
 	}
 
 	var userResponse TidalMeResponse
@@ -312,7 +316,14 @@ func (c *tidalClientImpl) getOAuthConfig(ctx context.Context) *oauth2.Config {
 			AuthURL:  cTidalAuthURL,
 			TokenURL: cTidalTokenURL,
 		},
-		Scopes: []string{"user.read", "playlists.read", "playlists.write", "collection.read", "search.read"},
+		Scopes: []string{
+			"user.read",
+			"playlists.read",
+			"playlists.write",
+			"collection.read",
+			"collection.write",
+			"search.read",
+		},
 	}
 }
 
@@ -350,7 +361,7 @@ func (c *tidalClientImpl) GetPlaylists(ctx context.Context, userInfo *myncer_pb.
 		core.Printf("Tidal: Fetching user collection playlists for user %s from URL: %s", c.tidalUserID, collectionNextURL)
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
-			return nil, core.WrappedError(err, "failed to get Tidal user collection playlists from URL: %s", collectionNextURL)
+			return nil, core.WrappedError(classifyHttpError(err), "failed to get Tidal user collection playlists from URL: %s", collectionNextURL)
 		}
 
 		body, err := io.ReadAll(resp.Body)
@@ -363,14 +374,14 @@ func (c *tidalClientImpl) GetPlaylists(ctx context.Context, userInfo *myncer_pb.
 		core.Printf("Tidal: Response from %s -> Status: %s", collectionNextURL, resp.Status)
 
 		if resp.StatusCode != http.StatusOK {
-			core.Errorf("Tidal API Error for user collection playlists. Status: %s, Body: %s", resp.Status, string(body))
+			core.Errorf(core.NewError("Tidal API Error for user collection playlists. Status: %s, Body: %s", resp.Status, string(body)))
 			// Continue to the next fetch type instead of failing completely
 			break
 		}
 
 		var playlistsResp UserCollectionPlaylistsResponse
 		if err := json.Unmarshal(body, &playlistsResp); err != nil {
-			core.Errorf("Failed to decode Tidal user collection playlists response: %v. Body: %s", err, string(body))
+			core.Errorf(core.NewError("Failed to decode Tidal user collection playlists response: %v. Body: %s", err, string(body)))
 			// Continue to the next fetch type
 			break
 		}
@@ -406,7 +417,7 @@ func (c *tidalClientImpl) GetPlaylists(ctx context.Context, userInfo *myncer_pb.
 		core.Printf("Tidal: Fetching owned playlists for user %s from URL: %s", c.tidalUserID, ownedNextURL)
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
-			return nil, core.WrappedError(err, "failed to get Tidal owned playlists from URL: %s", ownedNextURL)
+			return nil, core.WrappedError(classifyHttpError(err), "failed to get Tidal owned playlists from URL: %s", ownedNextURL)
 		}
 
 		body, err := io.ReadAll(resp.Body)
@@ -419,14 +430,14 @@ func (c *tidalClientImpl) GetPlaylists(ctx context.Context, userInfo *myncer_pb.
 		core.Printf("Tidal: Response from %s -> Status: %s", ownedNextURL, resp.Status)
 
 		if resp.StatusCode != http.StatusOK {
-			core.Errorf("Tidal API Error for owned playlists. Status: %s, Body: %s", resp.Status, string(body))
+			core.Errorf(core.NewError("Tidal API Error for owned playlists. Status: %s, Body: %s", resp.Status, string(body)))
 			// Break the loop on error but don't discard what we already have
 			break
 		}
 
 		var playlistsResp PlaylistsV2Response
 		if err := json.Unmarshal(body, &playlistsResp); err != nil {
-			core.Errorf("Failed to decode Tidal owned playlists response: %v. Body: %s", err, string(body))
+			core.Errorf(core.NewError("Failed to decode Tidal owned playlists response: %v. Body: %s", err, string(body)))
 			break
 		}
 
@@ -473,7 +484,7 @@ func (c *tidalClientImpl) GetPlaylist(ctx context.Context, userInfo *myncer_pb.U
 	core.Printf("Tidal: Fetching playlist details for playlist %s", playlistId)
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, core.WrappedError(err, "failed to get Tidal playlist %s", playlistId)
+		return nil, core.WrappedError(classifyHttpError(err), "failed to get Tidal playlist %s", playlistId)
 	}
 	defer resp.Body.Close()
 
@@ -485,8 +496,8 @@ func (c *tidalClientImpl) GetPlaylist(ctx context.Context, userInfo *myncer_pb.U
 	core.Printf("Tidal: Response from %s -> Status: %s", url, resp.Status)
 
 	if resp.StatusCode != http.StatusOK {
-		core.Errorf("Tidal API Error for playlist %s. Status: %s, Body: %s", playlistId, resp.Status, string(body))
-		return nil, core.NewError("Tidal API returned status %d for playlist %s. Body: %s", resp.StatusCode, playlistId, string(body))
+		core.Errorf(core.NewError("Tidal API Error for playlist %s. Status: %s, Body: %s", playlistId, resp.Status, string(body)))
+		return nil, classifyHttpStatusError(resp.StatusCode, core.NewError("Tidal API returned status %d for playlist %s. Body: %s", resp.StatusCode, playlistId, string(body)))
 	}
 
 	var playlistResp SinglePlaylistV2Response
@@ -502,54 +513,121 @@ func (c *tidalClientImpl) GetPlaylist(ctx context.Context, userInfo *myncer_pb.U
 	}, nil
 }
 
-func (c *tidalClientImpl) GetPlaylistSongs(ctx context.Context, userInfo *myncer_pb.User, playlistId string) ([]core.Song, error) {
+func (c *tidalClientImpl) CreatePlaylist(ctx context.Context, userInfo *myncer_pb.User, name string, description string, public bool) (*myncer_pb.Playlist, error) {
 	if err := c.ensureUserInfo(ctx, userInfo); err != nil {
 		return nil, core.WrappedError(err, "failed to ensure Tidal user info")
 	}
 
-	var allSongs []core.Song
+	// Tidal playlists that aren't public can still be opened by anyone with the link.
+	accessType := "UNLISTED"
+	if public {
+		accessType = "PUBLIC"
+	}
+	payload := map[string]any{
+		"data": map[string]any{
+			"type": "playlists",
+			"attributes": map[string]string{
+				"name":        name,
+				"description": description,
+				"accessType":  accessType,
+			},
+		},
+	}
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to marshal create playlist payload")
+	}
 
-	nextURL := fmt.Sprintf("%s/playlists/%s/relationships/items?countryCode=%s&include=items&limit=%d",
-		cTidalAPIBaseURL,
-		playlistId,
-		c.tidalCountryCode,
-		cTidalPageLimit)
+	url := fmt.Sprintf("%s/playlists?countryCode=%s", cTidalAPIBaseURL, c.tidalCountryCode)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadBytes))
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to create create playlist request")
+	}
+	req.Header.Set("Content-Type", "application/vnd.api+json")
+	req.Header.Set("Accept", cTidalAcceptHeader)
 
-	for nextURL != "" {
-		req, err := http.NewRequestWithContext(ctx, "GET", nextURL, nil)
-		if err != nil {
-			return nil, core.WrappedError(err, "failed to create request for Tidal playlist items")
-		}
-		req.Header.Set("Accept", cTidalAcceptHeader)
+	core.Printf("Tidal: Creating playlist %s", name)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, core.WrappedError(classifyHttpError(err), "failed to create Tidal playlist %s", name)
+	}
+	defer resp.Body.Close()
 
-		core.Printf("Tidal: Fetching songs for playlist %s from URL: %s", playlistId, nextURL)
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			return nil, core.WrappedError(err, "failed to get Tidal playlist items from URL: %s", nextURL)
-		}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to read response body when creating playlist")
+	}
 
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			resp.Body.Close()
-			return nil, core.WrappedError(err, "failed to read response body from Tidal playlist items")
-		}
-		resp.Body.Close()
+	core.Printf("Tidal: Response from POST %s -> Status: %s", url, resp.Status)
 
-		core.Printf("Tidal: Response from %s -> Status: %s", nextURL, resp.Status)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		core.Errorf(core.NewError("Tidal API Error creating playlist. Status: %s, Body: %s", resp.Status, string(body)))
+		return nil, classifyHttpStatusError(resp.StatusCode, core.NewError("Tidal API returned status %d when creating playlist. Body: %s", resp.StatusCode, string(body)))
+	}
 
-		if resp.StatusCode != http.StatusOK {
-			core.Errorf("Tidal API Error for playlist items. Status: %s, Body: %s", resp.Status, string(body))
-			return nil, core.NewError("Tidal API returned status %d for playlist items. Body: %s", resp.StatusCode, string(body))
-		}
+	var playlistResp SinglePlaylistV2Response
+	if err := json.Unmarshal(body, &playlistResp); err != nil {
+		return nil, core.WrappedError(err, "failed to decode created Tidal playlist response")
+	}
+
+	p := playlistResp.Data
+	return &myncer_pb.Playlist{
+		MusicSource: createMusicSource(myncer_pb.Datasource_DATASOURCE_TIDAL, p.ID),
+		Name:        p.Attributes.Name,
+		Description: p.Attributes.Description,
+	}, nil
+}
+
+func (c *tidalClientImpl) GetPlaylistSongs(ctx context.Context, userInfo *myncer_pb.User, playlist *myncer_pb.MusicSource) ([]core.Song, error) {
+	if err := c.ensureUserInfo(ctx, userInfo); err != nil {
+		return nil, core.WrappedError(err, "failed to ensure Tidal user info")
+	}
 
+	switch playlist.GetKind() {
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_LIKED_TRACKS:
+		return c.getTrackSongs(
+			ctx,
+			fmt.Sprintf("%s/userCollections/%s/relationships/tracks?countryCode=%s&include=tracks&limit=%d",
+				cTidalAPIBaseURL,
+				c.tidalUserID,
+				c.tidalCountryCode,
+				cTidalPageLimit),
+			"favorite tracks",
+		)
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_SAVED_ALBUMS:
+		return c.getSavedAlbumSongs(ctx)
+	}
+	return c.getTrackSongs(
+		ctx,
+		fmt.Sprintf("%s/playlists/%s/relationships/items?countryCode=%s&include=items&limit=%d",
+			cTidalAPIBaseURL,
+			playlist.GetPlaylistId(),
+			c.tidalCountryCode,
+			cTidalPageLimit),
+		"playlist "+playlist.GetPlaylistId(),
+	)
+}
+
+// Fetches every page of a relationship to tracks, like the items of a playlist.
+func (c *tidalClientImpl) getTrackSongs(ctx context.Context, nextURL string, description string) ([]core.Song, error) {
+	var allSongs []core.Song
+	for nextURL != "" {
+		core.Printf("Tidal: Fetching songs for %s from URL: %s", description, nextURL)
 		var itemsResp PlaylistItemsV2Response
-		if err := json.Unmarshal(body, &itemsResp); err != nil {
-			return nil, core.WrappedError(err, "failed to decode Tidal v2 playlist items response")
+		if err := c.getJsonApiPage(ctx, nextURL, &itemsResp); err != nil {
+			return nil, core.WrappedError(err, "failed to get songs for Tidal %s", description)
 		}
 
+		// The included tracks don't say when they were added, the items do.
+		addedAtByTrackId := map[string]string{}
+		for _, item := range itemsResp.Data {
+			addedAtByTrackId[item.ID] = item.Meta.AddedAt
+		}
 		for _, trackResource := range itemsResp.Included {
 			if trackResource.Type == "tracks" {
-				allSongs = append(allSongs, buildSongFromTidalV2Track(trackResource))
+				song := buildSongFromTidalV2Track(trackResource)
+				song.GetSpec().AddedAt = parseTimestamp(addedAtByTrackId[trackResource.ID])
+				allSongs = append(allSongs, song)
 			}
 		}
 
@@ -562,10 +640,94 @@ func (c *tidalClientImpl) GetPlaylistSongs(ctx context.Context, userInfo *myncer
 	return allSongs, nil
 }
 
-func (c *tidalClientImpl) AddToPlaylist(ctx context.Context, userInfo *myncer_pb.User, playlistId string, songs []core.Song) error {
+// Returns the tracks of the albums in the user's collection, album by album.
+func (c *tidalClientImpl) getSavedAlbumSongs(ctx context.Context) ([]core.Song, error) {
+	var albums []PlaylistItemIdentifier
+	nextURL := fmt.Sprintf("%s/userCollections/%s/relationships/albums?countryCode=%s&limit=%d",
+		cTidalAPIBaseURL,
+		c.tidalUserID,
+		c.tidalCountryCode,
+		cTidalPageLimit)
+	for nextURL != "" {
+		var albumsResp PlaylistItemsV2Response
+		if err := c.getJsonApiPage(ctx, nextURL, &albumsResp); err != nil {
+			return nil, core.WrappedError(err, "failed to get Tidal saved albums")
+		}
+		albums = append(albums, albumsResp.Data...)
+		if albumsResp.Links.Next != "" {
+			nextURL = fmt.Sprintf("%s%s", "https://openapi.tidal.com", albumsResp.Links.Next)
+		} else {
+			nextURL = ""
+		}
+	}
+
+	var allSongs []core.Song
+	for _, album := range albums {
+		if err := core.CheckSyncRunCancelled(ctx); err != nil {
+			return nil, err
+		}
+		songs, err := c.getTrackSongs(
+			ctx,
+			fmt.Sprintf("%s/albums/%s/relationships/items?countryCode=%s&include=items&limit=%d",
+				cTidalAPIBaseURL,
+				album.ID,
+				c.tidalCountryCode,
+				cTidalPageLimit),
+			"album "+album.ID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		// The songs were added along with their album.
+		for _, song := range songs {
+			song.GetSpec().AddedAt = parseTimestamp(album.Meta.AddedAt)
+		}
+		allSongs = append(allSongs, songs...)
+	}
+	return allSongs, nil
+}
+
+// Fetches one page of a JSON:API resource into `v`.
+func (c *tidalClientImpl) getJsonApiPage(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return core.WrappedError(err, "failed to create request for %s", url)
+	}
+	req.Header.Set("Accept", cTidalAcceptHeader)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return core.WrappedError(classifyHttpError(err), "failed to get URL: %s", url)
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return core.WrappedError(err, "failed to read response body from %s", url)
+	}
+
+	core.Printf("Tidal: Response from %s -> Status: %s", url, resp.Status)
+
+	if resp.StatusCode != http.StatusOK {
+		core.Errorf(core.NewError("Tidal API Error for %s. Status: %s, Body: %s", url, resp.Status, string(body)))
+		return classifyHttpStatusError(resp.StatusCode, core.NewError("Tidal API returned status %d for %s. Body: %s", resp.StatusCode, url, string(body)))
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return core.WrappedError(err, "failed to decode Tidal response from %s", url)
+	}
+	return nil
+}
+
+func (c *tidalClientImpl) AddToPlaylist(ctx context.Context, userInfo *myncer_pb.User, playlist *myncer_pb.MusicSource, songs []core.Song) error {
 	if err := c.ensureUserInfo(ctx, userInfo); err != nil {
 		return core.WrappedError(err, "failed to ensure Tidal user info")
 	}
+	switch playlist.GetKind() {
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_LIKED_TRACKS:
+		return c.updateFavoriteTracks(ctx, "POST", songs)
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_SAVED_ALBUMS:
+		return core.NewUnsupportedMusicSourceError(playlist, "adding songs")
+	}
+	playlistId := playlist.GetPlaylistId()
 
 	var resourceIdentifiers []TidalResourceIdentifier
 	for _, song := range songs {
@@ -579,6 +741,9 @@ func (c *tidalClientImpl) AddToPlaylist(ctx context.Context, userInfo *myncer_pb
 			end = len(resourceIdentifiers)
 		}
 		batch := resourceIdentifiers[i:end]
+		if err := core.CheckSyncRunCancelled(ctx); err != nil {
+			return core.WrappedError(err, "stopped adding tracks to Tidal playlist %s after %d tracks", playlistId, i)
+		}
 
 		payload := map[string][]TidalResourceIdentifier{"data": batch}
 		payloadBytes, err := json.Marshal(payload)
@@ -597,7 +762,7 @@ func (c *tidalClientImpl) AddToPlaylist(ctx context.Context, userInfo *myncer_pb
 		core.Printf("Tidal: Adding %d tracks to playlist %s", len(batch), playlistId)
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
-			return core.WrappedError(err, "failed to add tracks to Tidal playlist %s", playlistId)
+			return core.WrappedError(classifyHttpError(err), "failed to add tracks to Tidal playlist %s", playlistId)
 		}
 		defer resp.Body.Close()
 
@@ -609,56 +774,173 @@ func (c *tidalClientImpl) AddToPlaylist(ctx context.Context, userInfo *myncer_pb
 		core.Printf("Tidal: Response from POST %s -> Status: %s", url, resp.Status)
 
 		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-			core.Errorf("Tidal API Error adding tracks. Status: %s, Body: %s", resp.Status, string(body))
-			return core.NewError("Tidal API returned status %d when adding tracks. Body: %s", resp.StatusCode, string(body))
+			core.Errorf(core.NewError("Tidal API Error adding tracks. Status: %s, Body: %s", resp.Status, string(body)))
+			return classifyHttpStatusError(resp.StatusCode, core.NewError("Tidal API returned status %d when adding tracks. Body: %s", resp.StatusCode, string(body)))
 		}
 	}
 
 	return nil
 }
 
-func (c *tidalClientImpl) ClearPlaylist(ctx context.Context, userInfo *myncer_pb.User, playlistId string) error {
+func (c *tidalClientImpl) ClearPlaylist(ctx context.Context, userInfo *myncer_pb.User, playlist *myncer_pb.MusicSource) error {
+	if err := c.ensureUserInfo(ctx, userInfo); err != nil {
+		return core.WrappedError(err, "failed to ensure Tidal user info")
+	}
+	switch playlist.GetKind() {
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_LIKED_TRACKS:
+		favoriteSongs, err := c.GetPlaylistSongs(ctx, userInfo, playlist)
+		if err != nil {
+			return err
+		}
+		return c.updateFavoriteTracks(ctx, "DELETE", favoriteSongs)
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_SAVED_ALBUMS:
+		return core.NewUnsupportedMusicSourceError(playlist, "clearing")
+	}
+	playlistId := playlist.GetPlaylistId()
+
+	itemsToRemove, err := c.getPlaylistItemIdentifiers(ctx, playlistId)
+	if err != nil {
+		return err
+	}
+	if len(itemsToRemove) == 0 {
+		core.Printf("Tidal: Playlist %s is already empty. Nothing to clear.", playlistId)
+		return nil // Nothing to clear
+	}
+	return c.deletePlaylistItems(ctx, playlistId, itemsToRemove)
+}
+
+func (c *tidalClientImpl) RemoveFromPlaylist(ctx context.Context, userInfo *myncer_pb.User, playlist *myncer_pb.MusicSource, songs []core.Song) error {
 	if err := c.ensureUserInfo(ctx, userInfo); err != nil {
 		return core.WrappedError(err, "failed to ensure Tidal user info")
 	}
+	switch playlist.GetKind() {
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_LIKED_TRACKS:
+		return c.updateFavoriteTracks(ctx, "DELETE", songs)
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_SAVED_ALBUMS:
+		return core.NewUnsupportedMusicSourceError(playlist, "removing songs")
+	}
+	playlistId := playlist.GetPlaylistId()
 
-	// Fetch all item identifiers with their unique itemId for deletion.
+	trackIds := core.NewSet[string]()
+	for _, song := range songs {
+		trackIds.Add(song.GetId())
+	}
+	items, err := c.getPlaylistItemIdentifiers(ctx, playlistId)
+	if err != nil {
+		return err
+	}
+	// Items are deleted by their itemId, which is unique per occurrence of a track in the playlist.
 	var itemsToRemove []PlaylistItemIdentifier
+	for _, item := range items {
+		if trackIds.Contains(item.ID) {
+			itemsToRemove = append(itemsToRemove, item)
+		}
+	}
+	if len(itemsToRemove) == 0 {
+		return nil
+	}
+	return c.deletePlaylistItems(ctx, playlistId, itemsToRemove)
+}
+
+func (c *tidalClientImpl) ReorderPlaylist(ctx context.Context, userInfo *myncer_pb.User, playlist *myncer_pb.MusicSource, songs []core.Song) error {
+	if !core.IsMusicSourceReorderable(playlist) {
+		return core.NewUnsupportedMusicSourceError(playlist, "reordering")
+	}
+	if err := c.ensureUserInfo(ctx, userInfo); err != nil {
+		return core.WrappedError(err, "failed to ensure Tidal user info")
+	}
+	playlistId := playlist.GetPlaylistId()
+
+	items, err := c.getPlaylistItemIdentifiers(ctx, playlistId)
+	if err != nil {
+		return err
+	}
+	currentIds := []string{}
+	for _, item := range items {
+		currentIds = append(currentIds, item.ID)
+	}
+	moves, err := getPlaylistMoves(currentIds, getSongIds(songs))
+	if err != nil {
+		return core.WrappedError(err, "failed to reorder Tidal playlist %s", playlistId)
+	}
+
+	for i, move := range moves {
+		if err := core.CheckSyncRunCancelled(ctx); err != nil {
+			return core.WrappedError(err, "stopped reordering Tidal playlist %s after %d moves", playlistId, i)
+		}
+		// Items are moved in front of the item currently at the target position.
+		payload := map[string]any{
+			"data": []PlaylistItemIdentifier{items[move.from]},
+			"meta": map[string]string{"positionBefore": items[move.to].Meta.ItemID},
+		}
+		payloadBytes, err := json.Marshal(payload)
+		if err != nil {
+			return core.WrappedError(err, "failed to marshal move payload")
+		}
+
+		moveURL := fmt.Sprintf("%s/playlists/%s/relationships/items", cTidalAPIBaseURL, playlistId)
+		req, err := http.NewRequestWithContext(ctx, "PATCH", moveURL, bytes.NewBuffer(payloadBytes))
+		if err != nil {
+			return core.WrappedError(err, "failed to create move request")
+		}
+		req.Header.Set("Content-Type", "application/vnd.api+json")
+		req.Header.Set("Accept", cTidalAcceptHeader)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return core.WrappedError(classifyHttpError(err), "failed to move item in Tidal playlist %s", playlistId)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			core.Errorf(core.NewError("Tidal API Error when moving playlist item. Status: %s, Body: %s", resp.Status, string(body)))
+			return classifyHttpStatusError(resp.StatusCode, core.NewError("Tidal API returned status %d when moving playlist item. Body: %s", resp.StatusCode, string(body)))
+		}
+		moveItem(items, move.from, move.to)
+	}
+	core.Printf("Tidal: Reordered playlist %s with %d moves", playlistId, len(moves))
+	return nil
+}
+
+// Fetches all item identifiers of the playlist with their unique itemId for deletion.
+func (c *tidalClientImpl) getPlaylistItemIdentifiers(ctx context.Context, playlistId string) ([]PlaylistItemIdentifier, error) {
+	var items []PlaylistItemIdentifier
 	nextURL := fmt.Sprintf("%s/playlists/%s/relationships/items", cTidalAPIBaseURL, playlistId)
 
 	for nextURL != "" {
 		req, err := http.NewRequestWithContext(ctx, "GET", nextURL, nil)
 		if err != nil {
-			return core.WrappedError(err, "failed to create request to get playlist items for deletion")
+			return nil, core.WrappedError(err, "failed to create request to get playlist items for deletion")
 		}
 		req.Header.Set("Accept", cTidalAcceptHeader)
 
-		core.Printf("Tidal: Fetching items to clear from playlist %s", playlistId)
+		core.Printf("Tidal: Fetching items to remove from playlist %s", playlistId)
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
-			return core.WrappedError(err, "failed to get playlist items for deletion")
+			return nil, core.WrappedError(classifyHttpError(err), "failed to get playlist items for deletion")
 		}
 
 		body, err := io.ReadAll(resp.Body)
 		if err != nil {
 			resp.Body.Close()
-			return core.WrappedError(err, "failed to read body of playlist items for deletion")
+			return nil, core.WrappedError(err, "failed to read body of playlist items for deletion")
 		}
 		resp.Body.Close()
 
 		core.Printf("Tidal: Response from %s -> Status: %s", nextURL, resp.Status)
 
 		if resp.StatusCode != http.StatusOK {
-			core.Errorf("Tidal API Error getting items to clear. Status: %s, Body: %s", resp.Status, string(body))
-			return core.NewError("Tidal API returned status %d getting items to clear. Body: %s", resp.StatusCode, string(body))
+			core.Errorf(core.NewError("Tidal API Error getting items to remove. Status: %s, Body: %s", resp.Status, string(body)))
+			return nil, classifyHttpStatusError(resp.StatusCode, core.NewError("Tidal API returned status %d getting items to remove. Body: %s", resp.StatusCode, string(body)))
 		}
 
 		var itemsResp PlaylistItemsV2Response
 		if err := json.Unmarshal(body, &itemsResp); err != nil {
-			return core.WrappedError(err, "failed to decode playlist items for deletion")
+			return nil, core.WrappedError(err, "failed to decode playlist items for deletion")
 		}
 
-		itemsToRemove = append(itemsToRemove, itemsResp.Data...)
+		items = append(items, itemsResp.Data...)
 
 		if itemsResp.Links.Next != "" {
 			nextURL = fmt.Sprintf("%s%s", "https://openapi.tidal.com", itemsResp.Links.Next)
@@ -666,12 +948,10 @@ func (c *tidalClientImpl) ClearPlaylist(ctx context.Context, userInfo *myncer_pb
 			nextURL = ""
 		}
 	}
+	return items, nil
+}
 
-	if len(itemsToRemove) == 0 {
-		core.Printf("Tidal: Playlist %s is already empty. Nothing to clear.", playlistId)
-		return nil // Nothing to clear
-	}
-
+func (c *tidalClientImpl) deletePlaylistItems(ctx context.Context, playlistId string, itemsToRemove []PlaylistItemIdentifier) error {
 	// Delete items in batches of 20
 	for i := 0; i < len(itemsToRemove); i += 20 {
 		end := i + 20
@@ -679,6 +959,9 @@ func (c *tidalClientImpl) ClearPlaylist(ctx context.Context, userInfo *myncer_pb
 			end = len(itemsToRemove)
 		}
 		batch := itemsToRemove[i:end]
+		if err := core.CheckSyncRunCancelled(ctx); err != nil {
+			return core.WrappedError(err, "stopped removing tracks from Tidal playlist %s after %d tracks", playlistId, i)
+		}
 
 		payload := map[string][]PlaylistItemIdentifier{"data": batch}
 		payloadBytes, err := json.Marshal(payload)
@@ -694,10 +977,10 @@ func (c *tidalClientImpl) ClearPlaylist(ctx context.Context, userInfo *myncer_pb
 		req.Header.Set("Content-Type", "application/vnd.api+json")
 		req.Header.Set("Accept", cTidalAcceptHeader)
 
-		core.Printf("Tidal: Clearing %d tracks from playlist %s", len(batch), playlistId)
+		core.Printf("Tidal: Removing %d tracks from playlist %s", len(batch), playlistId)
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
-			return core.WrappedError(err, "failed to clear batch from playlist")
+			return core.WrappedError(classifyHttpError(err), "failed to remove batch from playlist")
 		}
 		defer resp.Body.Close()
 
@@ -705,14 +988,63 @@ func (c *tidalClientImpl) ClearPlaylist(ctx context.Context, userInfo *myncer_pb
 
 		if resp.StatusCode != http.StatusNoContent {
 			body, _ := io.ReadAll(resp.Body)
-			core.Errorf("Tidal API Error when clearing playlist. Status: %s, Body: %s", resp.Status, string(body))
-			return core.NewError("Tidal API returned status %d when clearing playlist. Body: %s", resp.StatusCode, string(body))
+			core.Errorf(core.NewError("Tidal API Error when removing tracks from playlist. Status: %s, Body: %s", resp.Status, string(body)))
+			return classifyHttpStatusError(resp.StatusCode, core.NewError("Tidal API returned status %d when removing tracks from playlist. Body: %s", resp.StatusCode, string(body)))
 		}
 	}
 
 	return nil
 }
 
+// Adds the tracks to the user's favorite tracks with POST, or removes them with DELETE.
+func (c *tidalClientImpl) updateFavoriteTracks(ctx context.Context, method string, songs []core.Song) error {
+	var resourceIdentifiers []TidalResourceIdentifier
+	for _, song := range songs {
+		resourceIdentifiers = append(resourceIdentifiers, TidalResourceIdentifier{ID: song.GetId(), Type: "tracks"})
+	}
+
+	// Like playlist items, favorites are updated in batches of max 20.
+	for i := 0; i < len(resourceIdentifiers); i += 20 {
+		end := min(i+20, len(resourceIdentifiers))
+		if err := core.CheckSyncRunCancelled(ctx); err != nil {
+			return core.WrappedError(err, "stopped updating Tidal favorite tracks after %d tracks", i)
+		}
+
+		payloadBytes, err := json.Marshal(map[string][]TidalResourceIdentifier{"data": resourceIdentifiers[i:end]})
+		if err != nil {
+			return core.WrappedError(err, "failed to marshal favorite tracks payload")
+		}
+		url := fmt.Sprintf("%s/userCollections/%s/relationships/tracks?countryCode=%s", cTidalAPIBaseURL, c.tidalUserID, c.tidalCountryCode)
+		req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(payloadBytes))
+		if err != nil {
+			return core.WrappedError(err, "failed to create favorite tracks request")
+		}
+		req.Header.Set("Content-Type", "application/vnd.api+json")
+		req.Header.Set("Accept", cTidalAcceptHeader)
+
+		core.Printf("Tidal: %s %d favorite tracks", method, end-i)
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return core.WrappedError(classifyHttpError(err), "failed to update Tidal favorite tracks")
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+			// Tokens granted before favorites could be written lack the collection.write scope.
+			return core.NewError(
+				"Tidal denied access to favorite tracks with status %d; reconnect Tidal to allow Myncer to update them",
+				resp.StatusCode,
+			)
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			core.Errorf(core.NewError("Tidal API Error updating favorite tracks. Status: %s, Body: %s", resp.Status, string(body)))
+			return classifyHttpStatusError(resp.StatusCode, core.NewError("Tidal API returned status %d when updating favorite tracks. Body: %s", resp.StatusCode, string(body)))
+		}
+	}
+	return nil
+}
+
 // buildTidalQueries constructs a list of search queries from most to least specific.
 // Optimized version to avoid duplicates.
 func buildTidalQueries(songToSearch core.Song) []string {
@@ -734,7 +1066,7 @@ func buildTidalQueries(songToSearch core.Song) []string {
 	cleanTrack := matching.Clean(rawTrack)
 	cleanArtists := matching.Clean(rawArtists)
 	cleanAlbum := matching.Clean(rawAlbum)
-	
+
 	// Build queries from most specific to most general
 	addQuery(fmt.Sprintf("%s %s %s", rawArtists, rawTrack, rawAlbum))
 	addQuery(fmt.Sprintf("%s %s %s", cleanArtists, cleanTrack, cleanAlbum))
@@ -747,7 +1079,12 @@ func buildTidalQueries(songToSearch core.Song) []string {
 	return queries
 }
 
-func (c *tidalClientImpl) Search(ctx context.Context, userInfo *myncer_pb.User, songToSearch core.Song) (core.Song, error) {
+func (c *tidalClientImpl) Search(
+	ctx context.Context,
+	userInfo *myncer_pb.User,
+	songToSearch core.Song,
+	profile *myncer_pb.MatchingProfile, /*const,@nullable*/
+) ([]*core.SearchCandidate, error) {
 	if err := c.ensureUserInfo(ctx, userInfo); err != nil {
 		return nil, core.WrappedError(err, "failed to ensure Tidal user info")
 	}
@@ -756,23 +1093,21 @@ func (c *tidalClientImpl) Search(ctx context.Context, userInfo *myncer_pb.User,
 	if isrc := songToSearch.GetSpec().GetIsrc(); isrc != "" {
 		core.Printf("Tidal: Searching song '%s' for track by ISRC %s", songToSearch.GetName(), isrc)
 		isrcURL := fmt.Sprintf("%s/tracks?filter[isrc]=%s&countryCode=%s&include=albums,artists", cTidalAPIBaseURL, isrc, c.tidalCountryCode)
-		req, _ := http.NewRequestWithContext(ctx, "GET", isrcURL, nil)
-		req.Header.Set("Accept", cTidalAcceptHeader)
-
-		resp, err := c.httpClient.Do(req)
-		if err == nil && resp.StatusCode == http.StatusOK {
-			body, readErr := io.ReadAll(resp.Body)
-			resp.Body.Close()
-			if readErr == nil {
-				var tracksResp TracksV2Response
-				if json.Unmarshal(body, &tracksResp) == nil && len(tracksResp.Data) > 0 {
-					core.Printf("Tidal: Found track by ISRC %s", isrc)
-					return buildSongFromTidalV2Track(tracksResp.Data[0]), nil
-				}
-			}
+		body, err := c.getSearchResponse(ctx, isrcURL)
+		if core.IsRetryableError(err) {
+			return nil, core.WrappedError(err, "Tidal search failed for ISRC %s", isrc)
 		}
-		if resp != nil {
-			resp.Body.Close()
+		if err != nil {
+			core.Warningf("Tidal search failed for ISRC %s, searching by metadata. Error: %v", isrc, err)
+		} else {
+			var tracksResp TracksV2Response
+			if json.Unmarshal(body, &tracksResp) == nil && len(tracksResp.Data) > 0 {
+				core.Printf("Tidal: Found track by ISRC %s", isrc)
+				// Songs with the same ISRC are the same recording.
+				return []*core.SearchCandidate{
+					{Song: buildSongFromTidalV2Track(tracksResp.Data[0]), Score: 100.0},
+				}, nil
+			}
 		}
 	} else {
 		core.Printf("Tidal: No ISRC found for song '%s'. Proceeding with metadata search.", songToSearch.GetName())
@@ -780,7 +1115,7 @@ func (c *tidalClientImpl) Search(ctx context.Context, userInfo *myncer_pb.User,
 
 	// 2. Fallback to metadata search
 	queries := buildTidalQueries(songToSearch)
-	var bestMatch core.Song
+	candidates := []*core.SearchCandidate{}
 	highestScore := 0.0
 
 	for _, query := range queries {
@@ -790,31 +1125,14 @@ func (c *tidalClientImpl) Search(ctx context.Context, userInfo *myncer_pb.User,
 		searchURL := fmt.Sprintf("%s/searchResults/%s/relationships/tracks?countryCode=%s&include=tracks&limit=10",
 			cTidalAPIBaseURL, url.QueryEscape(query), c.tidalCountryCode)
 
-		req, err := http.NewRequestWithContext(ctx, "GET", searchURL, nil)
-		if err != nil {
-			core.Warningf("Failed to create Tidal search request for query %q: %v", query, err)
-			continue
-		}
-		req.Header.Set("Accept", cTidalAcceptHeader)
-
 		core.Printf("Tidal: Searching for track with query: %s", query)
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			core.Warningf("Tidal search failed for query %q, trying next. Error: %v", query, err)
-			continue
+		body, err := c.getSearchResponse(ctx, searchURL)
+		if core.IsRetryableError(err) {
+			// Rate limits and outages would fail every other query too.
+			return nil, core.WrappedError(err, "Tidal search failed for query %q", query)
 		}
-
-		if resp.StatusCode != http.StatusOK {
-			body, _ := io.ReadAll(resp.Body)
-			core.Warningf("Tidal search returned status %d for query %q. Body: %s", resp.StatusCode, query, string(body))
-			resp.Body.Close()
-			continue
-		}
-
-		body, err := io.ReadAll(resp.Body)
-		resp.Body.Close()
 		if err != nil {
-			core.Warningf("Failed to read response body for Tidal search query %q: %v", query, err)
+			core.Warningf("Tidal search failed for query %q, trying next. Error: %v", query, err)
 			continue
 		}
 
@@ -827,7 +1145,7 @@ func (c *tidalClientImpl) Search(ctx context.Context, userInfo *myncer_pb.User,
 		for _, trackResource := range searchResp.Included {
 			if trackResource.Type == "tracks" {
 				foundSong := buildSongFromTidalV2Track(trackResource)
-				score := matching.CalculateSimilarity(songToSearch, foundSong)
+				score := matching.CalculateSimilarity(songToSearch, foundSong, profile)
 
 				// New diagnostic log
 				core.Printf(
@@ -835,29 +1153,47 @@ func (c *tidalClientImpl) Search(ctx context.Context, userInfo *myncer_pb.User,
 					query, foundSong.GetName(), strings.Join(foundSong.GetArtistNames(), ", "), score,
 				)
 
-				if score > highestScore {
-					highestScore = score
-					bestMatch = foundSong
-				}
-				if highestScore > 95.0 {
-					return bestMatch, nil
+				candidates = append(candidates, &core.SearchCandidate{Song: foundSong, Score: score})
+				highestScore = math.Max(highestScore, score)
+				if matching.IsExactMatch(highestScore, profile, myncer_pb.Datasource_DATASOURCE_TIDAL) {
+					return matching.RankCandidates(candidates), nil
 				}
 			}
 		}
 
-		if highestScore > 85.0 {
+		if matching.IsConfidentMatch(highestScore, profile, myncer_pb.Datasource_DATASOURCE_TIDAL) {
 			break
 		}
 	}
 
-	// --- KEY CORRECTION ---
-	// Apply minimum acceptance threshold.
-	if highestScore < cMinimumSimilarityThreshold {
-		return nil, core.NewError("no suitable track found after trying all queries for: %s (best score: %.2f)", songToSearch.GetName(), highestScore)
+	core.Printf("Tidal Search: Best score for '%s' is %.2f", songToSearch.GetName(), highestScore)
+	return matching.RankCandidates(candidates), nil
+}
+
+// Returns the body of a successful response to the search request.
+// Errors are classified so that searches can tell failures worth retrying from queries that
+// won't work.
+func (c *tidalClientImpl) getSearchResponse(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to create Tidal search request")
 	}
+	req.Header.Set("Accept", cTidalAcceptHeader)
 
-	core.Printf("Tidal Search: Final best match for '%s' is '%s' with score %.2f", songToSearch.GetName(), bestMatch.GetName(), highestScore)
-	return bestMatch, nil
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, core.WrappedError(classifyHttpError(err), "failed to search Tidal")
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to read Tidal search response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, classifyHttpStatusError(resp.StatusCode, core.NewError("Tidal API returned status %d for search. Body: %s", resp.StatusCode, string(body)))
+	}
+	return body, nil
 }
 
 // buildSongFromTidalV2Track converts a v2 track resource to core.Song
@@ -877,5 +1213,7 @@ func buildSongFromTidalV2Track(trackResource TidalV2TrackResource) core.Song {
 		Datasource:       myncer_pb.Datasource_DATASOURCE_TIDAL,
 		DatasourceSongId: trackID,
 		Isrc:             trackResource.Attributes.ISRC,
+		Explicit:         trackResource.Attributes.Explicit,
+		DurationMs:       parseIsoDurationMs(trackResource.Attributes.Duration),
 	})
 }
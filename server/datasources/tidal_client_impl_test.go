@@ -0,0 +1,83 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/hansbala/myncer/sync_engine"
+	"github.com/stretchr/testify/assert"
+)
+
+// Answers every request with the status, or fails it if the status is 0.
+type fakeRoundTripper struct {
+	statusCode  int
+	numRequests int
+}
+
+func (f *fakeRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.numRequests++
+	if f.statusCode == 0 {
+		return nil, errors.New("connection reset by peer")
+	}
+	return &http.Response{
+		StatusCode: f.statusCode,
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Request:    req,
+	}, nil
+}
+
+func TestTidalSearchErrors(t *testing.T) {
+	testCases := []struct {
+		name              string
+		statusCode        int
+		expectedRetryable bool
+	}{
+		{
+			name:              "rate limits fail the search",
+			statusCode:        http.StatusTooManyRequests,
+			expectedRetryable: true,
+		},
+		{
+			name:              "server errors fail the search",
+			statusCode:        http.StatusServiceUnavailable,
+			expectedRetryable: true,
+		},
+		{
+			name:              "transport errors fail the search",
+			expectedRetryable: true,
+		},
+		{
+			name:       "bad requests try the next query",
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := &fakeRoundTripper{statusCode: tc.statusCode}
+			client := &tidalClientImpl{httpClient: &http.Client{Transport: transport}, tidalCountryCode: "US"}
+			song := sync_engine.NewSong(&myncer_pb.Song{
+				Name:       "Song",
+				ArtistName: []string{"Artist"},
+				AlbumName:  "Album",
+				Isrc:       "USRC17607839",
+			})
+
+			candidates, err := client.Search(context.Background(), &myncer_pb.User{}, song, nil /*profile*/)
+			if tc.expectedRetryable {
+				assert.True(t, core.IsRetryableError(err))
+				// The ISRC search fails before any query is tried.
+				assert.Equal(t, 1, transport.numRequests)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Empty(t, candidates)
+			assert.Equal(t, 1+len(buildTidalQueries(song)), transport.numRequests)
+		})
+	}
+}
@@ -1,11 +1,50 @@
 package datasources
 
 import (
+	"cmp"
+	"errors"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
 	"github.com/zmb3/spotify/v2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/hansbala/myncer/core"
 	myncer_pb "github.com/hansbala/myncer/proto/myncer"
 )
 
+// YouTube reports exhausted quotas as 403s with one of these reasons.
+var cYoutubeRetryableReasons = []string{"quotaExceeded", "rateLimitExceeded", "userRateLimitExceeded"}
+
+// Returns nil if the RFC 3339 timestamp is empty or malformed.
+func parseTimestamp(value string) *timestamppb.Timestamp /*@nullable*/ {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+var cIsoDurationRegexp = regexp.MustCompile(`^PT(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?$`)
+
+// Returns the ISO 8601 duration (e.g. PT3M20S) in milliseconds, as reported by YouTube and Tidal.
+// Returns 0 if the duration is empty or malformed.
+func parseIsoDurationMs(value string) int64 {
+	matches := cIsoDurationRegexp.FindStringSubmatch(value)
+	if matches == nil {
+		return 0
+	}
+	hours, _ := strconv.ParseFloat(cmp.Or(matches[1], "0"), 64)
+	minutes, _ := strconv.ParseFloat(cmp.Or(matches[2], "0"), 64)
+	seconds, _ := strconv.ParseFloat(cmp.Or(matches[3], "0"), 64)
+	return int64(((hours*60+minutes)*60 + seconds) * 1000)
+}
+
 func createMusicSource(
 	datasource myncer_pb.Datasource,
 	playlistId string,
@@ -36,3 +75,110 @@ func spotifyPlaylistToProto(p *spotify.FullPlaylist /*const*/) *myncer_pb.Playli
 	}
 }
 
+// classifyHttpError marks transport failures, where the datasource never responded, as retryable.
+func classifyHttpError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return core.NewRetryableError(err)
+	}
+	return err
+}
+
+// classifyHttpStatusError marks errors for retryable HTTP status codes as retryable.
+func classifyHttpStatusError(statusCode int, err error) error {
+	if core.IsRetryableHttpStatus(statusCode) {
+		return core.NewRetryableError(err)
+	}
+	return err
+}
+
+// classifySpotifyError marks rate limits, server errors and transport failures from the Spotify API
+// as retryable.
+func classifySpotifyError(err error) error {
+	var spotifyErr spotify.Error
+	if errors.As(err, &spotifyErr) {
+		return classifyHttpStatusError(spotifyErr.Status, err)
+	}
+	return classifyHttpError(err)
+}
+
+// classifyYoutubeError marks rate limits, exhausted quotas, server errors and transport failures from
+// the YouTube API as retryable.
+func classifyYoutubeError(err error) error {
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) {
+		return classifyHttpError(err)
+	}
+	if apiErr.Code == http.StatusForbidden {
+		for _, item := range apiErr.Errors {
+			if slices.Contains(cYoutubeRetryableReasons, item.Reason) {
+				return core.NewRetryableError(err)
+			}
+		}
+	}
+	return classifyHttpStatusError(apiErr.Code, err)
+}
+
+// Moves the item at index `from` of a playlist to index `to`.
+type playlistMove struct {
+	from int
+	to   int
+}
+
+// getPlaylistMoves returns the moves that turn a playlist with songs `currentIds` into one with songs
+// `desiredIds`, to be applied in order.
+// Items with an empty id, such as deleted videos, aren't songs of the playlist. They are never
+// moved, though moves may shift them. `currentIds` must still hold them for the moves to use the
+// playlist's positions.
+// Every move takes a song to an earlier index. No moves are returned if the songs are already in
+// order.
+func getPlaylistMoves(currentIds []string /*const*/, desiredIds []string /*const*/) ([]playlistMove, error) {
+	desiredIds = slices.DeleteFunc(slices.Clone(desiredIds), func(id string) bool { return id == "" })
+	numSongs := 0
+	for _, id := range currentIds {
+		if id != "" {
+			numSongs++
+		}
+	}
+	if numSongs != len(desiredIds) {
+		return nil, core.NewError(
+			"playlist has %d songs but the new order has %d, the playlist may have changed",
+			numSongs,
+			len(desiredIds),
+		)
+	}
+	ids := slices.Clone(currentIds)
+	moves := []playlistMove{}
+	i := 0
+	for _, id := range desiredIds {
+		for ids[i] == "" {
+			i++
+		}
+		j := slices.Index(ids[i:], id)
+		if j < 0 {
+			return nil, core.NewError("song %s is not in the playlist, the playlist may have changed", id)
+		}
+		j += i
+		if j != i {
+			moves = append(moves, playlistMove{from: j, to: i})
+			moveItem(ids, j, i)
+		}
+		i++
+	}
+	return moves, nil
+}
+
+// Moves the item at index `from` to the earlier index `to`, shifting the items in between.
+func moveItem[T any](items []T, from int, to int) {
+	item := items[from]
+	copy(items[to+1:from+1], items[to:from])
+	items[to] = item
+}
+
+func getSongIds(songs []core.Song /*const*/) []string {
+	r := []string{}
+	for _, song := range songs {
+		r = append(r, song.GetId())
+	}
+	return r
+}
@@ -0,0 +1,127 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoveItem(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     int
+		to       int
+		expected []string
+	}{
+		{
+			name:     "to the front",
+			from:     3,
+			to:       0,
+			expected: []string{"d", "a", "b", "c"},
+		},
+		{
+			name:     "to the previous index",
+			from:     2,
+			to:       1,
+			expected: []string{"a", "c", "b", "d"},
+		},
+		{
+			name:     "in place",
+			from:     1,
+			to:       1,
+			expected: []string{"a", "b", "c", "d"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			items := []string{"a", "b", "c", "d"}
+			moveItem(items, tc.from, tc.to)
+			assert.Equal(t, tc.expected, items)
+		})
+	}
+}
+
+func TestGetPlaylistMoves(t *testing.T) {
+	testCases := []struct {
+		name          string
+		currentIds    []string
+		desiredIds    []string
+		expectedMoves []playlistMove
+		expectedIds   []string
+		expectedError string
+	}{
+		{
+			name:          "already in order",
+			currentIds:    []string{"a", "b", "c"},
+			desiredIds:    []string{"a", "b", "c"},
+			expectedMoves: []playlistMove{},
+			expectedIds:   []string{"a", "b", "c"},
+		},
+		{
+			name:          "reversed",
+			currentIds:    []string{"a", "b", "c"},
+			desiredIds:    []string{"c", "b", "a"},
+			expectedMoves: []playlistMove{{from: 2, to: 0}, {from: 2, to: 1}},
+			expectedIds:   []string{"c", "b", "a"},
+		},
+		{
+			name:          "duplicate songs",
+			currentIds:    []string{"a", "b", "a"},
+			desiredIds:    []string{"a", "a", "b"},
+			expectedMoves: []playlistMove{{from: 2, to: 1}},
+			expectedIds:   []string{"a", "a", "b"},
+		},
+		{
+			name:          "items that aren't songs are left in place",
+			currentIds:    []string{"", "a", "", "b"},
+			desiredIds:    []string{"a", "b"},
+			expectedMoves: []playlistMove{},
+			expectedIds:   []string{"", "a", "", "b"},
+		},
+		{
+			name:          "items that aren't songs are never moved",
+			currentIds:    []string{"a", "", "b", "c"},
+			desiredIds:    []string{"c", "a", "b"},
+			expectedMoves: []playlistMove{{from: 3, to: 0}},
+			expectedIds:   []string{"c", "a", "", "b"},
+		},
+		{
+			name:          "songs without ids in the new order are ignored",
+			currentIds:    []string{"a", "", "b"},
+			desiredIds:    []string{"b", "", "a"},
+			expectedMoves: []playlistMove{{from: 2, to: 0}},
+			expectedIds:   []string{"b", "a", ""},
+		},
+		{
+			name:          "different number of songs",
+			currentIds:    []string{"a", "b"},
+			desiredIds:    []string{"a", "b", "c"},
+			expectedError: "playlist has 2 songs but the new order has 3",
+		},
+		{
+			name:          "song missing from the playlist",
+			currentIds:    []string{"a", "b"},
+			desiredIds:    []string{"a", "c"},
+			expectedError: "song c is not in the playlist",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			moves, err := getPlaylistMoves(tc.currentIds, tc.desiredIds)
+			if tc.expectedError != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tc.expectedError)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedMoves, moves)
+
+			ids := append([]string{}, tc.currentIds...)
+			for _, move := range moves {
+				moveItem(ids, move.from, move.to)
+			}
+			assert.Equal(t, tc.expectedIds, ids)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package datasources
 import (
 	"context"
 	"fmt"
+	"math"
 	"regexp"
 	"strings"
 
@@ -102,15 +103,58 @@ func (c *youtubeClientImpl) GetPlaylist(
 	}, nil
 }
 
+func (c *youtubeClientImpl) CreatePlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	name string,
+	description string,
+	public bool,
+) (*myncer_pb.Playlist, error) {
+	svc, err := c.getService(ctx, userInfo)
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to get YouTube service")
+	}
+	privacyStatus := "private"
+	if public {
+		privacyStatus = "public"
+	}
+	p, err := svc.Playlists.Insert(
+		[]string{"snippet", "status"},
+		&youtube.Playlist{
+			Snippet: &youtube.PlaylistSnippet{
+				Title:       name,
+				Description: description,
+			},
+			Status: &youtube.PlaylistStatus{PrivacyStatus: privacyStatus},
+		},
+	).Do()
+	if err != nil {
+		return nil, core.WrappedError(classifyYoutubeError(err), "failed to create playlist %s", name)
+	}
+	return &myncer_pb.Playlist{
+		MusicSource: createMusicSource(myncer_pb.Datasource_DATASOURCE_YOUTUBE, p.Id),
+		Name:        p.Snippet.Title,
+		Description: p.Snippet.Description,
+		ImageUrl:    getBestThumbnailUrl(p.Snippet.Thumbnails),
+	}, nil
+}
+
 func (c *youtubeClientImpl) GetPlaylistSongs(
 	ctx context.Context,
 	userInfo *myncer_pb.User, /*const*/
-	playlistId string,
+	playlist *myncer_pb.MusicSource, /*const*/
 ) ([]core.Song, error) {
 	svc, err := c.getService(ctx, userInfo)
 	if err != nil {
 		return nil, core.WrappedError(err, "failed to get YouTube service")
 	}
+	switch playlist.GetKind() {
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_LIKED_TRACKS:
+		return c.getLikedVideos(svc)
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_SAVED_ALBUMS:
+		return nil, core.NewUnsupportedMusicSourceError(playlist, "reading")
+	}
+	playlistId := playlist.GetPlaylistId()
 
 	songs := []core.Song{}
 	nextPageToken := ""
@@ -122,15 +166,26 @@ func (c *youtubeClientImpl) GetPlaylistSongs(
 			PageToken(nextPageToken)
 		resp, err := call.Do()
 		if err != nil {
-			return nil, core.WrappedError(err, "failed to fetch playlist items")
+			return nil, core.WrappedError(classifyYoutubeError(err), "failed to fetch playlist items")
 		}
 
+		items := []*youtube.PlaylistItem{}
+		videoIds := []string{}
 		for _, item := range resp.Items {
 			videoId := item.Snippet.ResourceId.VideoId
 			if len(videoId) == 0 {
 				continue
 			}
-			songs = append(songs, buildSongFromYouTubePlaylistItem(item))
+			items = append(items, item)
+			videoIds = append(videoIds, videoId)
+		}
+		// Playlist items don't say how long their videos are.
+		durationsMs := c.getVideoDurationsMs(svc, videoIds)
+		for _, item := range items {
+			songs = append(
+				songs,
+				buildSongFromYouTubePlaylistItem(item, durationsMs[item.Snippet.ResourceId.VideoId]),
+			)
 		}
 		if resp.NextPageToken == "" {
 			break
@@ -144,15 +199,25 @@ func (c *youtubeClientImpl) GetPlaylistSongs(
 func (c *youtubeClientImpl) AddToPlaylist(
 	ctx context.Context,
 	userInfo *myncer_pb.User, /*const*/
-	playlistId string,
+	playlist *myncer_pb.MusicSource, /*const*/
 	songs []core.Song,
 ) error {
 	svc, err := c.getService(ctx, userInfo)
 	if err != nil {
 		return core.WrappedError(err, "failed to get YouTube service")
 	}
+	switch playlist.GetKind() {
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_LIKED_TRACKS:
+		return c.rateVideos(ctx, svc, songs, "like")
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_SAVED_ALBUMS:
+		return core.NewUnsupportedMusicSourceError(playlist, "adding songs")
+	}
+	playlistId := playlist.GetPlaylistId()
 
-	for _, song := range songs {
+	for i, song := range songs {
+		if err := core.CheckSyncRunCancelled(ctx); err != nil {
+			return core.WrappedError(err, "stopped adding videos to playlist %s after %d videos", playlistId, i)
+		}
 		if _, err := svc.PlaylistItems.Insert(
 			[]string{"snippet"},
 			&youtube.PlaylistItem{
@@ -166,7 +231,7 @@ func (c *youtubeClientImpl) AddToPlaylist(
 			},
 		).
 			Do(); err != nil {
-			return core.WrappedError(err, "failed to insert video %s", song.GetName())
+			return core.WrappedError(classifyYoutubeError(err), "failed to insert video %s", song.GetName())
 		}
 	}
 	return nil
@@ -175,12 +240,23 @@ func (c *youtubeClientImpl) AddToPlaylist(
 func (c *youtubeClientImpl) ClearPlaylist(
 	ctx context.Context,
 	userInfo *myncer_pb.User, /*const*/
-	playlistId string,
+	playlist *myncer_pb.MusicSource, /*const*/
 ) error {
 	svc, err := c.getService(ctx, userInfo)
 	if err != nil {
 		return core.WrappedError(err, "failed to get YouTube service")
 	}
+	switch playlist.GetKind() {
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_LIKED_TRACKS:
+		likedVideos, err := c.getLikedVideos(svc)
+		if err != nil {
+			return err
+		}
+		return c.rateVideos(ctx, svc, likedVideos, "none")
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_SAVED_ALBUMS:
+		return core.NewUnsupportedMusicSourceError(playlist, "clearing")
+	}
+	playlistId := playlist.GetPlaylistId()
 
 	var nextPageToken string
 	for {
@@ -191,21 +267,222 @@ func (c *youtubeClientImpl) ClearPlaylist(
 			PageToken(nextPageToken).
 			Do()
 		if err != nil {
-			return core.WrappedError(err, "failed to list playlist items")
+			return core.WrappedError(classifyYoutubeError(err), "failed to list playlist items")
 		}
 
 		for _, item := range resp.Items {
+			if err := core.CheckSyncRunCancelled(ctx); err != nil {
+				return core.WrappedError(err, "stopped clearing playlist %s", playlistId)
+			}
 			if err := svc.PlaylistItems.Delete(item.Id).Do(); err != nil {
-				return core.WrappedError(err, "failed to delete playlist item %s", item.Id)
+				return core.WrappedError(classifyYoutubeError(err), "failed to delete playlist item %s", item.Id)
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		nextPageToken = resp.NextPageToken
+	}
+
+	return nil
+}
+
+func (c *youtubeClientImpl) RemoveFromPlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
+	songs []core.Song, /*const*/
+) error {
+	svc, err := c.getService(ctx, userInfo)
+	if err != nil {
+		return core.WrappedError(err, "failed to get YouTube service")
+	}
+	switch playlist.GetKind() {
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_LIKED_TRACKS:
+		return c.rateVideos(ctx, svc, songs, "none")
+	case myncer_pb.MusicSourceKind_MUSIC_SOURCE_KIND_SAVED_ALBUMS:
+		return core.NewUnsupportedMusicSourceError(playlist, "removing songs")
+	}
+	playlistId := playlist.GetPlaylistId()
+
+	videoIds := core.NewSet[string]()
+	for _, song := range songs {
+		videoIds.Add(song.GetId())
+	}
+
+	// Playlist items are collected before deleting any so that deletions don't shift the pages.
+	itemIds := []string{}
+	var nextPageToken string
+	for {
+		resp, err := svc.PlaylistItems.
+			List([]string{"snippet"}).
+			PlaylistId(playlistId).
+			MaxResults(50).
+			PageToken(nextPageToken).
+			Do()
+		if err != nil {
+			return core.WrappedError(classifyYoutubeError(err), "failed to list playlist items")
+		}
+		for _, item := range resp.Items {
+			if videoIds.Contains(item.Snippet.ResourceId.VideoId) {
+				itemIds = append(itemIds, item.Id)
 			}
 		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		nextPageToken = resp.NextPageToken
+	}
+
+	for i, itemId := range itemIds {
+		if err := core.CheckSyncRunCancelled(ctx); err != nil {
+			return core.WrappedError(err, "stopped removing videos from playlist %s after %d videos", playlistId, i)
+		}
+		if err := svc.PlaylistItems.Delete(itemId).Do(); err != nil {
+			return core.WrappedError(classifyYoutubeError(err), "failed to delete playlist item %s", itemId)
+		}
+	}
+	return nil
+}
+
+// Each move is a playlist item update, which costs 50 units of the daily YouTube quota, so only the
+// songs out of order are moved.
+func (c *youtubeClientImpl) ReorderPlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
+	songs []core.Song, /*const*/
+) error {
+	if !core.IsMusicSourceReorderable(playlist) {
+		return core.NewUnsupportedMusicSourceError(playlist, "reordering")
+	}
+	svc, err := c.getService(ctx, userInfo)
+	if err != nil {
+		return core.WrappedError(err, "failed to get YouTube service")
+	}
+	playlistId := playlist.GetPlaylistId()
+
+	items := []*youtube.PlaylistItem{}
+	var nextPageToken string
+	for {
+		resp, err := svc.PlaylistItems.
+			List([]string{"snippet"}).
+			PlaylistId(playlistId).
+			MaxResults(50).
+			PageToken(nextPageToken).
+			Do()
+		if err != nil {
+			return core.WrappedError(classifyYoutubeError(err), "failed to list playlist items")
+		}
+		items = append(items, resp.Items...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		nextPageToken = resp.NextPageToken
+	}
+
+	// Items without a video, which GetPlaylistSongs skips, keep an empty id so that the ids line up
+	// with the positions of the playlist.
+	currentIds := []string{}
+	for _, item := range items {
+		currentIds = append(currentIds, item.Snippet.ResourceId.VideoId)
+	}
+	moves, err := getPlaylistMoves(currentIds, getSongIds(songs))
+	if err != nil {
+		return core.WrappedError(err, "failed to reorder playlist %s", playlistId)
+	}
+	for i, move := range moves {
+		if err := core.CheckSyncRunCancelled(ctx); err != nil {
+			return core.WrappedError(err, "stopped reordering playlist %s after %d moves", playlistId, i)
+		}
+		item := items[move.from]
+		if _, err := svc.PlaylistItems.Update(
+			[]string{"snippet"},
+			&youtube.PlaylistItem{
+				Id: item.Id,
+				Snippet: &youtube.PlaylistItemSnippet{
+					PlaylistId: playlistId,
+					ResourceId: item.Snippet.ResourceId,
+					Position:   int64(move.to),
+					// Position 0 would otherwise be dropped as an empty value.
+					ForceSendFields: []string{"Position"},
+				},
+			},
+		).
+			Do(); err != nil {
+			return core.WrappedError(classifyYoutubeError(err), "failed to move playlist item %s", item.Id)
+		}
+		moveItem(items, move.from, move.to)
+	}
+	return nil
+}
+
+// Returns the durations of the videos in milliseconds, keyed by video id.
+// Durations are best effort: failing to fetch them is logged and leaves them unknown.
+func (c *youtubeClientImpl) getVideoDurationsMs(
+	svc *youtube.Service,
+	videoIds []string, /*const*/
+) map[string]int64 {
+	r := map[string]int64{}
+	// Videos can be listed 50 ids at a time.
+	for start := 0; start < len(videoIds); start += 50 {
+		resp, err := svc.Videos.
+			List([]string{"contentDetails"}).
+			Id(videoIds[start:min(start+50, len(videoIds))]...).
+			MaxResults(50).
+			Do()
+		if err != nil {
+			core.Warningf("failed to fetch durations of YouTube videos: %v", classifyYoutubeError(err))
+			return r
+		}
+		for _, video := range resp.Items {
+			r[video.Id] = getYoutubeVideoDurationMs(video)
+		}
+	}
+	return r
+}
 
+// Returns the videos the user liked, most recently liked first.
+func (c *youtubeClientImpl) getLikedVideos(svc *youtube.Service) ([]core.Song, error) {
+	songs := []core.Song{}
+	nextPageToken := ""
+	for {
+		resp, err := svc.Videos.
+			List([]string{"snippet", "contentDetails"}).
+			MyRating("like").
+			MaxResults(50).
+			PageToken(nextPageToken).
+			Do()
+		if err != nil {
+			return nil, core.WrappedError(classifyYoutubeError(err), "failed to fetch liked videos")
+		}
+		for _, video := range resp.Items {
+			songs = append(songs, buildSongFromYouTubeVideo(video))
+		}
 		if resp.NextPageToken == "" {
 			break
 		}
 		nextPageToken = resp.NextPageToken
 	}
+	return songs, nil
+}
 
+// Rates every video, which is how videos are added to and removed from the liked videos.
+func (c *youtubeClientImpl) rateVideos(
+	ctx context.Context,
+	svc *youtube.Service,
+	songs []core.Song, /*const*/
+	rating string,
+) error {
+	for i, song := range songs {
+		if err := core.CheckSyncRunCancelled(ctx); err != nil {
+			return core.WrappedError(err, "stopped rating videos after %d videos", i)
+		}
+		if err := svc.Videos.Rate(song.GetId(), rating).Do(); err != nil {
+			return core.WrappedError(classifyYoutubeError(err), "failed to rate video %s", song.GetName())
+		}
+	}
 	return nil
 }
 
@@ -243,7 +520,8 @@ func (s *youtubeClientImpl) Search(
 	ctx context.Context,
 	userInfo *myncer_pb.User,
 	songToSearch core.Song,
-) (core.Song, error) {
+	profile *myncer_pb.MatchingProfile, /*const,@nullable*/
+) ([]*core.SearchCandidate, error) {
 	svc, err := s.getService(ctx, userInfo)
 	if err != nil {
 		return nil, core.WrappedError(err, "failed to get YouTube service")
@@ -251,7 +529,7 @@ func (s *youtubeClientImpl) Search(
 
 	// Search by metadata using multiple queries
 	queries := buildYouTubeQueries(songToSearch)
-	var bestMatch core.Song
+	candidates := []*core.SearchCandidate{}
 	highestScore := 0.0
 
 	for _, query := range queries {
@@ -262,6 +540,11 @@ func (s *youtubeClientImpl) Search(
 
 		resp, err := call.Do()
 		if err != nil {
+			// Exhausted quotas and rate limits would fail every other query too.
+			err = classifyYoutubeError(err)
+			if core.IsRetryableError(err) {
+				return nil, core.WrappedError(err, "YouTube search failed for query %q", query)
+			}
 			core.Warningf("YouTube search failed for query %q, trying next. Error: %v", query, err)
 			continue
 		}
@@ -271,32 +554,34 @@ func (s *youtubeClientImpl) Search(
 			continue
 		}
 
+		// Search results don't say how long their videos are.
+		videoIds := []string{}
 		for _, item := range resp.Items {
-			foundSong, err := buildSongFormYoutubeSearchResultItem(item)
+			if item.Id != nil && item.Id.VideoId != "" {
+				videoIds = append(videoIds, item.Id.VideoId)
+			}
+		}
+		durationsMs := s.getVideoDurationsMs(svc, videoIds)
+
+		for _, item := range resp.Items {
+			foundSong, err := buildSongFormYoutubeSearchResultItem(item, durationsMs)
 			if err != nil {
 				core.Warningf("Failed to build song from YouTube result: %v", err)
 				continue
 			}
 
-			score := matching.CalculateSimilarity(songToSearch, foundSong)
-
-			if score > highestScore {
-				highestScore = score
-				bestMatch = foundSong
-			}
+			score := matching.CalculateSimilarity(songToSearch, foundSong, profile)
+			candidates = append(candidates, &core.SearchCandidate{Song: foundSong, Score: score})
+			highestScore = math.Max(highestScore, score)
 
 			// If we find a nearly perfect match, we can stop.
-			if highestScore > 95.0 {
-				return bestMatch, nil
+			if matching.IsExactMatch(highestScore, profile, myncer_pb.Datasource_DATASOURCE_YOUTUBE) {
+				return matching.RankCandidates(candidates), nil
 			}
 		}
 	}
 
-	if bestMatch == nil {
-		return nil, core.NewError("no suitable video found after trying all queries for: %s", songToSearch.GetName())
-	}
-
-	return bestMatch, nil
+	return matching.RankCandidates(candidates), nil
 }
 
 func (c *youtubeClientImpl) getService(
@@ -371,6 +656,7 @@ func parseArtistsFromYouTubeTitle(title, channelTitle string) (string, []string)
 
 func buildSongFromYouTubePlaylistItem(
 	pi *youtube.PlaylistItem, /*const*/
+	durationMs int64, // 0 if unknown
 ) core.Song {
 	cleanTitle, artists := parseArtistsFromYouTubeTitle(pi.Snippet.Title, pi.Snippet.ChannelTitle)
 
@@ -380,12 +666,38 @@ func buildSongFromYouTubePlaylistItem(
 			ArtistName:       artists,
 			Datasource:       myncer_pb.Datasource_DATASOURCE_YOUTUBE,
 			DatasourceSongId: pi.Snippet.ResourceId.VideoId, // Use the VideoId as the ID
+			// The snippet of a playlist item is published when the item is added to the playlist.
+			AddedAt:    parseTimestamp(pi.Snippet.PublishedAt),
+			DurationMs: durationMs,
+		},
+	)
+}
+
+func buildSongFromYouTubeVideo(video *youtube.Video /*const*/) core.Song {
+	cleanTitle, artists := parseArtistsFromYouTubeTitle(video.Snippet.Title, video.Snippet.ChannelTitle)
+
+	return sync_engine.NewSong(
+		&myncer_pb.Song{
+			Name:             cleanTitle,
+			ArtistName:       artists,
+			Datasource:       myncer_pb.Datasource_DATASOURCE_YOUTUBE,
+			DatasourceSongId: video.Id,
+			DurationMs:       getYoutubeVideoDurationMs(video),
 		},
 	)
 }
 
+// Returns 0 if the content details of the video weren't fetched.
+func getYoutubeVideoDurationMs(video *youtube.Video /*const*/) int64 {
+	if video.ContentDetails == nil {
+		return 0
+	}
+	return parseIsoDurationMs(video.ContentDetails.Duration)
+}
+
 func buildSongFormYoutubeSearchResultItem(
 	item *youtube.SearchResult, /*const*/
+	durationsMs map[string]int64, /*const*/ // keyed by video id
 ) (core.Song, error) {
 	videoId := ""
 	if item.Id != nil && item.Id.VideoId != "" {
@@ -402,6 +714,7 @@ func buildSongFormYoutubeSearchResultItem(
 			ArtistName:       artists,
 			Datasource:       myncer_pb.Datasource_DATASOURCE_YOUTUBE,
 			DatasourceSongId: videoId,
+			DurationMs:       durationsMs[videoId],
 		},
 	), nil
 }
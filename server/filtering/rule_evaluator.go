@@ -0,0 +1,125 @@
+package filtering
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+// Decides which songs a sync carries based on its filter rules.
+type RuleEvaluator interface {
+	// Returns whether any of the rules excludes the song.
+	IsExcluded(song core.Song /*const*/) bool
+	// Splits the songs into the ones the rules keep and the ones they exclude, keeping their order.
+	FilterSongs(songs []core.Song /*const*/) (kept []core.Song, excluded []core.Song)
+}
+
+// Returns an error if any of the rules is invalid.
+// Rules that depend on time are evaluated relative to `now`.
+func NewRuleEvaluator(
+	rules []*myncer_pb.SyncFilterRule, /*const*/
+	now time.Time,
+) (RuleEvaluator, error) {
+	predicates := []songPredicate{}
+	for i, rule := range rules {
+		predicate, err := newSongPredicate(rule, now)
+		if err != nil {
+			return nil, core.WrappedError(err, "invalid filter rule %d", i+1)
+		}
+		predicates = append(predicates, predicate)
+	}
+	return &ruleEvaluatorImpl{predicates: predicates}, nil
+}
+
+// Returns true if the song is excluded.
+type songPredicate func(song core.Song /*const*/) bool
+
+type ruleEvaluatorImpl struct {
+	predicates []songPredicate
+}
+
+var _ RuleEvaluator = (*ruleEvaluatorImpl)(nil)
+
+func (r *ruleEvaluatorImpl) IsExcluded(song core.Song /*const*/) bool {
+	for _, predicate := range r.predicates {
+		if predicate(song) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *ruleEvaluatorImpl) FilterSongs(songs []core.Song /*const*/) ([]core.Song, []core.Song) {
+	kept, excluded := []core.Song{}, []core.Song{}
+	for _, song := range songs {
+		if r.IsExcluded(song) {
+			excluded = append(excluded, song)
+		} else {
+			kept = append(kept, song)
+		}
+	}
+	return kept, excluded
+}
+
+func newSongPredicate(rule *myncer_pb.SyncFilterRule /*const*/, now time.Time) (songPredicate, error) {
+	switch v := rule.GetRule().(type) {
+	case *myncer_pb.SyncFilterRule_ExcludeArtists:
+		artistNames := core.NewSet[string]()
+		for _, artistName := range v.ExcludeArtists.GetArtistNames() {
+			if normalized := normalizeArtistName(artistName); normalized != "" {
+				artistNames.Add(normalized)
+			}
+		}
+		if artistNames.IsEmpty() {
+			return nil, core.NewError("at least one artist name must be specified")
+		}
+		return func(song core.Song) bool {
+			for _, artistName := range song.GetArtistNames() {
+				if artistNames.Contains(normalizeArtistName(artistName)) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case *myncer_pb.SyncFilterRule_ExcludeNamePattern:
+		if v.ExcludeNamePattern == "" {
+			return nil, core.NewError("name pattern must be specified")
+		}
+		pattern, err := regexp.Compile(v.ExcludeNamePattern)
+		if err != nil {
+			return nil, core.WrappedError(err, "failed to compile name pattern")
+		}
+		return func(song core.Song) bool {
+			return pattern.MatchString(song.GetName())
+		}, nil
+	case *myncer_pb.SyncFilterRule_AddedWithinDays:
+		if v.AddedWithinDays <= 0 {
+			return nil, core.NewError("added within days must be positive")
+		}
+		cutoff := now.AddDate(0, 0, -int(v.AddedWithinDays))
+		return func(song core.Song) bool {
+			addedAt := song.GetSpec().GetAddedAt()
+			if addedAt == nil {
+				// There's no telling whether the song is recent enough, so it's kept.
+				return false
+			}
+			return addedAt.AsTime().Before(cutoff)
+		}, nil
+	case *myncer_pb.SyncFilterRule_ExcludeExplicit:
+		if !v.ExcludeExplicit {
+			return nil, core.NewError("exclude explicit must be true when set")
+		}
+		return func(song core.Song) bool {
+			return song.GetSpec().GetExplicit()
+		}, nil
+	default:
+		return nil, core.NewError("unknown filter rule type: %T", v)
+	}
+}
+
+func normalizeArtistName(artistName string) string {
+	return strings.ToLower(strings.TrimSpace(artistName))
+}
@@ -0,0 +1,122 @@
+package filtering
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type fakeSong struct {
+	core.Song
+	spec *myncer_pb.Song
+}
+
+func (f *fakeSong) GetName() string          { return f.spec.GetName() }
+func (f *fakeSong) GetArtistNames() []string { return f.spec.GetArtistName() }
+func (f *fakeSong) GetSpec() *myncer_pb.Song { return f.spec }
+
+func TestRuleEvaluator(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+	songs := []core.Song{
+		&fakeSong{spec: &myncer_pb.Song{
+			DatasourceSongId: "1",
+			Name:             "Intro",
+			ArtistName:       []string{"Someone", "Daft Punk"},
+		}},
+		&fakeSong{spec: &myncer_pb.Song{
+			DatasourceSongId: "2",
+			Name:             "Song (Live)",
+			ArtistName:       []string{"Someone"},
+			Explicit:         true,
+		}},
+		&fakeSong{spec: &myncer_pb.Song{
+			DatasourceSongId: "3",
+			Name:             "Old song",
+			ArtistName:       []string{"Someone else"},
+			AddedAt:          timestamppb.New(now.AddDate(0, 0, -10)),
+		}},
+		&fakeSong{spec: &myncer_pb.Song{
+			DatasourceSongId: "4",
+			Name:             "New song",
+			ArtistName:       []string{"Someone else"},
+			AddedAt:          timestamppb.New(now.AddDate(0, 0, -2)),
+		}},
+	}
+	getIds := func(songs []core.Song) []string {
+		r := []string{}
+		for _, song := range songs {
+			r = append(r, song.GetSpec().GetDatasourceSongId())
+		}
+		return r
+	}
+
+	testCases := []struct {
+		name        string
+		rules       []*myncer_pb.SyncFilterRule
+		expectedErr bool
+		keptIds     []string
+		excludedIds []string
+	}{
+		{
+			name:        "no rules keep every song",
+			keptIds:     []string{"1", "2", "3", "4"},
+			excludedIds: []string{},
+		},
+		{
+			name: "artists ignore case",
+			rules: []*myncer_pb.SyncFilterRule{
+				{Rule: &myncer_pb.SyncFilterRule_ExcludeArtists{
+					ExcludeArtists: &myncer_pb.SyncFilterArtists{ArtistNames: []string{" daft punk"}},
+				}},
+			},
+			keptIds:     []string{"2", "3", "4"},
+			excludedIds: []string{"1"},
+		},
+		{
+			name: "name pattern and explicit",
+			rules: []*myncer_pb.SyncFilterRule{
+				{Rule: &myncer_pb.SyncFilterRule_ExcludeNamePattern{ExcludeNamePattern: `(?i)^old`}},
+				{Rule: &myncer_pb.SyncFilterRule_ExcludeExplicit{ExcludeExplicit: true}},
+			},
+			keptIds:     []string{"1", "4"},
+			excludedIds: []string{"2", "3"},
+		},
+		{
+			name: "added within days keeps songs with unknown added time",
+			rules: []*myncer_pb.SyncFilterRule{
+				{Rule: &myncer_pb.SyncFilterRule_AddedWithinDays{AddedWithinDays: 7}},
+			},
+			keptIds:     []string{"1", "2", "4"},
+			excludedIds: []string{"3"},
+		},
+		{
+			name: "invalid name pattern",
+			rules: []*myncer_pb.SyncFilterRule{
+				{Rule: &myncer_pb.SyncFilterRule_ExcludeNamePattern{ExcludeNamePattern: `(`}},
+			},
+			expectedErr: true,
+		},
+		{
+			name:        "empty rule",
+			rules:       []*myncer_pb.SyncFilterRule{{}},
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			evaluator, err := NewRuleEvaluator(tc.rules, now)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			kept, excluded := evaluator.FilterSongs(songs)
+			assert.Equal(t, tc.keptIds, getIds(kept))
+			assert.Equal(t, tc.excludedIds, getIds(excluded))
+		})
+	}
+}
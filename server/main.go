@@ -13,9 +13,16 @@ import (
 	myncer_pb_connect "github.com/hansbala/myncer/proto/myncer/myncer_pbconnect"
 	"github.com/hansbala/myncer/services"
 	"github.com/hansbala/myncer/sync_engine"
+	"github.com/hansbala/myncer/sync_scheduler"
+	"github.com/hansbala/myncer/sync_worker"
 	"github.com/rs/cors"
 )
 
+const (
+	// Number of sync runs this server executes concurrently.
+	cNumSyncWorkers = 4
+)
+
 func main() {
 	ctx := context.Background()
 	spotifyClient := datasources.NewSpotifyClient()
@@ -35,6 +42,11 @@ func main() {
 	)
 	ctx = core.WithMyncerCtx(ctx, myncerCtx)
 
+	// Sync runs are executed in the background by a pool of workers.
+	go sync_worker.NewSyncWorkerPool(sync_engine.NewSyncEngine(), cNumSyncWorkers).Start(ctx)
+	// Queue scheduled syncs as they become due.
+	go sync_scheduler.NewSyncScheduler().Start(ctx)
+
 	// All routes are served on a single mux.
 	// We expect there is no path conflict between REST and GRPC for the time being.
 	// The long term goal is to remove API entirely.
@@ -0,0 +1,112 @@
+package matching
+
+import (
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+const (
+	cDefaultDedupeThreshold = 90.0
+	cDefaultTitleWeight     = 45.0
+	cDefaultArtistWeight    = 45.0
+	cDefaultAlbumWeight     = 10.0
+	// Search results scoring above this are taken without looking at further results.
+	cExactMatchScore = 95.0
+	// Search results scoring above this are taken without trying more generic queries.
+	cConfidentMatchScore = 85.0
+)
+
+// Minimum acceptance scores of datasources whose search results aren't all accepted by default.
+// Other datasources accept their best search result unless the profile sets a minimum.
+var cDefaultMinAcceptanceScores = map[myncer_pb.Datasource]float64{
+	myncer_pb.Datasource_DATASOURCE_TIDAL: 65.0,
+}
+
+// ValidateMatchingProfile returns an error if the matching profile can't be used for matching.
+func ValidateMatchingProfile(profile *myncer_pb.MatchingProfile /*const,@nullable*/) error {
+	scores := []struct {
+		name  string
+		value float64
+	}{
+		{"dedupe threshold", profile.GetDedupeThreshold()},
+		{"minimum acceptance score", profile.GetMinAcceptanceScore()},
+	}
+	for _, score := range scores {
+		if score.value < 0 || score.value > 100 {
+			return core.NewError("%s must be between 0 and 100, got %v", score.name, score.value)
+		}
+	}
+	weights := []struct {
+		name  string
+		value float64
+	}{
+		{"title weight", profile.GetTitleWeight()},
+		{"artist weight", profile.GetArtistWeight()},
+		{"album weight", profile.GetAlbumWeight()},
+	}
+	for _, weight := range weights {
+		if weight.value < 0 {
+			return core.NewError("%s must not be negative, got %v", weight.name, weight.value)
+		}
+	}
+	return nil
+}
+
+// GetDedupeThreshold returns the score at which songs are considered duplicates.
+func GetDedupeThreshold(profile *myncer_pb.MatchingProfile /*const,@nullable*/) float64 {
+	if profile.GetDedupeThreshold() == 0 {
+		return cDefaultDedupeThreshold
+	}
+	return profile.GetDedupeThreshold()
+}
+
+// GetMinAcceptanceScore returns the score below which search results of the datasource are not
+// accepted as a match.
+func GetMinAcceptanceScore(
+	profile *myncer_pb.MatchingProfile, /*const,@nullable*/
+	datasource myncer_pb.Datasource,
+) float64 {
+	if profile.GetMinAcceptanceScore() == 0 {
+		return cDefaultMinAcceptanceScores[datasource]
+	}
+	return profile.GetMinAcceptanceScore()
+}
+
+// IsExactMatch returns whether a search can stop at a result with the score.
+func IsExactMatch(
+	score float64,
+	profile *myncer_pb.MatchingProfile, /*const,@nullable*/
+	datasource myncer_pb.Datasource,
+) bool {
+	return score > cExactMatchScore && IsAcceptableMatch(score, profile, datasource)
+}
+
+// IsConfidentMatch returns whether a search can skip more generic queries once it has a result
+// with the score.
+func IsConfidentMatch(
+	score float64,
+	profile *myncer_pb.MatchingProfile, /*const,@nullable*/
+	datasource myncer_pb.Datasource,
+) bool {
+	return score > cConfidentMatchScore && IsAcceptableMatch(score, profile, datasource)
+}
+
+// IsAcceptableMatch returns whether a search result of the datasource with the score can be
+// accepted as a match. Results sharing nothing with the searched song are never accepted.
+func IsAcceptableMatch(
+	score float64,
+	profile *myncer_pb.MatchingProfile, /*const,@nullable*/
+	datasource myncer_pb.Datasource,
+) bool {
+	return score > 0 && score >= GetMinAcceptanceScore(profile, datasource)
+}
+
+// Returns the title, artist and album weights of the profile, which sum up to 1.
+func getWeights(profile *myncer_pb.MatchingProfile /*const,@nullable*/) (float64, float64, float64) {
+	title, artist, album := profile.GetTitleWeight(), profile.GetArtistWeight(), profile.GetAlbumWeight()
+	if title+artist+album == 0 {
+		title, artist, album = cDefaultTitleWeight, cDefaultArtistWeight, cDefaultAlbumWeight
+	}
+	total := title + artist + album
+	return title / total, artist / total, album / total
+}
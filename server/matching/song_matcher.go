@@ -1,10 +1,13 @@
 package matching
 
 import (
+	"cmp"
 	"math"
+	"slices"
 	"strings"
 
 	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
 	"github.com/lithammer/fuzzysearch/fuzzy"
 )
 
@@ -50,11 +53,41 @@ func tokenSetRatio(s1, s2 string) float64 {
 	return (float64(len(intersection)) / float64(len(union))) * 100.0
 }
 
+const (
+	// Songs whose durations differ by up to this much are taken to be the same recording.
+	cDurationToleranceMs = 3000
+	// Songs whose durations differ by this much or more are different versions of the song, e.g. a
+	// radio edit and an extended mix.
+	cDurationMismatchMs = 30000
+	// Scales down the score of songs whose durations mismatch, enough that a perfect metadata match
+	// isn't accepted with the default profile.
+	cDurationMismatchFactor = 0.6
+)
+
+// getDurationFactor returns how much the difference in duration scales the similarity of two songs,
+// from cDurationMismatchFactor to 1. Songs without a duration aren't penalized.
+func getDurationFactor(durationMsA, durationMsB int64) float64 {
+	if durationMsA <= 0 || durationMsB <= 0 {
+		return 1.0
+	}
+	diff := math.Abs(float64(durationMsA - durationMsB))
+	if diff <= cDurationToleranceMs {
+		return 1.0
+	}
+	if diff >= cDurationMismatchMs {
+		return cDurationMismatchFactor
+	}
+	// Scaled down linearly in between.
+	mismatch := (diff - cDurationToleranceMs) / (cDurationMismatchMs - cDurationToleranceMs)
+	return 1.0 - (1.0-cDurationMismatchFactor)*mismatch
+}
 
 // CalculateSimilarity calculates a weighted similarity score between two songs.
 // It prioritizes an exact ISRC match and falls back to a weighted fuzzy match
 // on cleaned metadata if no ISRC is available.
-func CalculateSimilarity(songA, songB core.Song) float64 {
+// The weights of the fuzzy match come from the matching profile, and the score is scaled down for
+// songs whose durations differ.
+func CalculateSimilarity(songA, songB core.Song, profile *myncer_pb.MatchingProfile /*const,@nullable*/) float64 {
 	// 1. Exact identifier check (ISRC). If it matches, it's 100% the same song.
 	isrcA := songA.GetSpec().GetIsrc()
 	isrcB := songB.GetSpec().GetIsrc()
@@ -88,33 +121,44 @@ func CalculateSimilarity(songA, songB core.Song) float64 {
 		return artistScore * 0.5 // Return very low score so it gets discarded.
 	}
 
-	// If albums are present in both songs but don't match, reduce the importance of title.
-	titleWeight := 0.45
+	// Default weightings: 45% artist, 45% title, 10% album.
+	titleWeight, artistWeight, albumWeight := getWeights(profile)
+
+	// If albums are present in both songs but don't match, reduce the importance of title by a
+	// third (45% to 30% by default).
 	if albumA != "" && albumB != "" && albumScore < 70 {
-		titleWeight = 0.30
+		titleWeight *= 2.0 / 3.0
 	}
-	
-	// New weightings: 45% artist, 45% title (or 30% if album doesn't match), 10% album.
-	artistWeight := 0.45
-	albumWeight := 0.10
 
 	weightedScore := (titleScore * titleWeight) + (artistScore * artistWeight) + (albumScore * albumWeight)
 
-	return weightedScore
+	// Titles often clean to the same string for different versions of a song, which the durations
+	// tell apart.
+	return weightedScore * getDurationFactor(songA.GetSpec().GetDurationMs(), songB.GetSpec().GetDurationMs())
 }
 
-// AreDuplicates compares two songs to determine if they are duplicates based on a similarity threshold.
-func AreDuplicates(songA, songB core.Song, threshold float64) bool {
-	return CalculateSimilarity(songA, songB) >= threshold
+// AreDuplicates compares two songs to determine if they are duplicates based on the dedupe threshold
+// of the matching profile.
+// Songs whose durations mismatch are never duplicates, whatever the threshold.
+func AreDuplicates(songA, songB core.Song, profile *myncer_pb.MatchingProfile /*const,@nullable*/) bool {
+	if getDurationFactor(songA.GetSpec().GetDurationMs(), songB.GetSpec().GetDurationMs()) ==
+		cDurationMismatchFactor {
+		return false
+	}
+	return CalculateSimilarity(songA, songB, profile) >= GetDedupeThreshold(profile)
 }
 
-// DeduplicateSongs filters a list of songs, returning only the unique ones based on the similarity threshold.
-func DeduplicateSongs(songs []core.Song, threshold float64) ([]core.Song, error) {
+// DeduplicateSongs filters a list of songs, returning only the unique ones based on the dedupe
+// threshold of the matching profile.
+func DeduplicateSongs(
+	songs []core.Song,
+	profile *myncer_pb.MatchingProfile, /*const,@nullable*/
+) ([]core.Song, error) {
 	uniqueSongs := []core.Song{}
 	for _, song := range songs {
 		isDuplicate := false
 		for _, uniqueSong := range uniqueSongs {
-			if AreDuplicates(song, uniqueSong, threshold) {
+			if AreDuplicates(song, uniqueSong, profile) {
 				isDuplicate = true
 				break
 			}
@@ -125,3 +169,30 @@ func DeduplicateSongs(songs []core.Song, threshold float64) ([]core.Song, error)
 	}
 	return uniqueSongs, nil
 }
+
+// The most candidates a search returns.
+const CMaxSearchCandidates = 5
+
+// Returns the candidates best first, keeping the best score of songs that were found more than once.
+// Candidates that score the same keep their order. At most CMaxSearchCandidates are kept.
+func RankCandidates(candidates []*core.SearchCandidate /*const*/) []*core.SearchCandidate {
+	bestById := map[string]*core.SearchCandidate{}
+	r := []*core.SearchCandidate{}
+	for _, candidate := range candidates {
+		id := candidate.Song.GetId()
+		if best, ok := bestById[id]; ok {
+			best.Score = math.Max(best.Score, candidate.Score)
+			continue
+		}
+		best := &core.SearchCandidate{Song: candidate.Song, Score: candidate.Score}
+		bestById[id] = best
+		r = append(r, best)
+	}
+	slices.SortStableFunc(r, func(a, b *core.SearchCandidate) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+	if len(r) > CMaxSearchCandidates {
+		r = r[:CMaxSearchCandidates]
+	}
+	return r
+}
@@ -0,0 +1,134 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/stretchr/testify/assert"
+)
+
+// A song backed by its spec.
+type fakeSong struct {
+	core.Song
+	spec *myncer_pb.Song
+}
+
+func (f *fakeSong) GetName() string          { return f.spec.GetName() }
+func (f *fakeSong) GetArtistNames() []string { return f.spec.GetArtistName() }
+func (f *fakeSong) GetAlbum() string         { return f.spec.GetAlbumName() }
+func (f *fakeSong) GetId() string            { return f.spec.GetDatasourceSongId() }
+func (f *fakeSong) GetSpec() *myncer_pb.Song { return f.spec }
+
+// Returns a version of the same song that lasts `durationMs`.
+func newSongVersion(id string, durationMs int64) core.Song {
+	return &fakeSong{
+		spec: &myncer_pb.Song{
+			Name:             "Midnight City",
+			ArtistName:       []string{"M83"},
+			AlbumName:        "Hurry Up, We're Dreaming",
+			DatasourceSongId: id,
+			DurationMs:       durationMs,
+		},
+	}
+}
+
+func TestGetDurationFactor(t *testing.T) {
+	testCases := []struct {
+		name        string
+		durationMsA int64
+		durationMsB int64
+		expected    float64
+	}{
+		{
+			name:        "unknown duration",
+			durationMsA: 0,
+			durationMsB: 200000,
+			expected:    1.0,
+		},
+		{
+			name:        "within tolerance",
+			durationMsA: 200000,
+			durationMsB: 202500,
+			expected:    1.0,
+		},
+		{
+			name:        "halfway to a mismatch",
+			durationMsA: 200000,
+			durationMsB: 216500,
+			expected:    0.8,
+		},
+		{
+			name:        "radio edit and extended mix",
+			durationMsA: 200000,
+			durationMsB: 585000,
+			expected:    cDurationMismatchFactor,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.expected, getDurationFactor(tc.durationMsA, tc.durationMsB), 1e-9)
+			assert.InDelta(t, tc.expected, getDurationFactor(tc.durationMsB, tc.durationMsA), 1e-9)
+		})
+	}
+}
+
+func TestCalculateSimilarityPrefersMatchingDuration(t *testing.T) {
+	songToSearch := newSongVersion("source", 243000)
+	candidates := []*core.SearchCandidate{}
+	for _, song := range []core.Song{
+		newSongVersion("extended-mix", 540000),
+		newSongVersion("radio-edit", 244000),
+		newSongVersion("unknown-duration", 0),
+	} {
+		candidates = append(
+			candidates,
+			&core.SearchCandidate{Song: song, Score: CalculateSimilarity(songToSearch, song, nil /*profile*/)},
+		)
+	}
+
+	ranked := RankCandidates(candidates)
+	ids := []string{}
+	for _, candidate := range ranked {
+		ids = append(ids, candidate.Song.GetId())
+	}
+	// Songs without a duration aren't penalized, so they tie with the right duration.
+	assert.Equal(t, []string{"radio-edit", "unknown-duration", "extended-mix"}, ids)
+	assert.Less(t, ranked[2].Score, GetMinAcceptanceScore(nil /*profile*/, myncer_pb.Datasource_DATASOURCE_TIDAL))
+}
+
+func TestDeduplicateSongsKeepsVersionsApart(t *testing.T) {
+	testCases := []struct {
+		name        string
+		songs       []core.Song
+		expectedIds []string
+	}{
+		{
+			name:        "same recording",
+			songs:       []core.Song{newSongVersion("a", 243000), newSongVersion("b", 244500)},
+			expectedIds: []string{"a"},
+		},
+		{
+			name:        "unknown duration",
+			songs:       []core.Song{newSongVersion("a", 243000), newSongVersion("b", 0)},
+			expectedIds: []string{"a"},
+		},
+		{
+			name:        "radio edit and extended mix",
+			songs:       []core.Song{newSongVersion("a", 243000), newSongVersion("b", 540000)},
+			expectedIds: []string{"a", "b"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, len(tc.expectedIds) == 1, AreDuplicates(tc.songs[0], tc.songs[1], nil /*profile*/))
+			uniqueSongs, err := DeduplicateSongs(tc.songs, nil /*profile*/)
+			assert.NoError(t, err)
+			ids := []string{}
+			for _, song := range uniqueSongs {
+				ids = append(ids, song.GetId())
+			}
+			assert.Equal(t, tc.expectedIds, ids)
+		})
+	}
+}
@@ -122,6 +122,61 @@ func (OAuthExchangeStatus) EnumDescriptor() ([]byte, []int) {
 	return file_myncer_datasource_proto_rawDescGZIP(), []int{1}
 }
 
+// What part of the user's library a music source is.
+type MusicSourceKind int32
+
+const (
+	// The playlist identified by `playlist_id`.
+	MusicSourceKind_MUSIC_SOURCE_KIND_UNSPECIFIED MusicSourceKind = 0
+	// The tracks the user liked: Liked Songs on Spotify, favorite tracks on Tidal and liked videos
+	// on YouTube.
+	MusicSourceKind_MUSIC_SOURCE_KIND_LIKED_TRACKS MusicSourceKind = 1
+	// The tracks of every album the user saved to their library.
+	// Can only be read, and isn't available on YouTube.
+	MusicSourceKind_MUSIC_SOURCE_KIND_SAVED_ALBUMS MusicSourceKind = 2
+)
+
+// Enum value maps for MusicSourceKind.
+var (
+	MusicSourceKind_name = map[int32]string{
+		0: "MUSIC_SOURCE_KIND_UNSPECIFIED",
+		1: "MUSIC_SOURCE_KIND_LIKED_TRACKS",
+		2: "MUSIC_SOURCE_KIND_SAVED_ALBUMS",
+	}
+	MusicSourceKind_value = map[string]int32{
+		"MUSIC_SOURCE_KIND_UNSPECIFIED":  0,
+		"MUSIC_SOURCE_KIND_LIKED_TRACKS": 1,
+		"MUSIC_SOURCE_KIND_SAVED_ALBUMS": 2,
+	}
+)
+
+func (x MusicSourceKind) Enum() *MusicSourceKind {
+	p := new(MusicSourceKind)
+	*p = x
+	return p
+}
+
+func (x MusicSourceKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MusicSourceKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_myncer_datasource_proto_enumTypes[2].Descriptor()
+}
+
+func (MusicSourceKind) Type() protoreflect.EnumType {
+	return &file_myncer_datasource_proto_enumTypes[2]
+}
+
+func (x MusicSourceKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MusicSourceKind.Descriptor instead.
+func (MusicSourceKind) EnumDescriptor() ([]byte, []int) {
+	return file_myncer_datasource_proto_rawDescGZIP(), []int{2}
+}
+
 type ExchangeOAuthCodeRequest struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
 	Datasource Datasource             `protobuf:"varint,1,opt,name=datasource,proto3,enum=myncer.Datasource" json:"datasource,omitempty"`
@@ -668,7 +723,9 @@ type MusicSource struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
 	Datasource Datasource             `protobuf:"varint,1,opt,name=datasource,proto3,enum=myncer.Datasource" json:"datasource,omitempty"`
 	// Unique, stable playlist identifier for the datasource.
-	PlaylistId    string `protobuf:"bytes,2,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"` // next: 3
+	// Empty for library collections.
+	PlaylistId    string          `protobuf:"bytes,2,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	Kind          MusicSourceKind `protobuf:"varint,3,opt,name=kind,proto3,enum=myncer.MusicSourceKind" json:"kind,omitempty"` // next: 4
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -717,6 +774,13 @@ func (x *MusicSource) GetPlaylistId() string {
 	return ""
 }
 
+func (x *MusicSource) GetKind() MusicSourceKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MusicSourceKind_MUSIC_SOURCE_KIND_UNSPECIFIED
+}
+
 var File_myncer_datasource_proto protoreflect.FileDescriptor
 
 const file_myncer_datasource_proto_rawDesc = "" +
@@ -759,13 +823,14 @@ const file_myncer_datasource_proto_rawDesc = "" +
 	"\vplaylist_id\x18\x02 \x01(\tR\n" +
 	"playlistId\"J\n" +
 	"\x1aGetPlaylistDetailsResponse\x12,\n" +
-	"\bplaylist\x18\x01 \x01(\v2\x10.myncer.PlaylistR\bplaylist\"b\n" +
+	"\bplaylist\x18\x01 \x01(\v2\x10.myncer.PlaylistR\bplaylist\"\x8f\x01\n" +
 	"\vMusicSource\x122\n" +
 	"\n" +
 	"datasource\x18\x01 \x01(\x0e2\x12.myncer.DatasourceR\n" +
 	"datasource\x12\x1f\n" +
 	"\vplaylist_id\x18\x02 \x01(\tR\n" +
-	"playlistId*n\n" +
+	"playlistId\x12+\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x17.myncer.MusicSourceKindR\x04kind*n\n" +
 	"\n" +
 	"Datasource\x12\x1a\n" +
 	"\x16DATASOURCE_UNSPECIFIED\x10\x00\x12\x16\n" +
@@ -775,7 +840,11 @@ const file_myncer_datasource_proto_rawDesc = "" +
 	"\x13OAuthExchangeStatus\x12&\n" +
 	"\"O_AUTH_EXCHANGE_STATUS_UNSPECIFIED\x10\x00\x12\"\n" +
 	"\x1eO_AUTH_EXCHANGE_STATUS_SUCCESS\x10\x01\x12\"\n" +
-	"\x1eO_AUTH_EXCHANGE_STATUS_FAILURE\x10\x022\xc3\x03\n" +
+	"\x1eO_AUTH_EXCHANGE_STATUS_FAILURE\x10\x02*|\n" +
+	"\x0fMusicSourceKind\x12!\n" +
+	"\x1dMUSIC_SOURCE_KIND_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eMUSIC_SOURCE_KIND_LIKED_TRACKS\x10\x01\x12\"\n" +
+	"\x1eMUSIC_SOURCE_KIND_SAVED_ALBUMS\x10\x022\xc3\x03\n" +
 	"\x11DatasourceService\x12X\n" +
 	"\x11ExchangeOAuthCode\x12 .myncer.ExchangeOAuthCodeRequest\x1a!.myncer.ExchangeOAuthCodeResponse\x12R\n" +
 	"\x0fListDatasources\x12\x1e.myncer.ListDatasourcesRequest\x1a\x1f.myncer.ListDatasourcesResponse\x12L\n" +
@@ -795,23 +864,24 @@ func file_myncer_datasource_proto_rawDescGZIP() []byte {
 	return file_myncer_datasource_proto_rawDescData
 }
 
-var file_myncer_datasource_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_myncer_datasource_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_myncer_datasource_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_myncer_datasource_proto_goTypes = []any{
 	(Datasource)(0),                    // 0: myncer.Datasource
 	(OAuthExchangeStatus)(0),           // 1: myncer.OAuthExchangeStatus
-	(*ExchangeOAuthCodeRequest)(nil),   // 2: myncer.ExchangeOAuthCodeRequest
-	(*ExchangeOAuthCodeResponse)(nil),  // 3: myncer.ExchangeOAuthCodeResponse
-	(*UnlinkDatasourceRequest)(nil),    // 4: myncer.UnlinkDatasourceRequest
-	(*UnlinkDatasourceResponse)(nil),   // 5: myncer.UnlinkDatasourceResponse
-	(*ListDatasourcesRequest)(nil),     // 6: myncer.ListDatasourcesRequest
-	(*ListDatasourcesResponse)(nil),    // 7: myncer.ListDatasourcesResponse
-	(*ListPlaylistsRequest)(nil),       // 8: myncer.ListPlaylistsRequest
-	(*Playlist)(nil),                   // 9: myncer.Playlist
-	(*ListPlaylistsResponse)(nil),      // 10: myncer.ListPlaylistsResponse
-	(*GetPlaylistDetailsRequest)(nil),  // 11: myncer.GetPlaylistDetailsRequest
-	(*GetPlaylistDetailsResponse)(nil), // 12: myncer.GetPlaylistDetailsResponse
-	(*MusicSource)(nil),                // 13: myncer.MusicSource
+	(MusicSourceKind)(0),               // 2: myncer.MusicSourceKind
+	(*ExchangeOAuthCodeRequest)(nil),   // 3: myncer.ExchangeOAuthCodeRequest
+	(*ExchangeOAuthCodeResponse)(nil),  // 4: myncer.ExchangeOAuthCodeResponse
+	(*UnlinkDatasourceRequest)(nil),    // 5: myncer.UnlinkDatasourceRequest
+	(*UnlinkDatasourceResponse)(nil),   // 6: myncer.UnlinkDatasourceResponse
+	(*ListDatasourcesRequest)(nil),     // 7: myncer.ListDatasourcesRequest
+	(*ListDatasourcesResponse)(nil),    // 8: myncer.ListDatasourcesResponse
+	(*ListPlaylistsRequest)(nil),       // 9: myncer.ListPlaylistsRequest
+	(*Playlist)(nil),                   // 10: myncer.Playlist
+	(*ListPlaylistsResponse)(nil),      // 11: myncer.ListPlaylistsResponse
+	(*GetPlaylistDetailsRequest)(nil),  // 12: myncer.GetPlaylistDetailsRequest
+	(*GetPlaylistDetailsResponse)(nil), // 13: myncer.GetPlaylistDetailsResponse
+	(*MusicSource)(nil),                // 14: myncer.MusicSource
 }
 var file_myncer_datasource_proto_depIdxs = []int32{
 	0,  // 0: myncer.ExchangeOAuthCodeRequest.datasource:type_name -> myncer.Datasource
@@ -819,26 +889,27 @@ var file_myncer_datasource_proto_depIdxs = []int32{
 	0,  // 2: myncer.UnlinkDatasourceRequest.datasource:type_name -> myncer.Datasource
 	0,  // 3: myncer.ListDatasourcesResponse.datasources:type_name -> myncer.Datasource
 	0,  // 4: myncer.ListPlaylistsRequest.datasource:type_name -> myncer.Datasource
-	13, // 5: myncer.Playlist.music_source:type_name -> myncer.MusicSource
-	9,  // 6: myncer.ListPlaylistsResponse.playlist:type_name -> myncer.Playlist
+	14, // 5: myncer.Playlist.music_source:type_name -> myncer.MusicSource
+	10, // 6: myncer.ListPlaylistsResponse.playlist:type_name -> myncer.Playlist
 	0,  // 7: myncer.GetPlaylistDetailsRequest.datasource:type_name -> myncer.Datasource
-	9,  // 8: myncer.GetPlaylistDetailsResponse.playlist:type_name -> myncer.Playlist
+	10, // 8: myncer.GetPlaylistDetailsResponse.playlist:type_name -> myncer.Playlist
 	0,  // 9: myncer.MusicSource.datasource:type_name -> myncer.Datasource
-	2,  // 10: myncer.DatasourceService.ExchangeOAuthCode:input_type -> myncer.ExchangeOAuthCodeRequest
-	6,  // 11: myncer.DatasourceService.ListDatasources:input_type -> myncer.ListDatasourcesRequest
-	8,  // 12: myncer.DatasourceService.ListPlaylists:input_type -> myncer.ListPlaylistsRequest
-	11, // 13: myncer.DatasourceService.GetPlaylistDetails:input_type -> myncer.GetPlaylistDetailsRequest
-	4,  // 14: myncer.DatasourceService.UnlinkDatasource:input_type -> myncer.UnlinkDatasourceRequest
-	3,  // 15: myncer.DatasourceService.ExchangeOAuthCode:output_type -> myncer.ExchangeOAuthCodeResponse
-	7,  // 16: myncer.DatasourceService.ListDatasources:output_type -> myncer.ListDatasourcesResponse
-	10, // 17: myncer.DatasourceService.ListPlaylists:output_type -> myncer.ListPlaylistsResponse
-	12, // 18: myncer.DatasourceService.GetPlaylistDetails:output_type -> myncer.GetPlaylistDetailsResponse
-	5,  // 19: myncer.DatasourceService.UnlinkDatasource:output_type -> myncer.UnlinkDatasourceResponse
-	15, // [15:20] is the sub-list for method output_type
-	10, // [10:15] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	2,  // 10: myncer.MusicSource.kind:type_name -> myncer.MusicSourceKind
+	3,  // 11: myncer.DatasourceService.ExchangeOAuthCode:input_type -> myncer.ExchangeOAuthCodeRequest
+	7,  // 12: myncer.DatasourceService.ListDatasources:input_type -> myncer.ListDatasourcesRequest
+	9,  // 13: myncer.DatasourceService.ListPlaylists:input_type -> myncer.ListPlaylistsRequest
+	12, // 14: myncer.DatasourceService.GetPlaylistDetails:input_type -> myncer.GetPlaylistDetailsRequest
+	5,  // 15: myncer.DatasourceService.UnlinkDatasource:input_type -> myncer.UnlinkDatasourceRequest
+	4,  // 16: myncer.DatasourceService.ExchangeOAuthCode:output_type -> myncer.ExchangeOAuthCodeResponse
+	8,  // 17: myncer.DatasourceService.ListDatasources:output_type -> myncer.ListDatasourcesResponse
+	11, // 18: myncer.DatasourceService.ListPlaylists:output_type -> myncer.ListPlaylistsResponse
+	13, // 19: myncer.DatasourceService.GetPlaylistDetails:output_type -> myncer.GetPlaylistDetailsResponse
+	6,  // 20: myncer.DatasourceService.UnlinkDatasource:output_type -> myncer.UnlinkDatasourceResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_myncer_datasource_proto_init() }
@@ -851,7 +922,7 @@ func file_myncer_datasource_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_myncer_datasource_proto_rawDesc), len(file_myncer_datasource_proto_rawDesc)),
-			NumEnums:      2,
+			NumEnums:      3,
 			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
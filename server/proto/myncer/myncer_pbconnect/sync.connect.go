@@ -37,6 +37,12 @@ const (
 	SyncServiceCreateSyncProcedure = "/myncer.SyncService/CreateSync"
 	// SyncServiceDeleteSyncProcedure is the fully-qualified name of the SyncService's DeleteSync RPC.
 	SyncServiceDeleteSyncProcedure = "/myncer.SyncService/DeleteSync"
+	// SyncServiceUpdateSyncProcedure is the fully-qualified name of the SyncService's UpdateSync RPC.
+	SyncServiceUpdateSyncProcedure = "/myncer.SyncService/UpdateSync"
+	// SyncServicePauseSyncProcedure is the fully-qualified name of the SyncService's PauseSync RPC.
+	SyncServicePauseSyncProcedure = "/myncer.SyncService/PauseSync"
+	// SyncServiceResumeSyncProcedure is the fully-qualified name of the SyncService's ResumeSync RPC.
+	SyncServiceResumeSyncProcedure = "/myncer.SyncService/ResumeSync"
 	// SyncServiceListSyncsProcedure is the fully-qualified name of the SyncService's ListSyncs RPC.
 	SyncServiceListSyncsProcedure = "/myncer.SyncService/ListSyncs"
 	// SyncServiceGetSyncProcedure is the fully-qualified name of the SyncService's GetSync RPC.
@@ -46,16 +52,87 @@ const (
 	// SyncServiceListSyncRunsProcedure is the fully-qualified name of the SyncService's ListSyncRuns
 	// RPC.
 	SyncServiceListSyncRunsProcedure = "/myncer.SyncService/ListSyncRuns"
+	// SyncServiceCancelSyncRunProcedure is the fully-qualified name of the SyncService's CancelSyncRun
+	// RPC.
+	SyncServiceCancelSyncRunProcedure = "/myncer.SyncService/CancelSyncRun"
+	// SyncServiceWatchSyncRunProcedure is the fully-qualified name of the SyncService's WatchSyncRun
+	// RPC.
+	SyncServiceWatchSyncRunProcedure = "/myncer.SyncService/WatchSyncRun"
+	// SyncServiceListPlaylistSnapshotsProcedure is the fully-qualified name of the SyncService's
+	// ListPlaylistSnapshots RPC.
+	SyncServiceListPlaylistSnapshotsProcedure = "/myncer.SyncService/ListPlaylistSnapshots"
+	// SyncServiceDiffPlaylistSnapshotsProcedure is the fully-qualified name of the SyncService's
+	// DiffPlaylistSnapshots RPC.
+	SyncServiceDiffPlaylistSnapshotsProcedure = "/myncer.SyncService/DiffPlaylistSnapshots"
+	// SyncServiceRestorePlaylistSnapshotProcedure is the fully-qualified name of the SyncService's
+	// RestorePlaylistSnapshot RPC.
+	SyncServiceRestorePlaylistSnapshotProcedure = "/myncer.SyncService/RestorePlaylistSnapshot"
+	// SyncServiceGetSyncGraphProcedure is the fully-qualified name of the SyncService's GetSyncGraph
+	// RPC.
+	SyncServiceGetSyncGraphProcedure = "/myncer.SyncService/GetSyncGraph"
+	// SyncServiceTransferLibraryProcedure is the fully-qualified name of the SyncService's
+	// TransferLibrary RPC.
+	SyncServiceTransferLibraryProcedure = "/myncer.SyncService/TransferLibrary"
+	// SyncServiceGetLibraryTransferProcedure is the fully-qualified name of the SyncService's
+	// GetLibraryTransfer RPC.
+	SyncServiceGetLibraryTransferProcedure = "/myncer.SyncService/GetLibraryTransfer"
+	// SyncServiceListLibraryTransfersProcedure is the fully-qualified name of the SyncService's
+	// ListLibraryTransfers RPC.
+	SyncServiceListLibraryTransfersProcedure = "/myncer.SyncService/ListLibraryTransfers"
+	// SyncServiceClearSongResolutionsProcedure is the fully-qualified name of the SyncService's
+	// ClearSongResolutions RPC.
+	SyncServiceClearSongResolutionsProcedure = "/myncer.SyncService/ClearSongResolutions"
+	// SyncServiceSearchSongsProcedure is the fully-qualified name of the SyncService's SearchSongs RPC.
+	SyncServiceSearchSongsProcedure = "/myncer.SyncService/SearchSongs"
+	// SyncServiceSetSongOverrideProcedure is the fully-qualified name of the SyncService's
+	// SetSongOverride RPC.
+	SyncServiceSetSongOverrideProcedure = "/myncer.SyncService/SetSongOverride"
+	// SyncServiceDeleteSongOverrideProcedure is the fully-qualified name of the SyncService's
+	// DeleteSongOverride RPC.
+	SyncServiceDeleteSongOverrideProcedure = "/myncer.SyncService/DeleteSongOverride"
+	// SyncServiceListSongOverridesProcedure is the fully-qualified name of the SyncService's
+	// ListSongOverrides RPC.
+	SyncServiceListSongOverridesProcedure = "/myncer.SyncService/ListSongOverrides"
 )
 
 // SyncServiceClient is a client for the myncer.SyncService service.
 type SyncServiceClient interface {
 	CreateSync(context.Context, *connect.Request[myncer.CreateSyncRequest]) (*connect.Response[myncer.CreateSyncResponse], error)
 	DeleteSync(context.Context, *connect.Request[myncer.DeleteSyncRequest]) (*connect.Response[myncer.DeleteSyncResponse], error)
+	// Replaces the settings of a sync, keeping its run history.
+	UpdateSync(context.Context, *connect.Request[myncer.UpdateSyncRequest]) (*connect.Response[myncer.UpdateSyncResponse], error)
+	// Stops the sync from running until it is resumed. Runs already in progress are not cancelled.
+	PauseSync(context.Context, *connect.Request[myncer.PauseSyncRequest]) (*connect.Response[myncer.PauseSyncResponse], error)
+	ResumeSync(context.Context, *connect.Request[myncer.ResumeSyncRequest]) (*connect.Response[myncer.ResumeSyncResponse], error)
 	ListSyncs(context.Context, *connect.Request[myncer.ListSyncsRequest]) (*connect.Response[myncer.ListSyncsResponse], error)
 	GetSync(context.Context, *connect.Request[myncer.GetSyncRequest]) (*connect.Response[myncer.GetSyncResponse], error)
 	RunSync(context.Context, *connect.Request[myncer.RunSyncRequest]) (*connect.Response[myncer.RunSyncResponse], error)
 	ListSyncRuns(context.Context, *connect.Request[myncer.ListSyncRunsRequest]) (*connect.Response[myncer.ListSyncRunsResponse], error)
+	CancelSyncRun(context.Context, *connect.Request[myncer.CancelSyncRunRequest]) (*connect.Response[myncer.CancelSyncRunResponse], error)
+	// Streams the progress of a sync run until it finishes.
+	WatchSyncRun(context.Context, *connect.Request[myncer.WatchSyncRunRequest]) (*connect.ServerStreamForClient[myncer.WatchSyncRunResponse], error)
+	// Snapshots are taken before a sync run removes songs from a playlist.
+	ListPlaylistSnapshots(context.Context, *connect.Request[myncer.ListPlaylistSnapshotsRequest]) (*connect.Response[myncer.ListPlaylistSnapshotsResponse], error)
+	DiffPlaylistSnapshots(context.Context, *connect.Request[myncer.DiffPlaylistSnapshotsRequest]) (*connect.Response[myncer.DiffPlaylistSnapshotsResponse], error)
+	// Puts the playlist back exactly as it was when the snapshot was taken.
+	RestorePlaylistSnapshot(context.Context, *connect.Request[myncer.RestorePlaylistSnapshotRequest]) (*connect.Response[myncer.RestorePlaylistSnapshotResponse], error)
+	// Shows how the user's syncs connect their playlists.
+	GetSyncGraph(context.Context, *connect.Request[myncer.GetSyncGraphRequest]) (*connect.Response[myncer.GetSyncGraphResponse], error)
+	// Copies playlists of one datasource to another. A new playlist is created on the destination
+	// for each playlist, along with a one-way sync which is run right away.
+	TransferLibrary(context.Context, *connect.Request[myncer.TransferLibraryRequest]) (*connect.Response[myncer.TransferLibraryResponse], error)
+	// Returns the transfer with the progress of its runs so far.
+	GetLibraryTransfer(context.Context, *connect.Request[myncer.GetLibraryTransferRequest]) (*connect.Response[myncer.GetLibraryTransferResponse], error)
+	ListLibraryTransfers(context.Context, *connect.Request[myncer.ListLibraryTransfersRequest]) (*connect.Response[myncer.ListLibraryTransfersResponse], error)
+	// Forgets which songs the user's songs were resolved to, so that they are searched for again.
+	ClearSongResolutions(context.Context, *connect.Request[myncer.ClearSongResolutionsRequest]) (*connect.Response[myncer.ClearSongResolutionsResponse], error)
+	// Searches a datasource for a song, e.g. to find the song to pin another song to.
+	SearchSongs(context.Context, *connect.Request[myncer.SearchSongsRequest]) (*connect.Response[myncer.SearchSongsResponse], error)
+	// Pins a song to a song of another datasource, or marks it to never be synced to the datasource.
+	// Replaces the existing override of the song for the datasource, if any.
+	SetSongOverride(context.Context, *connect.Request[myncer.SetSongOverrideRequest]) (*connect.Response[myncer.SetSongOverrideResponse], error)
+	DeleteSongOverride(context.Context, *connect.Request[myncer.DeleteSongOverrideRequest]) (*connect.Response[myncer.DeleteSongOverrideResponse], error)
+	ListSongOverrides(context.Context, *connect.Request[myncer.ListSongOverridesRequest]) (*connect.Response[myncer.ListSongOverridesResponse], error)
 }
 
 // NewSyncServiceClient constructs a client for the myncer.SyncService service. By default, it uses
@@ -81,6 +158,24 @@ func NewSyncServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(syncServiceMethods.ByName("DeleteSync")),
 			connect.WithClientOptions(opts...),
 		),
+		updateSync: connect.NewClient[myncer.UpdateSyncRequest, myncer.UpdateSyncResponse](
+			httpClient,
+			baseURL+SyncServiceUpdateSyncProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("UpdateSync")),
+			connect.WithClientOptions(opts...),
+		),
+		pauseSync: connect.NewClient[myncer.PauseSyncRequest, myncer.PauseSyncResponse](
+			httpClient,
+			baseURL+SyncServicePauseSyncProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("PauseSync")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeSync: connect.NewClient[myncer.ResumeSyncRequest, myncer.ResumeSyncResponse](
+			httpClient,
+			baseURL+SyncServiceResumeSyncProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("ResumeSync")),
+			connect.WithClientOptions(opts...),
+		),
 		listSyncs: connect.NewClient[myncer.ListSyncsRequest, myncer.ListSyncsResponse](
 			httpClient,
 			baseURL+SyncServiceListSyncsProcedure,
@@ -105,17 +200,118 @@ func NewSyncServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(syncServiceMethods.ByName("ListSyncRuns")),
 			connect.WithClientOptions(opts...),
 		),
+		cancelSyncRun: connect.NewClient[myncer.CancelSyncRunRequest, myncer.CancelSyncRunResponse](
+			httpClient,
+			baseURL+SyncServiceCancelSyncRunProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("CancelSyncRun")),
+			connect.WithClientOptions(opts...),
+		),
+		watchSyncRun: connect.NewClient[myncer.WatchSyncRunRequest, myncer.WatchSyncRunResponse](
+			httpClient,
+			baseURL+SyncServiceWatchSyncRunProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("WatchSyncRun")),
+			connect.WithClientOptions(opts...),
+		),
+		listPlaylistSnapshots: connect.NewClient[myncer.ListPlaylistSnapshotsRequest, myncer.ListPlaylistSnapshotsResponse](
+			httpClient,
+			baseURL+SyncServiceListPlaylistSnapshotsProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("ListPlaylistSnapshots")),
+			connect.WithClientOptions(opts...),
+		),
+		diffPlaylistSnapshots: connect.NewClient[myncer.DiffPlaylistSnapshotsRequest, myncer.DiffPlaylistSnapshotsResponse](
+			httpClient,
+			baseURL+SyncServiceDiffPlaylistSnapshotsProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("DiffPlaylistSnapshots")),
+			connect.WithClientOptions(opts...),
+		),
+		restorePlaylistSnapshot: connect.NewClient[myncer.RestorePlaylistSnapshotRequest, myncer.RestorePlaylistSnapshotResponse](
+			httpClient,
+			baseURL+SyncServiceRestorePlaylistSnapshotProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("RestorePlaylistSnapshot")),
+			connect.WithClientOptions(opts...),
+		),
+		getSyncGraph: connect.NewClient[myncer.GetSyncGraphRequest, myncer.GetSyncGraphResponse](
+			httpClient,
+			baseURL+SyncServiceGetSyncGraphProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("GetSyncGraph")),
+			connect.WithClientOptions(opts...),
+		),
+		transferLibrary: connect.NewClient[myncer.TransferLibraryRequest, myncer.TransferLibraryResponse](
+			httpClient,
+			baseURL+SyncServiceTransferLibraryProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("TransferLibrary")),
+			connect.WithClientOptions(opts...),
+		),
+		getLibraryTransfer: connect.NewClient[myncer.GetLibraryTransferRequest, myncer.GetLibraryTransferResponse](
+			httpClient,
+			baseURL+SyncServiceGetLibraryTransferProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("GetLibraryTransfer")),
+			connect.WithClientOptions(opts...),
+		),
+		listLibraryTransfers: connect.NewClient[myncer.ListLibraryTransfersRequest, myncer.ListLibraryTransfersResponse](
+			httpClient,
+			baseURL+SyncServiceListLibraryTransfersProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("ListLibraryTransfers")),
+			connect.WithClientOptions(opts...),
+		),
+		clearSongResolutions: connect.NewClient[myncer.ClearSongResolutionsRequest, myncer.ClearSongResolutionsResponse](
+			httpClient,
+			baseURL+SyncServiceClearSongResolutionsProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("ClearSongResolutions")),
+			connect.WithClientOptions(opts...),
+		),
+		searchSongs: connect.NewClient[myncer.SearchSongsRequest, myncer.SearchSongsResponse](
+			httpClient,
+			baseURL+SyncServiceSearchSongsProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("SearchSongs")),
+			connect.WithClientOptions(opts...),
+		),
+		setSongOverride: connect.NewClient[myncer.SetSongOverrideRequest, myncer.SetSongOverrideResponse](
+			httpClient,
+			baseURL+SyncServiceSetSongOverrideProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("SetSongOverride")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSongOverride: connect.NewClient[myncer.DeleteSongOverrideRequest, myncer.DeleteSongOverrideResponse](
+			httpClient,
+			baseURL+SyncServiceDeleteSongOverrideProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("DeleteSongOverride")),
+			connect.WithClientOptions(opts...),
+		),
+		listSongOverrides: connect.NewClient[myncer.ListSongOverridesRequest, myncer.ListSongOverridesResponse](
+			httpClient,
+			baseURL+SyncServiceListSongOverridesProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("ListSongOverrides")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // syncServiceClient implements SyncServiceClient.
 type syncServiceClient struct {
-	createSync   *connect.Client[myncer.CreateSyncRequest, myncer.CreateSyncResponse]
-	deleteSync   *connect.Client[myncer.DeleteSyncRequest, myncer.DeleteSyncResponse]
-	listSyncs    *connect.Client[myncer.ListSyncsRequest, myncer.ListSyncsResponse]
-	getSync      *connect.Client[myncer.GetSyncRequest, myncer.GetSyncResponse]
-	runSync      *connect.Client[myncer.RunSyncRequest, myncer.RunSyncResponse]
-	listSyncRuns *connect.Client[myncer.ListSyncRunsRequest, myncer.ListSyncRunsResponse]
+	createSync              *connect.Client[myncer.CreateSyncRequest, myncer.CreateSyncResponse]
+	deleteSync              *connect.Client[myncer.DeleteSyncRequest, myncer.DeleteSyncResponse]
+	updateSync              *connect.Client[myncer.UpdateSyncRequest, myncer.UpdateSyncResponse]
+	pauseSync               *connect.Client[myncer.PauseSyncRequest, myncer.PauseSyncResponse]
+	resumeSync              *connect.Client[myncer.ResumeSyncRequest, myncer.ResumeSyncResponse]
+	listSyncs               *connect.Client[myncer.ListSyncsRequest, myncer.ListSyncsResponse]
+	getSync                 *connect.Client[myncer.GetSyncRequest, myncer.GetSyncResponse]
+	runSync                 *connect.Client[myncer.RunSyncRequest, myncer.RunSyncResponse]
+	listSyncRuns            *connect.Client[myncer.ListSyncRunsRequest, myncer.ListSyncRunsResponse]
+	cancelSyncRun           *connect.Client[myncer.CancelSyncRunRequest, myncer.CancelSyncRunResponse]
+	watchSyncRun            *connect.Client[myncer.WatchSyncRunRequest, myncer.WatchSyncRunResponse]
+	listPlaylistSnapshots   *connect.Client[myncer.ListPlaylistSnapshotsRequest, myncer.ListPlaylistSnapshotsResponse]
+	diffPlaylistSnapshots   *connect.Client[myncer.DiffPlaylistSnapshotsRequest, myncer.DiffPlaylistSnapshotsResponse]
+	restorePlaylistSnapshot *connect.Client[myncer.RestorePlaylistSnapshotRequest, myncer.RestorePlaylistSnapshotResponse]
+	getSyncGraph            *connect.Client[myncer.GetSyncGraphRequest, myncer.GetSyncGraphResponse]
+	transferLibrary         *connect.Client[myncer.TransferLibraryRequest, myncer.TransferLibraryResponse]
+	getLibraryTransfer      *connect.Client[myncer.GetLibraryTransferRequest, myncer.GetLibraryTransferResponse]
+	listLibraryTransfers    *connect.Client[myncer.ListLibraryTransfersRequest, myncer.ListLibraryTransfersResponse]
+	clearSongResolutions    *connect.Client[myncer.ClearSongResolutionsRequest, myncer.ClearSongResolutionsResponse]
+	searchSongs             *connect.Client[myncer.SearchSongsRequest, myncer.SearchSongsResponse]
+	setSongOverride         *connect.Client[myncer.SetSongOverrideRequest, myncer.SetSongOverrideResponse]
+	deleteSongOverride      *connect.Client[myncer.DeleteSongOverrideRequest, myncer.DeleteSongOverrideResponse]
+	listSongOverrides       *connect.Client[myncer.ListSongOverridesRequest, myncer.ListSongOverridesResponse]
 }
 
 // CreateSync calls myncer.SyncService.CreateSync.
@@ -128,6 +324,21 @@ func (c *syncServiceClient) DeleteSync(ctx context.Context, req *connect.Request
 	return c.deleteSync.CallUnary(ctx, req)
 }
 
+// UpdateSync calls myncer.SyncService.UpdateSync.
+func (c *syncServiceClient) UpdateSync(ctx context.Context, req *connect.Request[myncer.UpdateSyncRequest]) (*connect.Response[myncer.UpdateSyncResponse], error) {
+	return c.updateSync.CallUnary(ctx, req)
+}
+
+// PauseSync calls myncer.SyncService.PauseSync.
+func (c *syncServiceClient) PauseSync(ctx context.Context, req *connect.Request[myncer.PauseSyncRequest]) (*connect.Response[myncer.PauseSyncResponse], error) {
+	return c.pauseSync.CallUnary(ctx, req)
+}
+
+// ResumeSync calls myncer.SyncService.ResumeSync.
+func (c *syncServiceClient) ResumeSync(ctx context.Context, req *connect.Request[myncer.ResumeSyncRequest]) (*connect.Response[myncer.ResumeSyncResponse], error) {
+	return c.resumeSync.CallUnary(ctx, req)
+}
+
 // ListSyncs calls myncer.SyncService.ListSyncs.
 func (c *syncServiceClient) ListSyncs(ctx context.Context, req *connect.Request[myncer.ListSyncsRequest]) (*connect.Response[myncer.ListSyncsResponse], error) {
 	return c.listSyncs.CallUnary(ctx, req)
@@ -148,14 +359,114 @@ func (c *syncServiceClient) ListSyncRuns(ctx context.Context, req *connect.Reque
 	return c.listSyncRuns.CallUnary(ctx, req)
 }
 
+// CancelSyncRun calls myncer.SyncService.CancelSyncRun.
+func (c *syncServiceClient) CancelSyncRun(ctx context.Context, req *connect.Request[myncer.CancelSyncRunRequest]) (*connect.Response[myncer.CancelSyncRunResponse], error) {
+	return c.cancelSyncRun.CallUnary(ctx, req)
+}
+
+// WatchSyncRun calls myncer.SyncService.WatchSyncRun.
+func (c *syncServiceClient) WatchSyncRun(ctx context.Context, req *connect.Request[myncer.WatchSyncRunRequest]) (*connect.ServerStreamForClient[myncer.WatchSyncRunResponse], error) {
+	return c.watchSyncRun.CallServerStream(ctx, req)
+}
+
+// ListPlaylistSnapshots calls myncer.SyncService.ListPlaylistSnapshots.
+func (c *syncServiceClient) ListPlaylistSnapshots(ctx context.Context, req *connect.Request[myncer.ListPlaylistSnapshotsRequest]) (*connect.Response[myncer.ListPlaylistSnapshotsResponse], error) {
+	return c.listPlaylistSnapshots.CallUnary(ctx, req)
+}
+
+// DiffPlaylistSnapshots calls myncer.SyncService.DiffPlaylistSnapshots.
+func (c *syncServiceClient) DiffPlaylistSnapshots(ctx context.Context, req *connect.Request[myncer.DiffPlaylistSnapshotsRequest]) (*connect.Response[myncer.DiffPlaylistSnapshotsResponse], error) {
+	return c.diffPlaylistSnapshots.CallUnary(ctx, req)
+}
+
+// RestorePlaylistSnapshot calls myncer.SyncService.RestorePlaylistSnapshot.
+func (c *syncServiceClient) RestorePlaylistSnapshot(ctx context.Context, req *connect.Request[myncer.RestorePlaylistSnapshotRequest]) (*connect.Response[myncer.RestorePlaylistSnapshotResponse], error) {
+	return c.restorePlaylistSnapshot.CallUnary(ctx, req)
+}
+
+// GetSyncGraph calls myncer.SyncService.GetSyncGraph.
+func (c *syncServiceClient) GetSyncGraph(ctx context.Context, req *connect.Request[myncer.GetSyncGraphRequest]) (*connect.Response[myncer.GetSyncGraphResponse], error) {
+	return c.getSyncGraph.CallUnary(ctx, req)
+}
+
+// TransferLibrary calls myncer.SyncService.TransferLibrary.
+func (c *syncServiceClient) TransferLibrary(ctx context.Context, req *connect.Request[myncer.TransferLibraryRequest]) (*connect.Response[myncer.TransferLibraryResponse], error) {
+	return c.transferLibrary.CallUnary(ctx, req)
+}
+
+// GetLibraryTransfer calls myncer.SyncService.GetLibraryTransfer.
+func (c *syncServiceClient) GetLibraryTransfer(ctx context.Context, req *connect.Request[myncer.GetLibraryTransferRequest]) (*connect.Response[myncer.GetLibraryTransferResponse], error) {
+	return c.getLibraryTransfer.CallUnary(ctx, req)
+}
+
+// ListLibraryTransfers calls myncer.SyncService.ListLibraryTransfers.
+func (c *syncServiceClient) ListLibraryTransfers(ctx context.Context, req *connect.Request[myncer.ListLibraryTransfersRequest]) (*connect.Response[myncer.ListLibraryTransfersResponse], error) {
+	return c.listLibraryTransfers.CallUnary(ctx, req)
+}
+
+// ClearSongResolutions calls myncer.SyncService.ClearSongResolutions.
+func (c *syncServiceClient) ClearSongResolutions(ctx context.Context, req *connect.Request[myncer.ClearSongResolutionsRequest]) (*connect.Response[myncer.ClearSongResolutionsResponse], error) {
+	return c.clearSongResolutions.CallUnary(ctx, req)
+}
+
+// SearchSongs calls myncer.SyncService.SearchSongs.
+func (c *syncServiceClient) SearchSongs(ctx context.Context, req *connect.Request[myncer.SearchSongsRequest]) (*connect.Response[myncer.SearchSongsResponse], error) {
+	return c.searchSongs.CallUnary(ctx, req)
+}
+
+// SetSongOverride calls myncer.SyncService.SetSongOverride.
+func (c *syncServiceClient) SetSongOverride(ctx context.Context, req *connect.Request[myncer.SetSongOverrideRequest]) (*connect.Response[myncer.SetSongOverrideResponse], error) {
+	return c.setSongOverride.CallUnary(ctx, req)
+}
+
+// DeleteSongOverride calls myncer.SyncService.DeleteSongOverride.
+func (c *syncServiceClient) DeleteSongOverride(ctx context.Context, req *connect.Request[myncer.DeleteSongOverrideRequest]) (*connect.Response[myncer.DeleteSongOverrideResponse], error) {
+	return c.deleteSongOverride.CallUnary(ctx, req)
+}
+
+// ListSongOverrides calls myncer.SyncService.ListSongOverrides.
+func (c *syncServiceClient) ListSongOverrides(ctx context.Context, req *connect.Request[myncer.ListSongOverridesRequest]) (*connect.Response[myncer.ListSongOverridesResponse], error) {
+	return c.listSongOverrides.CallUnary(ctx, req)
+}
+
 // SyncServiceHandler is an implementation of the myncer.SyncService service.
 type SyncServiceHandler interface {
 	CreateSync(context.Context, *connect.Request[myncer.CreateSyncRequest]) (*connect.Response[myncer.CreateSyncResponse], error)
 	DeleteSync(context.Context, *connect.Request[myncer.DeleteSyncRequest]) (*connect.Response[myncer.DeleteSyncResponse], error)
+	// Replaces the settings of a sync, keeping its run history.
+	UpdateSync(context.Context, *connect.Request[myncer.UpdateSyncRequest]) (*connect.Response[myncer.UpdateSyncResponse], error)
+	// Stops the sync from running until it is resumed. Runs already in progress are not cancelled.
+	PauseSync(context.Context, *connect.Request[myncer.PauseSyncRequest]) (*connect.Response[myncer.PauseSyncResponse], error)
+	ResumeSync(context.Context, *connect.Request[myncer.ResumeSyncRequest]) (*connect.Response[myncer.ResumeSyncResponse], error)
 	ListSyncs(context.Context, *connect.Request[myncer.ListSyncsRequest]) (*connect.Response[myncer.ListSyncsResponse], error)
 	GetSync(context.Context, *connect.Request[myncer.GetSyncRequest]) (*connect.Response[myncer.GetSyncResponse], error)
 	RunSync(context.Context, *connect.Request[myncer.RunSyncRequest]) (*connect.Response[myncer.RunSyncResponse], error)
 	ListSyncRuns(context.Context, *connect.Request[myncer.ListSyncRunsRequest]) (*connect.Response[myncer.ListSyncRunsResponse], error)
+	CancelSyncRun(context.Context, *connect.Request[myncer.CancelSyncRunRequest]) (*connect.Response[myncer.CancelSyncRunResponse], error)
+	// Streams the progress of a sync run until it finishes.
+	WatchSyncRun(context.Context, *connect.Request[myncer.WatchSyncRunRequest], *connect.ServerStream[myncer.WatchSyncRunResponse]) error
+	// Snapshots are taken before a sync run removes songs from a playlist.
+	ListPlaylistSnapshots(context.Context, *connect.Request[myncer.ListPlaylistSnapshotsRequest]) (*connect.Response[myncer.ListPlaylistSnapshotsResponse], error)
+	DiffPlaylistSnapshots(context.Context, *connect.Request[myncer.DiffPlaylistSnapshotsRequest]) (*connect.Response[myncer.DiffPlaylistSnapshotsResponse], error)
+	// Puts the playlist back exactly as it was when the snapshot was taken.
+	RestorePlaylistSnapshot(context.Context, *connect.Request[myncer.RestorePlaylistSnapshotRequest]) (*connect.Response[myncer.RestorePlaylistSnapshotResponse], error)
+	// Shows how the user's syncs connect their playlists.
+	GetSyncGraph(context.Context, *connect.Request[myncer.GetSyncGraphRequest]) (*connect.Response[myncer.GetSyncGraphResponse], error)
+	// Copies playlists of one datasource to another. A new playlist is created on the destination
+	// for each playlist, along with a one-way sync which is run right away.
+	TransferLibrary(context.Context, *connect.Request[myncer.TransferLibraryRequest]) (*connect.Response[myncer.TransferLibraryResponse], error)
+	// Returns the transfer with the progress of its runs so far.
+	GetLibraryTransfer(context.Context, *connect.Request[myncer.GetLibraryTransferRequest]) (*connect.Response[myncer.GetLibraryTransferResponse], error)
+	ListLibraryTransfers(context.Context, *connect.Request[myncer.ListLibraryTransfersRequest]) (*connect.Response[myncer.ListLibraryTransfersResponse], error)
+	// Forgets which songs the user's songs were resolved to, so that they are searched for again.
+	ClearSongResolutions(context.Context, *connect.Request[myncer.ClearSongResolutionsRequest]) (*connect.Response[myncer.ClearSongResolutionsResponse], error)
+	// Searches a datasource for a song, e.g. to find the song to pin another song to.
+	SearchSongs(context.Context, *connect.Request[myncer.SearchSongsRequest]) (*connect.Response[myncer.SearchSongsResponse], error)
+	// Pins a song to a song of another datasource, or marks it to never be synced to the datasource.
+	// Replaces the existing override of the song for the datasource, if any.
+	SetSongOverride(context.Context, *connect.Request[myncer.SetSongOverrideRequest]) (*connect.Response[myncer.SetSongOverrideResponse], error)
+	DeleteSongOverride(context.Context, *connect.Request[myncer.DeleteSongOverrideRequest]) (*connect.Response[myncer.DeleteSongOverrideResponse], error)
+	ListSongOverrides(context.Context, *connect.Request[myncer.ListSongOverridesRequest]) (*connect.Response[myncer.ListSongOverridesResponse], error)
 }
 
 // NewSyncServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -177,6 +488,24 @@ func NewSyncServiceHandler(svc SyncServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(syncServiceMethods.ByName("DeleteSync")),
 		connect.WithHandlerOptions(opts...),
 	)
+	syncServiceUpdateSyncHandler := connect.NewUnaryHandler(
+		SyncServiceUpdateSyncProcedure,
+		svc.UpdateSync,
+		connect.WithSchema(syncServiceMethods.ByName("UpdateSync")),
+		connect.WithHandlerOptions(opts...),
+	)
+	syncServicePauseSyncHandler := connect.NewUnaryHandler(
+		SyncServicePauseSyncProcedure,
+		svc.PauseSync,
+		connect.WithSchema(syncServiceMethods.ByName("PauseSync")),
+		connect.WithHandlerOptions(opts...),
+	)
+	syncServiceResumeSyncHandler := connect.NewUnaryHandler(
+		SyncServiceResumeSyncProcedure,
+		svc.ResumeSync,
+		connect.WithSchema(syncServiceMethods.ByName("ResumeSync")),
+		connect.WithHandlerOptions(opts...),
+	)
 	syncServiceListSyncsHandler := connect.NewUnaryHandler(
 		SyncServiceListSyncsProcedure,
 		svc.ListSyncs,
@@ -201,12 +530,102 @@ func NewSyncServiceHandler(svc SyncServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(syncServiceMethods.ByName("ListSyncRuns")),
 		connect.WithHandlerOptions(opts...),
 	)
+	syncServiceCancelSyncRunHandler := connect.NewUnaryHandler(
+		SyncServiceCancelSyncRunProcedure,
+		svc.CancelSyncRun,
+		connect.WithSchema(syncServiceMethods.ByName("CancelSyncRun")),
+		connect.WithHandlerOptions(opts...),
+	)
+	syncServiceWatchSyncRunHandler := connect.NewServerStreamHandler(
+		SyncServiceWatchSyncRunProcedure,
+		svc.WatchSyncRun,
+		connect.WithSchema(syncServiceMethods.ByName("WatchSyncRun")),
+		connect.WithHandlerOptions(opts...),
+	)
+	syncServiceListPlaylistSnapshotsHandler := connect.NewUnaryHandler(
+		SyncServiceListPlaylistSnapshotsProcedure,
+		svc.ListPlaylistSnapshots,
+		connect.WithSchema(syncServiceMethods.ByName("ListPlaylistSnapshots")),
+		connect.WithHandlerOptions(opts...),
+	)
+	syncServiceDiffPlaylistSnapshotsHandler := connect.NewUnaryHandler(
+		SyncServiceDiffPlaylistSnapshotsProcedure,
+		svc.DiffPlaylistSnapshots,
+		connect.WithSchema(syncServiceMethods.ByName("DiffPlaylistSnapshots")),
+		connect.WithHandlerOptions(opts...),
+	)
+	syncServiceRestorePlaylistSnapshotHandler := connect.NewUnaryHandler(
+		SyncServiceRestorePlaylistSnapshotProcedure,
+		svc.RestorePlaylistSnapshot,
+		connect.WithSchema(syncServiceMethods.ByName("RestorePlaylistSnapshot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	syncServiceGetSyncGraphHandler := connect.NewUnaryHandler(
+		SyncServiceGetSyncGraphProcedure,
+		svc.GetSyncGraph,
+		connect.WithSchema(syncServiceMethods.ByName("GetSyncGraph")),
+		connect.WithHandlerOptions(opts...),
+	)
+	syncServiceTransferLibraryHandler := connect.NewUnaryHandler(
+		SyncServiceTransferLibraryProcedure,
+		svc.TransferLibrary,
+		connect.WithSchema(syncServiceMethods.ByName("TransferLibrary")),
+		connect.WithHandlerOptions(opts...),
+	)
+	syncServiceGetLibraryTransferHandler := connect.NewUnaryHandler(
+		SyncServiceGetLibraryTransferProcedure,
+		svc.GetLibraryTransfer,
+		connect.WithSchema(syncServiceMethods.ByName("GetLibraryTransfer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	syncServiceListLibraryTransfersHandler := connect.NewUnaryHandler(
+		SyncServiceListLibraryTransfersProcedure,
+		svc.ListLibraryTransfers,
+		connect.WithSchema(syncServiceMethods.ByName("ListLibraryTransfers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	syncServiceClearSongResolutionsHandler := connect.NewUnaryHandler(
+		SyncServiceClearSongResolutionsProcedure,
+		svc.ClearSongResolutions,
+		connect.WithSchema(syncServiceMethods.ByName("ClearSongResolutions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	syncServiceSearchSongsHandler := connect.NewUnaryHandler(
+		SyncServiceSearchSongsProcedure,
+		svc.SearchSongs,
+		connect.WithSchema(syncServiceMethods.ByName("SearchSongs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	syncServiceSetSongOverrideHandler := connect.NewUnaryHandler(
+		SyncServiceSetSongOverrideProcedure,
+		svc.SetSongOverride,
+		connect.WithSchema(syncServiceMethods.ByName("SetSongOverride")),
+		connect.WithHandlerOptions(opts...),
+	)
+	syncServiceDeleteSongOverrideHandler := connect.NewUnaryHandler(
+		SyncServiceDeleteSongOverrideProcedure,
+		svc.DeleteSongOverride,
+		connect.WithSchema(syncServiceMethods.ByName("DeleteSongOverride")),
+		connect.WithHandlerOptions(opts...),
+	)
+	syncServiceListSongOverridesHandler := connect.NewUnaryHandler(
+		SyncServiceListSongOverridesProcedure,
+		svc.ListSongOverrides,
+		connect.WithSchema(syncServiceMethods.ByName("ListSongOverrides")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/myncer.SyncService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SyncServiceCreateSyncProcedure:
 			syncServiceCreateSyncHandler.ServeHTTP(w, r)
 		case SyncServiceDeleteSyncProcedure:
 			syncServiceDeleteSyncHandler.ServeHTTP(w, r)
+		case SyncServiceUpdateSyncProcedure:
+			syncServiceUpdateSyncHandler.ServeHTTP(w, r)
+		case SyncServicePauseSyncProcedure:
+			syncServicePauseSyncHandler.ServeHTTP(w, r)
+		case SyncServiceResumeSyncProcedure:
+			syncServiceResumeSyncHandler.ServeHTTP(w, r)
 		case SyncServiceListSyncsProcedure:
 			syncServiceListSyncsHandler.ServeHTTP(w, r)
 		case SyncServiceGetSyncProcedure:
@@ -215,6 +634,34 @@ func NewSyncServiceHandler(svc SyncServiceHandler, opts ...connect.HandlerOption
 			syncServiceRunSyncHandler.ServeHTTP(w, r)
 		case SyncServiceListSyncRunsProcedure:
 			syncServiceListSyncRunsHandler.ServeHTTP(w, r)
+		case SyncServiceCancelSyncRunProcedure:
+			syncServiceCancelSyncRunHandler.ServeHTTP(w, r)
+		case SyncServiceWatchSyncRunProcedure:
+			syncServiceWatchSyncRunHandler.ServeHTTP(w, r)
+		case SyncServiceListPlaylistSnapshotsProcedure:
+			syncServiceListPlaylistSnapshotsHandler.ServeHTTP(w, r)
+		case SyncServiceDiffPlaylistSnapshotsProcedure:
+			syncServiceDiffPlaylistSnapshotsHandler.ServeHTTP(w, r)
+		case SyncServiceRestorePlaylistSnapshotProcedure:
+			syncServiceRestorePlaylistSnapshotHandler.ServeHTTP(w, r)
+		case SyncServiceGetSyncGraphProcedure:
+			syncServiceGetSyncGraphHandler.ServeHTTP(w, r)
+		case SyncServiceTransferLibraryProcedure:
+			syncServiceTransferLibraryHandler.ServeHTTP(w, r)
+		case SyncServiceGetLibraryTransferProcedure:
+			syncServiceGetLibraryTransferHandler.ServeHTTP(w, r)
+		case SyncServiceListLibraryTransfersProcedure:
+			syncServiceListLibraryTransfersHandler.ServeHTTP(w, r)
+		case SyncServiceClearSongResolutionsProcedure:
+			syncServiceClearSongResolutionsHandler.ServeHTTP(w, r)
+		case SyncServiceSearchSongsProcedure:
+			syncServiceSearchSongsHandler.ServeHTTP(w, r)
+		case SyncServiceSetSongOverrideProcedure:
+			syncServiceSetSongOverrideHandler.ServeHTTP(w, r)
+		case SyncServiceDeleteSongOverrideProcedure:
+			syncServiceDeleteSongOverrideHandler.ServeHTTP(w, r)
+		case SyncServiceListSongOverridesProcedure:
+			syncServiceListSongOverridesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -232,6 +679,18 @@ func (UnimplementedSyncServiceHandler) DeleteSync(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.DeleteSync is not implemented"))
 }
 
+func (UnimplementedSyncServiceHandler) UpdateSync(context.Context, *connect.Request[myncer.UpdateSyncRequest]) (*connect.Response[myncer.UpdateSyncResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.UpdateSync is not implemented"))
+}
+
+func (UnimplementedSyncServiceHandler) PauseSync(context.Context, *connect.Request[myncer.PauseSyncRequest]) (*connect.Response[myncer.PauseSyncResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.PauseSync is not implemented"))
+}
+
+func (UnimplementedSyncServiceHandler) ResumeSync(context.Context, *connect.Request[myncer.ResumeSyncRequest]) (*connect.Response[myncer.ResumeSyncResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.ResumeSync is not implemented"))
+}
+
 func (UnimplementedSyncServiceHandler) ListSyncs(context.Context, *connect.Request[myncer.ListSyncsRequest]) (*connect.Response[myncer.ListSyncsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.ListSyncs is not implemented"))
 }
@@ -247,3 +706,59 @@ func (UnimplementedSyncServiceHandler) RunSync(context.Context, *connect.Request
 func (UnimplementedSyncServiceHandler) ListSyncRuns(context.Context, *connect.Request[myncer.ListSyncRunsRequest]) (*connect.Response[myncer.ListSyncRunsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.ListSyncRuns is not implemented"))
 }
+
+func (UnimplementedSyncServiceHandler) CancelSyncRun(context.Context, *connect.Request[myncer.CancelSyncRunRequest]) (*connect.Response[myncer.CancelSyncRunResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.CancelSyncRun is not implemented"))
+}
+
+func (UnimplementedSyncServiceHandler) WatchSyncRun(context.Context, *connect.Request[myncer.WatchSyncRunRequest], *connect.ServerStream[myncer.WatchSyncRunResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.WatchSyncRun is not implemented"))
+}
+
+func (UnimplementedSyncServiceHandler) ListPlaylistSnapshots(context.Context, *connect.Request[myncer.ListPlaylistSnapshotsRequest]) (*connect.Response[myncer.ListPlaylistSnapshotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.ListPlaylistSnapshots is not implemented"))
+}
+
+func (UnimplementedSyncServiceHandler) DiffPlaylistSnapshots(context.Context, *connect.Request[myncer.DiffPlaylistSnapshotsRequest]) (*connect.Response[myncer.DiffPlaylistSnapshotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.DiffPlaylistSnapshots is not implemented"))
+}
+
+func (UnimplementedSyncServiceHandler) RestorePlaylistSnapshot(context.Context, *connect.Request[myncer.RestorePlaylistSnapshotRequest]) (*connect.Response[myncer.RestorePlaylistSnapshotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.RestorePlaylistSnapshot is not implemented"))
+}
+
+func (UnimplementedSyncServiceHandler) GetSyncGraph(context.Context, *connect.Request[myncer.GetSyncGraphRequest]) (*connect.Response[myncer.GetSyncGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.GetSyncGraph is not implemented"))
+}
+
+func (UnimplementedSyncServiceHandler) TransferLibrary(context.Context, *connect.Request[myncer.TransferLibraryRequest]) (*connect.Response[myncer.TransferLibraryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.TransferLibrary is not implemented"))
+}
+
+func (UnimplementedSyncServiceHandler) GetLibraryTransfer(context.Context, *connect.Request[myncer.GetLibraryTransferRequest]) (*connect.Response[myncer.GetLibraryTransferResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.GetLibraryTransfer is not implemented"))
+}
+
+func (UnimplementedSyncServiceHandler) ListLibraryTransfers(context.Context, *connect.Request[myncer.ListLibraryTransfersRequest]) (*connect.Response[myncer.ListLibraryTransfersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.ListLibraryTransfers is not implemented"))
+}
+
+func (UnimplementedSyncServiceHandler) ClearSongResolutions(context.Context, *connect.Request[myncer.ClearSongResolutionsRequest]) (*connect.Response[myncer.ClearSongResolutionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.ClearSongResolutions is not implemented"))
+}
+
+func (UnimplementedSyncServiceHandler) SearchSongs(context.Context, *connect.Request[myncer.SearchSongsRequest]) (*connect.Response[myncer.SearchSongsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.SearchSongs is not implemented"))
+}
+
+func (UnimplementedSyncServiceHandler) SetSongOverride(context.Context, *connect.Request[myncer.SetSongOverrideRequest]) (*connect.Response[myncer.SetSongOverrideResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.SetSongOverride is not implemented"))
+}
+
+func (UnimplementedSyncServiceHandler) DeleteSongOverride(context.Context, *connect.Request[myncer.DeleteSongOverrideRequest]) (*connect.Response[myncer.DeleteSongOverrideResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.DeleteSongOverride is not implemented"))
+}
+
+func (UnimplementedSyncServiceHandler) ListSongOverrides(context.Context, *connect.Request[myncer.ListSongOverridesRequest]) (*connect.Response[myncer.ListSongOverridesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myncer.SyncService.ListSongOverrides is not implemented"))
+}
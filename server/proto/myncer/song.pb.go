@@ -9,6 +9,7 @@ package myncer_pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +22,57 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SongOverrideKind int32
+
+const (
+	SongOverrideKind_SONG_OVERRIDE_KIND_UNSPECIFIED SongOverrideKind = 0
+	// The song is always synced as the destination song instead of searching for it.
+	SongOverrideKind_SONG_OVERRIDE_KIND_PIN SongOverrideKind = 1
+	// The song is never synced to the destination datasource.
+	SongOverrideKind_SONG_OVERRIDE_KIND_NEVER_SYNC SongOverrideKind = 2
+)
+
+// Enum value maps for SongOverrideKind.
+var (
+	SongOverrideKind_name = map[int32]string{
+		0: "SONG_OVERRIDE_KIND_UNSPECIFIED",
+		1: "SONG_OVERRIDE_KIND_PIN",
+		2: "SONG_OVERRIDE_KIND_NEVER_SYNC",
+	}
+	SongOverrideKind_value = map[string]int32{
+		"SONG_OVERRIDE_KIND_UNSPECIFIED": 0,
+		"SONG_OVERRIDE_KIND_PIN":         1,
+		"SONG_OVERRIDE_KIND_NEVER_SYNC":  2,
+	}
+)
+
+func (x SongOverrideKind) Enum() *SongOverrideKind {
+	p := new(SongOverrideKind)
+	*p = x
+	return p
+}
+
+func (x SongOverrideKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SongOverrideKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_myncer_song_proto_enumTypes[0].Descriptor()
+}
+
+func (SongOverrideKind) Type() protoreflect.EnumType {
+	return &file_myncer_song_proto_enumTypes[0]
+}
+
+func (x SongOverrideKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SongOverrideKind.Descriptor instead.
+func (SongOverrideKind) EnumDescriptor() ([]byte, []int) {
+	return file_myncer_song_proto_rawDescGZIP(), []int{0}
+}
+
 type Song struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The deterministic, reproducible ID of a bunch of details about the song.
@@ -32,9 +84,16 @@ type Song struct {
 	Datasource Datasource `protobuf:"varint,4,opt,name=datasource,proto3,enum=myncer.Datasource" json:"datasource,omitempty"`
 	// Unique, stable song identifier for the datasource.
 	DatasourceSongId string `protobuf:"bytes,5,opt,name=datasource_song_id,json=datasourceSongId,proto3" json:"datasource_song_id,omitempty"`
-	Isrc             string `protobuf:"bytes,7,opt,name=isrc,proto3" json:"isrc,omitempty"` // next: 8
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	Isrc             string `protobuf:"bytes,7,opt,name=isrc,proto3" json:"isrc,omitempty"`
+	// When the song was added to the playlist it was fetched from.
+	// Unset for songs that weren't fetched from a playlist or if the datasource doesn't say.
+	AddedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	// Whether the datasource marks the song as explicit.
+	Explicit bool `protobuf:"varint,9,opt,name=explicit,proto3" json:"explicit,omitempty"`
+	// Length of the song. Zero if the datasource doesn't say.
+	DurationMs    int64 `protobuf:"varint,10,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // next: 11
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Song) Reset() {
@@ -116,11 +175,271 @@ func (x *Song) GetIsrc() string {
 	return ""
 }
 
+func (x *Song) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+func (x *Song) GetExplicit() bool {
+	if x != nil {
+		return x.Explicit
+	}
+	return false
+}
+
+func (x *Song) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+// A song of one datasource resolved to a song of another datasource by searching for it.
+// Resolutions are cached per user so that repeat runs don't search for the same songs again.
+type SongResolution struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Only the datasource and datasource song id are set.
+	SourceSong      *Song `protobuf:"bytes,2,opt,name=source_song,json=sourceSong,proto3" json:"source_song,omitempty"`
+	DestinationSong *Song `protobuf:"bytes,3,opt,name=destination_song,json=destinationSong,proto3" json:"destination_song,omitempty"`
+	// How similar the destination song is to the source song, from 0 to 100.
+	Score      float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	ResolvedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	// Other songs the search found, best first.
+	RunnerUps     []*SongMatchCandidate `protobuf:"bytes,6,rep,name=runner_ups,json=runnerUps,proto3" json:"runner_ups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SongResolution) Reset() {
+	*x = SongResolution{}
+	mi := &file_myncer_song_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SongResolution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongResolution) ProtoMessage() {}
+
+func (x *SongResolution) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_song_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongResolution.ProtoReflect.Descriptor instead.
+func (*SongResolution) Descriptor() ([]byte, []int) {
+	return file_myncer_song_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SongResolution) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SongResolution) GetSourceSong() *Song {
+	if x != nil {
+		return x.SourceSong
+	}
+	return nil
+}
+
+func (x *SongResolution) GetDestinationSong() *Song {
+	if x != nil {
+		return x.DestinationSong
+	}
+	return nil
+}
+
+func (x *SongResolution) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SongResolution) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+func (x *SongResolution) GetRunnerUps() []*SongMatchCandidate {
+	if x != nil {
+		return x.RunnerUps
+	}
+	return nil
+}
+
+// A song found by searching a datasource.
+type SongMatchCandidate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Song  *Song                  `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+	// How similar the song is to the song searched for, from 0 to 100.
+	Score         float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SongMatchCandidate) Reset() {
+	*x = SongMatchCandidate{}
+	mi := &file_myncer_song_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SongMatchCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongMatchCandidate) ProtoMessage() {}
+
+func (x *SongMatchCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_song_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongMatchCandidate.ProtoReflect.Descriptor instead.
+func (*SongMatchCandidate) Descriptor() ([]byte, []int) {
+	return file_myncer_song_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SongMatchCandidate) GetSong() *Song {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+func (x *SongMatchCandidate) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// Overrides how a song of one datasource is synced to another datasource.
+// Overrides are set by the user and take precedence over searching for the song.
+type SongOverride struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Only the datasource and datasource song id identify the song, the rest is kept for display.
+	SourceSong            *Song            `protobuf:"bytes,2,opt,name=source_song,json=sourceSong,proto3" json:"source_song,omitempty"`
+	DestinationDatasource Datasource       `protobuf:"varint,3,opt,name=destination_datasource,json=destinationDatasource,proto3,enum=myncer.Datasource" json:"destination_datasource,omitempty"`
+	Kind                  SongOverrideKind `protobuf:"varint,4,opt,name=kind,proto3,enum=myncer.SongOverrideKind" json:"kind,omitempty"`
+	// Only set for pins.
+	DestinationSong *Song                  `protobuf:"bytes,5,opt,name=destination_song,json=destinationSong,proto3" json:"destination_song,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SongOverride) Reset() {
+	*x = SongOverride{}
+	mi := &file_myncer_song_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SongOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongOverride) ProtoMessage() {}
+
+func (x *SongOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_song_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongOverride.ProtoReflect.Descriptor instead.
+func (*SongOverride) Descriptor() ([]byte, []int) {
+	return file_myncer_song_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SongOverride) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SongOverride) GetSourceSong() *Song {
+	if x != nil {
+		return x.SourceSong
+	}
+	return nil
+}
+
+func (x *SongOverride) GetDestinationDatasource() Datasource {
+	if x != nil {
+		return x.DestinationDatasource
+	}
+	return Datasource_DATASOURCE_UNSPECIFIED
+}
+
+func (x *SongOverride) GetKind() SongOverrideKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SongOverrideKind_SONG_OVERRIDE_KIND_UNSPECIFIED
+}
+
+func (x *SongOverride) GetDestinationSong() *Song {
+	if x != nil {
+		return x.DestinationSong
+	}
+	return nil
+}
+
+func (x *SongOverride) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SongOverride) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 var File_myncer_song_proto protoreflect.FileDescriptor
 
 const file_myncer_song_proto_rawDesc = "" +
 	"\n" +
-	"\x11myncer/song.proto\x12\x06myncer\x1a\x17myncer/datasource.proto\"\xe0\x01\n" +
+	"\x11myncer/song.proto\x12\x06myncer\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17myncer/datasource.proto\"\xd4\x02\n" +
 	"\x04Song\x12\x0e\n" +
 	"\x02id\x18\x06 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
@@ -132,7 +451,40 @@ const file_myncer_song_proto_rawDesc = "" +
 	"datasource\x18\x04 \x01(\x0e2\x12.myncer.DatasourceR\n" +
 	"datasource\x12,\n" +
 	"\x12datasource_song_id\x18\x05 \x01(\tR\x10datasourceSongId\x12\x12\n" +
-	"\x04isrc\x18\a \x01(\tR\x04isrcB3Z1github.com/hansbala/myncer/proto/myncer;myncer_pbb\x06proto3"
+	"\x04isrc\x18\a \x01(\tR\x04isrc\x125\n" +
+	"\badded_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\x12\x1a\n" +
+	"\bexplicit\x18\t \x01(\bR\bexplicit\x12\x1f\n" +
+	"\vduration_ms\x18\n" +
+	" \x01(\x03R\n" +
+	"durationMs\"\x9f\x02\n" +
+	"\x0eSongResolution\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12-\n" +
+	"\vsource_song\x18\x02 \x01(\v2\f.myncer.SongR\n" +
+	"sourceSong\x127\n" +
+	"\x10destination_song\x18\x03 \x01(\v2\f.myncer.SongR\x0fdestinationSong\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\x12;\n" +
+	"\vresolved_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\x129\n" +
+	"\n" +
+	"runner_ups\x18\x06 \x03(\v2\x1a.myncer.SongMatchCandidateR\trunnerUps\"L\n" +
+	"\x12SongMatchCandidate\x12 \n" +
+	"\x04song\x18\x01 \x01(\v2\f.myncer.SongR\x04song\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"\xfe\x02\n" +
+	"\fSongOverride\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12-\n" +
+	"\vsource_song\x18\x02 \x01(\v2\f.myncer.SongR\n" +
+	"sourceSong\x12I\n" +
+	"\x16destination_datasource\x18\x03 \x01(\x0e2\x12.myncer.DatasourceR\x15destinationDatasource\x12,\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x18.myncer.SongOverrideKindR\x04kind\x127\n" +
+	"\x10destination_song\x18\x05 \x01(\v2\f.myncer.SongR\x0fdestinationSong\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt*u\n" +
+	"\x10SongOverrideKind\x12\"\n" +
+	"\x1eSONG_OVERRIDE_KIND_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SONG_OVERRIDE_KIND_PIN\x10\x01\x12!\n" +
+	"\x1dSONG_OVERRIDE_KIND_NEVER_SYNC\x10\x02B3Z1github.com/hansbala/myncer/proto/myncer;myncer_pbb\x06proto3"
 
 var (
 	file_myncer_song_proto_rawDescOnce sync.Once
@@ -146,18 +498,36 @@ func file_myncer_song_proto_rawDescGZIP() []byte {
 	return file_myncer_song_proto_rawDescData
 }
 
-var file_myncer_song_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_myncer_song_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_myncer_song_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_myncer_song_proto_goTypes = []any{
-	(*Song)(nil),    // 0: myncer.Song
-	(Datasource)(0), // 1: myncer.Datasource
+	(SongOverrideKind)(0),         // 0: myncer.SongOverrideKind
+	(*Song)(nil),                  // 1: myncer.Song
+	(*SongResolution)(nil),        // 2: myncer.SongResolution
+	(*SongMatchCandidate)(nil),    // 3: myncer.SongMatchCandidate
+	(*SongOverride)(nil),          // 4: myncer.SongOverride
+	(Datasource)(0),               // 5: myncer.Datasource
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
 }
 var file_myncer_song_proto_depIdxs = []int32{
-	1, // 0: myncer.Song.datasource:type_name -> myncer.Datasource
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5,  // 0: myncer.Song.datasource:type_name -> myncer.Datasource
+	6,  // 1: myncer.Song.added_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: myncer.SongResolution.source_song:type_name -> myncer.Song
+	1,  // 3: myncer.SongResolution.destination_song:type_name -> myncer.Song
+	6,  // 4: myncer.SongResolution.resolved_at:type_name -> google.protobuf.Timestamp
+	3,  // 5: myncer.SongResolution.runner_ups:type_name -> myncer.SongMatchCandidate
+	1,  // 6: myncer.SongMatchCandidate.song:type_name -> myncer.Song
+	1,  // 7: myncer.SongOverride.source_song:type_name -> myncer.Song
+	5,  // 8: myncer.SongOverride.destination_datasource:type_name -> myncer.Datasource
+	0,  // 9: myncer.SongOverride.kind:type_name -> myncer.SongOverrideKind
+	1,  // 10: myncer.SongOverride.destination_song:type_name -> myncer.Song
+	6,  // 11: myncer.SongOverride.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 12: myncer.SongOverride.updated_at:type_name -> google.protobuf.Timestamp
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_myncer_song_proto_init() }
@@ -171,13 +541,14 @@ func file_myncer_song_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_myncer_song_proto_rawDesc), len(file_myncer_song_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_myncer_song_proto_goTypes,
 		DependencyIndexes: file_myncer_song_proto_depIdxs,
+		EnumInfos:         file_myncer_song_proto_enumTypes,
 		MessageInfos:      file_myncer_song_proto_msgTypes,
 	}.Build()
 	File_myncer_song_proto = out.File
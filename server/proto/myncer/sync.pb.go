@@ -22,6 +22,407 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PlaylistMergeSyncMode int32
+
+const (
+	// The merged songs are written to the destination.
+	PlaylistMergeSyncMode_PLAYLIST_MERGE_SYNC_MODE_UNSPECIFIED PlaylistMergeSyncMode = 0
+	// The merged songs are written back to every source, and the destination if set.
+	// Only songs missing from each playlist are added.
+	PlaylistMergeSyncMode_PLAYLIST_MERGE_SYNC_MODE_BIDIRECTIONAL PlaylistMergeSyncMode = 1
+	// Like bidirectional, but also compares every playlist against its contents after the last
+	// successful run.
+	// Songs removed from any playlist since then are removed from every playlist.
+	PlaylistMergeSyncMode_PLAYLIST_MERGE_SYNC_MODE_THREE_WAY PlaylistMergeSyncMode = 2
+)
+
+// Enum value maps for PlaylistMergeSyncMode.
+var (
+	PlaylistMergeSyncMode_name = map[int32]string{
+		0: "PLAYLIST_MERGE_SYNC_MODE_UNSPECIFIED",
+		1: "PLAYLIST_MERGE_SYNC_MODE_BIDIRECTIONAL",
+		2: "PLAYLIST_MERGE_SYNC_MODE_THREE_WAY",
+	}
+	PlaylistMergeSyncMode_value = map[string]int32{
+		"PLAYLIST_MERGE_SYNC_MODE_UNSPECIFIED":   0,
+		"PLAYLIST_MERGE_SYNC_MODE_BIDIRECTIONAL": 1,
+		"PLAYLIST_MERGE_SYNC_MODE_THREE_WAY":     2,
+	}
+)
+
+func (x PlaylistMergeSyncMode) Enum() *PlaylistMergeSyncMode {
+	p := new(PlaylistMergeSyncMode)
+	*p = x
+	return p
+}
+
+func (x PlaylistMergeSyncMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlaylistMergeSyncMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_myncer_sync_proto_enumTypes[0].Descriptor()
+}
+
+func (PlaylistMergeSyncMode) Type() protoreflect.EnumType {
+	return &file_myncer_sync_proto_enumTypes[0]
+}
+
+func (x PlaylistMergeSyncMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlaylistMergeSyncMode.Descriptor instead.
+func (PlaylistMergeSyncMode) EnumDescriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{0}
+}
+
+// What happens when a song was removed from one playlist but added to another since the last
+// successful run of a three-way merge.
+type MergeConflictPolicy int32
+
+const (
+	// Same as keep.
+	MergeConflictPolicy_MERGE_CONFLICT_POLICY_UNSPECIFIED MergeConflictPolicy = 0
+	// The song is kept in, and added back to, every playlist.
+	MergeConflictPolicy_MERGE_CONFLICT_POLICY_KEEP MergeConflictPolicy = 1
+	// The song is removed from every playlist.
+	MergeConflictPolicy_MERGE_CONFLICT_POLICY_REMOVE MergeConflictPolicy = 2
+)
+
+// Enum value maps for MergeConflictPolicy.
+var (
+	MergeConflictPolicy_name = map[int32]string{
+		0: "MERGE_CONFLICT_POLICY_UNSPECIFIED",
+		1: "MERGE_CONFLICT_POLICY_KEEP",
+		2: "MERGE_CONFLICT_POLICY_REMOVE",
+	}
+	MergeConflictPolicy_value = map[string]int32{
+		"MERGE_CONFLICT_POLICY_UNSPECIFIED": 0,
+		"MERGE_CONFLICT_POLICY_KEEP":        1,
+		"MERGE_CONFLICT_POLICY_REMOVE":      2,
+	}
+)
+
+func (x MergeConflictPolicy) Enum() *MergeConflictPolicy {
+	p := new(MergeConflictPolicy)
+	*p = x
+	return p
+}
+
+func (x MergeConflictPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergeConflictPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_myncer_sync_proto_enumTypes[1].Descriptor()
+}
+
+func (MergeConflictPolicy) Type() protoreflect.EnumType {
+	return &file_myncer_sync_proto_enumTypes[1]
+}
+
+func (x MergeConflictPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergeConflictPolicy.Descriptor instead.
+func (MergeConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{1}
+}
+
+// How often a scheduled sync should run.
+type SyncScheduleInterval int32
+
+const (
+	// The sync is only ever run manually.
+	SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_UNSPECIFIED SyncScheduleInterval = 0
+	SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_HOURLY      SyncScheduleInterval = 1
+	SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_WEEKLY      SyncScheduleInterval = 2
+	SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_BI_WEEKLY   SyncScheduleInterval = 3
+	SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_MONTHLY     SyncScheduleInterval = 4
+)
+
+// Enum value maps for SyncScheduleInterval.
+var (
+	SyncScheduleInterval_name = map[int32]string{
+		0: "SYNC_SCHEDULE_INTERVAL_UNSPECIFIED",
+		1: "SYNC_SCHEDULE_INTERVAL_HOURLY",
+		2: "SYNC_SCHEDULE_INTERVAL_WEEKLY",
+		3: "SYNC_SCHEDULE_INTERVAL_BI_WEEKLY",
+		4: "SYNC_SCHEDULE_INTERVAL_MONTHLY",
+	}
+	SyncScheduleInterval_value = map[string]int32{
+		"SYNC_SCHEDULE_INTERVAL_UNSPECIFIED": 0,
+		"SYNC_SCHEDULE_INTERVAL_HOURLY":      1,
+		"SYNC_SCHEDULE_INTERVAL_WEEKLY":      2,
+		"SYNC_SCHEDULE_INTERVAL_BI_WEEKLY":   3,
+		"SYNC_SCHEDULE_INTERVAL_MONTHLY":     4,
+	}
+)
+
+func (x SyncScheduleInterval) Enum() *SyncScheduleInterval {
+	p := new(SyncScheduleInterval)
+	*p = x
+	return p
+}
+
+func (x SyncScheduleInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SyncScheduleInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_myncer_sync_proto_enumTypes[2].Descriptor()
+}
+
+func (SyncScheduleInterval) Type() protoreflect.EnumType {
+	return &file_myncer_sync_proto_enumTypes[2]
+}
+
+func (x SyncScheduleInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SyncScheduleInterval.Descriptor instead.
+func (SyncScheduleInterval) EnumDescriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{2}
+}
+
+type SyncRunKind int32
+
+const (
+	// A regular run which writes to playlists.
+	SyncRunKind_SYNC_RUN_KIND_UNSPECIFIED SyncRunKind = 0
+	// A dry run which plans the changes of the sync without making them.
+	SyncRunKind_SYNC_RUN_KIND_PREVIEW SyncRunKind = 1
+)
+
+// Enum value maps for SyncRunKind.
+var (
+	SyncRunKind_name = map[int32]string{
+		0: "SYNC_RUN_KIND_UNSPECIFIED",
+		1: "SYNC_RUN_KIND_PREVIEW",
+	}
+	SyncRunKind_value = map[string]int32{
+		"SYNC_RUN_KIND_UNSPECIFIED": 0,
+		"SYNC_RUN_KIND_PREVIEW":     1,
+	}
+)
+
+func (x SyncRunKind) Enum() *SyncRunKind {
+	p := new(SyncRunKind)
+	*p = x
+	return p
+}
+
+func (x SyncRunKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SyncRunKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_myncer_sync_proto_enumTypes[3].Descriptor()
+}
+
+func (SyncRunKind) Type() protoreflect.EnumType {
+	return &file_myncer_sync_proto_enumTypes[3]
+}
+
+func (x SyncRunKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SyncRunKind.Descriptor instead.
+func (SyncRunKind) EnumDescriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{3}
+}
+
+// The phases of a sync run. Which phases are run depends on the kind of sync.
+type SyncRunPhase int32
+
+const (
+	SyncRunPhase_SYNC_RUN_PHASE_UNSPECIFIED SyncRunPhase = 0
+	// Fetching songs from the source playlists.
+	SyncRunPhase_SYNC_RUN_PHASE_FETCH_SOURCE SyncRunPhase = 1
+	// Normalizing song metadata with the LLM.
+	SyncRunPhase_SYNC_RUN_PHASE_NORMALIZE SyncRunPhase = 2
+	// Searching for the songs on the destination datasource.
+	SyncRunPhase_SYNC_RUN_PHASE_SEARCH SyncRunPhase = 3
+	// Clearing the destination playlist.
+	SyncRunPhase_SYNC_RUN_PHASE_CLEAR_DESTINATION SyncRunPhase = 4
+	// Adding songs to the destination playlist.
+	SyncRunPhase_SYNC_RUN_PHASE_ADD_TO_DESTINATION SyncRunPhase = 5
+	// Fetching songs already in the destination playlist.
+	SyncRunPhase_SYNC_RUN_PHASE_FETCH_DESTINATION SyncRunPhase = 6
+	// Removing songs from the destination playlist.
+	SyncRunPhase_SYNC_RUN_PHASE_REMOVE_FROM_DESTINATION SyncRunPhase = 7
+	// Reordering the destination playlist.
+	SyncRunPhase_SYNC_RUN_PHASE_REORDER_DESTINATION SyncRunPhase = 8
+)
+
+// Enum value maps for SyncRunPhase.
+var (
+	SyncRunPhase_name = map[int32]string{
+		0: "SYNC_RUN_PHASE_UNSPECIFIED",
+		1: "SYNC_RUN_PHASE_FETCH_SOURCE",
+		2: "SYNC_RUN_PHASE_NORMALIZE",
+		3: "SYNC_RUN_PHASE_SEARCH",
+		4: "SYNC_RUN_PHASE_CLEAR_DESTINATION",
+		5: "SYNC_RUN_PHASE_ADD_TO_DESTINATION",
+		6: "SYNC_RUN_PHASE_FETCH_DESTINATION",
+		7: "SYNC_RUN_PHASE_REMOVE_FROM_DESTINATION",
+		8: "SYNC_RUN_PHASE_REORDER_DESTINATION",
+	}
+	SyncRunPhase_value = map[string]int32{
+		"SYNC_RUN_PHASE_UNSPECIFIED":             0,
+		"SYNC_RUN_PHASE_FETCH_SOURCE":            1,
+		"SYNC_RUN_PHASE_NORMALIZE":               2,
+		"SYNC_RUN_PHASE_SEARCH":                  3,
+		"SYNC_RUN_PHASE_CLEAR_DESTINATION":       4,
+		"SYNC_RUN_PHASE_ADD_TO_DESTINATION":      5,
+		"SYNC_RUN_PHASE_FETCH_DESTINATION":       6,
+		"SYNC_RUN_PHASE_REMOVE_FROM_DESTINATION": 7,
+		"SYNC_RUN_PHASE_REORDER_DESTINATION":     8,
+	}
+)
+
+func (x SyncRunPhase) Enum() *SyncRunPhase {
+	p := new(SyncRunPhase)
+	*p = x
+	return p
+}
+
+func (x SyncRunPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SyncRunPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_myncer_sync_proto_enumTypes[4].Descriptor()
+}
+
+func (SyncRunPhase) Type() protoreflect.EnumType {
+	return &file_myncer_sync_proto_enumTypes[4]
+}
+
+func (x SyncRunPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SyncRunPhase.Descriptor instead.
+func (SyncRunPhase) EnumDescriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{4}
+}
+
+// How a sync orders the playlists it writes to.
+type PlaylistOrder int32
+
+const (
+	// The playlist is not reordered. New songs are added to the end.
+	PlaylistOrder_PLAYLIST_ORDER_UNSPECIFIED PlaylistOrder = 0
+	// Songs are in the same order as in the source.
+	// Songs that aren't in the source are moved to the end.
+	PlaylistOrder_PLAYLIST_ORDER_SOURCE PlaylistOrder = 1
+	// Songs are sorted by name.
+	PlaylistOrder_PLAYLIST_ORDER_NAME PlaylistOrder = 2
+	// Songs are sorted by their first artist.
+	PlaylistOrder_PLAYLIST_ORDER_ARTIST PlaylistOrder = 3
+	// Songs are sorted by album.
+	PlaylistOrder_PLAYLIST_ORDER_ALBUM PlaylistOrder = 4
+)
+
+// Enum value maps for PlaylistOrder.
+var (
+	PlaylistOrder_name = map[int32]string{
+		0: "PLAYLIST_ORDER_UNSPECIFIED",
+		1: "PLAYLIST_ORDER_SOURCE",
+		2: "PLAYLIST_ORDER_NAME",
+		3: "PLAYLIST_ORDER_ARTIST",
+		4: "PLAYLIST_ORDER_ALBUM",
+	}
+	PlaylistOrder_value = map[string]int32{
+		"PLAYLIST_ORDER_UNSPECIFIED": 0,
+		"PLAYLIST_ORDER_SOURCE":      1,
+		"PLAYLIST_ORDER_NAME":        2,
+		"PLAYLIST_ORDER_ARTIST":      3,
+		"PLAYLIST_ORDER_ALBUM":       4,
+	}
+)
+
+func (x PlaylistOrder) Enum() *PlaylistOrder {
+	p := new(PlaylistOrder)
+	*p = x
+	return p
+}
+
+func (x PlaylistOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlaylistOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_myncer_sync_proto_enumTypes[5].Descriptor()
+}
+
+func (PlaylistOrder) Type() protoreflect.EnumType {
+	return &file_myncer_sync_proto_enumTypes[5]
+}
+
+func (x PlaylistOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlaylistOrder.Descriptor instead.
+func (PlaylistOrder) EnumDescriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{5}
+}
+
+// How a one-way sync updates the destination playlist.
+type OneWaySyncMode int32
+
+const (
+	// Every source song is added to the destination, after clearing it if `overwrite_existing` is set.
+	OneWaySyncMode_ONE_WAY_SYNC_MODE_UNSPECIFIED OneWaySyncMode = 0
+	// Only source songs missing from the destination are added, so repeated runs are idempotent.
+	OneWaySyncMode_ONE_WAY_SYNC_MODE_DIFF OneWaySyncMode = 1
+)
+
+// Enum value maps for OneWaySyncMode.
+var (
+	OneWaySyncMode_name = map[int32]string{
+		0: "ONE_WAY_SYNC_MODE_UNSPECIFIED",
+		1: "ONE_WAY_SYNC_MODE_DIFF",
+	}
+	OneWaySyncMode_value = map[string]int32{
+		"ONE_WAY_SYNC_MODE_UNSPECIFIED": 0,
+		"ONE_WAY_SYNC_MODE_DIFF":        1,
+	}
+)
+
+func (x OneWaySyncMode) Enum() *OneWaySyncMode {
+	p := new(OneWaySyncMode)
+	*p = x
+	return p
+}
+
+func (x OneWaySyncMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OneWaySyncMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_myncer_sync_proto_enumTypes[6].Descriptor()
+}
+
+func (OneWaySyncMode) Type() protoreflect.EnumType {
+	return &file_myncer_sync_proto_enumTypes[6]
+}
+
+func (x OneWaySyncMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OneWaySyncMode.Descriptor instead.
+func (OneWaySyncMode) EnumDescriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{6}
+}
+
 type SyncStatus int32
 
 const (
@@ -69,11 +470,11 @@ func (x SyncStatus) String() string {
 }
 
 func (SyncStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_myncer_sync_proto_enumTypes[0].Descriptor()
+	return file_myncer_sync_proto_enumTypes[7].Descriptor()
 }
 
 func (SyncStatus) Type() protoreflect.EnumType {
-	return &file_myncer_sync_proto_enumTypes[0]
+	return &file_myncer_sync_proto_enumTypes[7]
 }
 
 func (x SyncStatus) Number() protoreflect.EnumNumber {
@@ -82,17 +483,25 @@ func (x SyncStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SyncStatus.Descriptor instead.
 func (SyncStatus) EnumDescriptor() ([]byte, []int) {
-	return file_myncer_sync_proto_rawDescGZIP(), []int{0}
+	return file_myncer_sync_proto_rawDescGZIP(), []int{7}
 }
 
 // Representative of multiple sources -> one destination.
 type PlaylistMergeSync struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Sources           []*MusicSource         `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
-	Destination       *MusicSource           `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
-	OverwriteExisting bool                   `protobuf:"varint,3,opt,name=overwrite_existing,json=overwriteExisting,proto3" json:"overwrite_existing,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Sources []*MusicSource         `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	// Optional in bidirectional mode.
+	Destination *MusicSource `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	// Not supported in bidirectional mode.
+	OverwriteExisting bool                  `protobuf:"varint,3,opt,name=overwrite_existing,json=overwriteExisting,proto3" json:"overwrite_existing,omitempty"`
+	Mode              PlaylistMergeSyncMode `protobuf:"varint,4,opt,name=mode,proto3,enum=myncer.PlaylistMergeSyncMode" json:"mode,omitempty"`
+	// How conflicting changes are resolved in three-way mode.
+	ConflictPolicy MergeConflictPolicy `protobuf:"varint,5,opt,name=conflict_policy,json=conflictPolicy,proto3,enum=myncer.MergeConflictPolicy" json:"conflict_policy,omitempty"`
+	// How the written playlists are ordered after each run.
+	// Source order follows the order of the sources, then the order of each source.
+	Order         PlaylistOrder `protobuf:"varint,6,opt,name=order,proto3,enum=myncer.PlaylistOrder" json:"order,omitempty"` // next: 7
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PlaylistMergeSync) Reset() {
@@ -146,40 +555,54 @@ func (x *PlaylistMergeSync) GetOverwriteExisting() bool {
 	return false
 }
 
-type Sync struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// google/uuid generated UUID.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Myncer user id.
-	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Metadata which is fetched from SQL (for it's ACID compliance).
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	// Holds the actual sync data.
-	//
-	// Types that are valid to be assigned to SyncVariant:
-	//
-	//	*Sync_OneWaySync
-	//	*Sync_PlaylistMergeSync
-	SyncVariant   isSync_SyncVariant `protobuf_oneof:"sync_variant"`
+func (x *PlaylistMergeSync) GetMode() PlaylistMergeSyncMode {
+	if x != nil {
+		return x.Mode
+	}
+	return PlaylistMergeSyncMode_PLAYLIST_MERGE_SYNC_MODE_UNSPECIFIED
+}
+
+func (x *PlaylistMergeSync) GetConflictPolicy() MergeConflictPolicy {
+	if x != nil {
+		return x.ConflictPolicy
+	}
+	return MergeConflictPolicy_MERGE_CONFLICT_POLICY_UNSPECIFIED
+}
+
+func (x *PlaylistMergeSync) GetOrder() PlaylistOrder {
+	if x != nil {
+		return x.Order
+	}
+	return PlaylistOrder_PLAYLIST_ORDER_UNSPECIFIED
+}
+
+// The songs of each playlist of a three-way merge sync after its last successful run.
+type SyncBaseline struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SyncId string                 `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	// The run that produced the baseline.
+	RunId         string                  `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Playlists     []*SyncBaselinePlaylist `protobuf:"bytes,3,rep,name=playlists,proto3" json:"playlists,omitempty"`
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Sync) Reset() {
-	*x = Sync{}
+func (x *SyncBaseline) Reset() {
+	*x = SyncBaseline{}
 	mi := &file_myncer_sync_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Sync) String() string {
+func (x *SyncBaseline) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Sync) ProtoMessage() {}
+func (*SyncBaseline) ProtoMessage() {}
 
-func (x *Sync) ProtoReflect() protoreflect.Message {
+func (x *SyncBaseline) ProtoReflect() protoreflect.Message {
 	mi := &file_myncer_sync_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -191,114 +614,68 @@ func (x *Sync) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Sync.ProtoReflect.Descriptor instead.
-func (*Sync) Descriptor() ([]byte, []int) {
+// Deprecated: Use SyncBaseline.ProtoReflect.Descriptor instead.
+func (*SyncBaseline) Descriptor() ([]byte, []int) {
 	return file_myncer_sync_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Sync) GetId() string {
+func (x *SyncBaseline) GetSyncId() string {
 	if x != nil {
-		return x.Id
+		return x.SyncId
 	}
 	return ""
 }
 
-func (x *Sync) GetUserId() string {
+func (x *SyncBaseline) GetRunId() string {
 	if x != nil {
-		return x.UserId
+		return x.RunId
 	}
 	return ""
 }
 
-func (x *Sync) GetCreatedAt() *timestamppb.Timestamp {
+func (x *SyncBaseline) GetPlaylists() []*SyncBaselinePlaylist {
 	if x != nil {
-		return x.CreatedAt
+		return x.Playlists
 	}
 	return nil
 }
 
-func (x *Sync) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *SyncBaseline) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Sync) GetSyncVariant() isSync_SyncVariant {
+func (x *SyncBaseline) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SyncVariant
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *Sync) GetOneWaySync() *OneWaySync {
-	if x != nil {
-		if x, ok := x.SyncVariant.(*Sync_OneWaySync); ok {
-			return x.OneWaySync
-		}
-	}
-	return nil
+type SyncBaselinePlaylist struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Playlist      *MusicSource           `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	Songs         []*Song                `protobuf:"bytes,2,rep,name=songs,proto3" json:"songs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Sync) GetPlaylistMergeSync() *PlaylistMergeSync {
-	if x != nil {
-		if x, ok := x.SyncVariant.(*Sync_PlaylistMergeSync); ok {
-			return x.PlaylistMergeSync
-		}
-	}
-	return nil
-}
-
-type isSync_SyncVariant interface {
-	isSync_SyncVariant()
-}
-
-type Sync_OneWaySync struct {
-	OneWaySync *OneWaySync `protobuf:"bytes,5,opt,name=one_way_sync,json=oneWaySync,proto3,oneof"`
-}
-
-type Sync_PlaylistMergeSync struct {
-	PlaylistMergeSync *PlaylistMergeSync `protobuf:"bytes,6,opt,name=playlist_merge_sync,json=playlistMergeSync,proto3,oneof"`
-}
-
-func (*Sync_OneWaySync) isSync_SyncVariant() {}
-
-func (*Sync_PlaylistMergeSync) isSync_SyncVariant() {}
-
-type SyncRun struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The ID of the sync that was run.
-	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
-	// The run id of this sync.
-	// This is expected to be randomly generated for each sync run.
-	RunId string `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	// The status of this sync run.
-	SyncStatus SyncStatus `protobuf:"varint,3,opt,name=sync_status,json=syncStatus,proto3,enum=myncer.SyncStatus" json:"sync_status,omitempty"`
-	// Metadata which is fetched from SQL (for it's ACID compliance).
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	// Lista de canciones no encontradas durante la sincronización
-	UnmatchedSongs []*Song `protobuf:"bytes,6,rep,name=unmatched_songs,json=unmatchedSongs,proto3" json:"unmatched_songs,omitempty"`
-	// Mensaje de error detallado (ej. playlist eliminada)
-	ErrorMessage  string `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SyncRun) Reset() {
-	*x = SyncRun{}
+func (x *SyncBaselinePlaylist) Reset() {
+	*x = SyncBaselinePlaylist{}
 	mi := &file_myncer_sync_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncRun) String() string {
+func (x *SyncBaselinePlaylist) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncRun) ProtoMessage() {}
+func (*SyncBaselinePlaylist) ProtoMessage() {}
 
-func (x *SyncRun) ProtoReflect() protoreflect.Message {
+func (x *SyncBaselinePlaylist) ProtoReflect() protoreflect.Message {
 	mi := &file_myncer_sync_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -310,86 +687,53 @@ func (x *SyncRun) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncRun.ProtoReflect.Descriptor instead.
-func (*SyncRun) Descriptor() ([]byte, []int) {
+// Deprecated: Use SyncBaselinePlaylist.ProtoReflect.Descriptor instead.
+func (*SyncBaselinePlaylist) Descriptor() ([]byte, []int) {
 	return file_myncer_sync_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *SyncRun) GetSyncId() string {
-	if x != nil {
-		return x.SyncId
-	}
-	return ""
-}
-
-func (x *SyncRun) GetRunId() string {
-	if x != nil {
-		return x.RunId
-	}
-	return ""
-}
-
-func (x *SyncRun) GetSyncStatus() SyncStatus {
-	if x != nil {
-		return x.SyncStatus
-	}
-	return SyncStatus_SYNC_STATUS_UNSPECIFIED
-}
-
-func (x *SyncRun) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *SyncRun) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *SyncBaselinePlaylist) GetPlaylist() *MusicSource {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Playlist
 	}
 	return nil
 }
 
-func (x *SyncRun) GetUnmatchedSongs() []*Song {
+func (x *SyncBaselinePlaylist) GetSongs() []*Song {
 	if x != nil {
-		return x.UnmatchedSongs
+		return x.Songs
 	}
 	return nil
 }
 
-func (x *SyncRun) GetErrorMessage() string {
-	if x != nil {
-		return x.ErrorMessage
-	}
-	return ""
-}
-
-// Representative of source -> destination.
-type OneWaySync struct {
+type MergeConflict struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
-	Source      *MusicSource           `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	Destination *MusicSource           `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
-	// When true, it overwrites the destination songs.
-	// If a song exists in source but not in destination, the song will be lost from destination.
-	OverwriteExisting bool `protobuf:"varint,3,opt,name=overwrite_existing,json=overwriteExisting,proto3" json:"overwrite_existing,omitempty"` // next: 4
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	RemovedSong *Song                  `protobuf:"bytes,1,opt,name=removed_song,json=removedSong,proto3" json:"removed_song,omitempty"`
+	// The playlist the song was removed from.
+	RemovedFrom *MusicSource `protobuf:"bytes,2,opt,name=removed_from,json=removedFrom,proto3" json:"removed_from,omitempty"`
+	AddedSong   *Song        `protobuf:"bytes,3,opt,name=added_song,json=addedSong,proto3" json:"added_song,omitempty"`
+	// The playlist the song was added to.
+	AddedTo *MusicSource `protobuf:"bytes,4,opt,name=added_to,json=addedTo,proto3" json:"added_to,omitempty"`
+	// How the conflict was resolved.
+	Resolution    MergeConflictPolicy `protobuf:"varint,5,opt,name=resolution,proto3,enum=myncer.MergeConflictPolicy" json:"resolution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OneWaySync) Reset() {
-	*x = OneWaySync{}
+func (x *MergeConflict) Reset() {
+	*x = MergeConflict{}
 	mi := &file_myncer_sync_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OneWaySync) String() string {
+func (x *MergeConflict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OneWaySync) ProtoMessage() {}
+func (*MergeConflict) ProtoMessage() {}
 
-func (x *OneWaySync) ProtoReflect() protoreflect.Message {
+func (x *MergeConflict) ProtoReflect() protoreflect.Message {
 	mi := &file_myncer_sync_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -401,59 +745,95 @@ func (x *OneWaySync) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OneWaySync.ProtoReflect.Descriptor instead.
-func (*OneWaySync) Descriptor() ([]byte, []int) {
+// Deprecated: Use MergeConflict.ProtoReflect.Descriptor instead.
+func (*MergeConflict) Descriptor() ([]byte, []int) {
 	return file_myncer_sync_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *OneWaySync) GetSource() *MusicSource {
+func (x *MergeConflict) GetRemovedSong() *Song {
 	if x != nil {
-		return x.Source
+		return x.RemovedSong
 	}
 	return nil
 }
 
-func (x *OneWaySync) GetDestination() *MusicSource {
+func (x *MergeConflict) GetRemovedFrom() *MusicSource {
 	if x != nil {
-		return x.Destination
+		return x.RemovedFrom
 	}
 	return nil
 }
 
-func (x *OneWaySync) GetOverwriteExisting() bool {
+func (x *MergeConflict) GetAddedSong() *Song {
 	if x != nil {
-		return x.OverwriteExisting
+		return x.AddedSong
 	}
-	return false
+	return nil
 }
 
-type CreateSyncRequest struct {
+func (x *MergeConflict) GetAddedTo() *MusicSource {
+	if x != nil {
+		return x.AddedTo
+	}
+	return nil
+}
+
+func (x *MergeConflict) GetResolution() MergeConflictPolicy {
+	if x != nil {
+		return x.Resolution
+	}
+	return MergeConflictPolicy_MERGE_CONFLICT_POLICY_UNSPECIFIED
+}
+
+type Sync struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The sync to create.
+	// google/uuid generated UUID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Myncer user id.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Metadata which is fetched from SQL (for it's ACID compliance).
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Holds the actual sync data.
 	//
 	// Types that are valid to be assigned to SyncVariant:
 	//
-	//	*CreateSyncRequest_OneWaySync
-	//	*CreateSyncRequest_PlaylistMergeSync
-	SyncVariant   isCreateSyncRequest_SyncVariant `protobuf_oneof:"sync_variant"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	//	*Sync_OneWaySync
+	//	*Sync_PlaylistMergeSync
+	//	*Sync_FanOutSync
+	SyncVariant isSync_SyncVariant `protobuf_oneof:"sync_variant"`
+	// When set, the sync is run automatically by the server.
+	Schedule *SyncSchedule `protobuf:"bytes,7,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// How failed runs of the sync are retried. Unset means failed runs are not retried.
+	RetryPolicy *RetryPolicy `protobuf:"bytes,8,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// Songs of the sources that any of the rules exclude are not synced.
+	FilterRules []*SyncFilterRule `protobuf:"bytes,10,rep,name=filter_rules,json=filterRules,proto3" json:"filter_rules,omitempty"`
+	// How songs are matched across datasources. Unset means the default profile.
+	MatchingProfile *MatchingProfile `protobuf:"bytes,11,opt,name=matching_profile,json=matchingProfile,proto3" json:"matching_profile,omitempty"`
+	// Set while the sync is paused. Paused syncs are neither run on their schedule nor on request.
+	// Dry runs are still allowed since they don't change any playlist.
+	Pause *SyncPause `protobuf:"bytes,12,opt,name=pause,proto3" json:"pause,omitempty"`
+	// Number of runs in a row that failed. Previews are not counted.
+	// The sync is paused automatically once this reaches the limit.
+	ConsecutiveFailures int32 `protobuf:"varint,13,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"` // next: 14
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *CreateSyncRequest) Reset() {
-	*x = CreateSyncRequest{}
+func (x *Sync) Reset() {
+	*x = Sync{}
 	mi := &file_myncer_sync_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSyncRequest) String() string {
+func (x *Sync) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSyncRequest) ProtoMessage() {}
+func (*Sync) ProtoMessage() {}
 
-func (x *CreateSyncRequest) ProtoReflect() protoreflect.Message {
+func (x *Sync) ProtoReflect() protoreflect.Message {
 	mi := &file_myncer_sync_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -465,74 +845,161 @@ func (x *CreateSyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSyncRequest.ProtoReflect.Descriptor instead.
-func (*CreateSyncRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Sync.ProtoReflect.Descriptor instead.
+func (*Sync) Descriptor() ([]byte, []int) {
 	return file_myncer_sync_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateSyncRequest) GetSyncVariant() isCreateSyncRequest_SyncVariant {
+func (x *Sync) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Sync) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Sync) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Sync) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Sync) GetSyncVariant() isSync_SyncVariant {
 	if x != nil {
 		return x.SyncVariant
 	}
 	return nil
 }
 
-func (x *CreateSyncRequest) GetOneWaySync() *OneWaySync {
+func (x *Sync) GetOneWaySync() *OneWaySync {
 	if x != nil {
-		if x, ok := x.SyncVariant.(*CreateSyncRequest_OneWaySync); ok {
+		if x, ok := x.SyncVariant.(*Sync_OneWaySync); ok {
 			return x.OneWaySync
 		}
 	}
 	return nil
 }
 
-func (x *CreateSyncRequest) GetPlaylistMergeSync() *PlaylistMergeSync {
+func (x *Sync) GetPlaylistMergeSync() *PlaylistMergeSync {
 	if x != nil {
-		if x, ok := x.SyncVariant.(*CreateSyncRequest_PlaylistMergeSync); ok {
+		if x, ok := x.SyncVariant.(*Sync_PlaylistMergeSync); ok {
 			return x.PlaylistMergeSync
 		}
 	}
 	return nil
 }
 
-type isCreateSyncRequest_SyncVariant interface {
-	isCreateSyncRequest_SyncVariant()
+func (x *Sync) GetFanOutSync() *FanOutSync {
+	if x != nil {
+		if x, ok := x.SyncVariant.(*Sync_FanOutSync); ok {
+			return x.FanOutSync
+		}
+	}
+	return nil
 }
 
-type CreateSyncRequest_OneWaySync struct {
-	OneWaySync *OneWaySync `protobuf:"bytes,1,opt,name=one_way_sync,json=oneWaySync,proto3,oneof"`
+func (x *Sync) GetSchedule() *SyncSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
 }
 
-type CreateSyncRequest_PlaylistMergeSync struct {
-	PlaylistMergeSync *PlaylistMergeSync `protobuf:"bytes,2,opt,name=playlist_merge_sync,json=playlistMergeSync,proto3,oneof"`
+func (x *Sync) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
 }
 
-func (*CreateSyncRequest_OneWaySync) isCreateSyncRequest_SyncVariant() {}
+func (x *Sync) GetFilterRules() []*SyncFilterRule {
+	if x != nil {
+		return x.FilterRules
+	}
+	return nil
+}
 
-func (*CreateSyncRequest_PlaylistMergeSync) isCreateSyncRequest_SyncVariant() {}
+func (x *Sync) GetMatchingProfile() *MatchingProfile {
+	if x != nil {
+		return x.MatchingProfile
+	}
+	return nil
+}
 
-type CreateSyncResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The created sync.
-	Sync          *Sync `protobuf:"bytes,1,opt,name=sync,proto3" json:"sync,omitempty"`
+func (x *Sync) GetPause() *SyncPause {
+	if x != nil {
+		return x.Pause
+	}
+	return nil
+}
+
+func (x *Sync) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+type isSync_SyncVariant interface {
+	isSync_SyncVariant()
+}
+
+type Sync_OneWaySync struct {
+	OneWaySync *OneWaySync `protobuf:"bytes,5,opt,name=one_way_sync,json=oneWaySync,proto3,oneof"`
+}
+
+type Sync_PlaylistMergeSync struct {
+	PlaylistMergeSync *PlaylistMergeSync `protobuf:"bytes,6,opt,name=playlist_merge_sync,json=playlistMergeSync,proto3,oneof"`
+}
+
+type Sync_FanOutSync struct {
+	FanOutSync *FanOutSync `protobuf:"bytes,9,opt,name=fan_out_sync,json=fanOutSync,proto3,oneof"`
+}
+
+func (*Sync_OneWaySync) isSync_SyncVariant() {}
+
+func (*Sync_PlaylistMergeSync) isSync_SyncVariant() {}
+
+func (*Sync_FanOutSync) isSync_SyncVariant() {}
+
+type SyncPause struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PausedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at,omitempty"`
+	Reason   string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// True if the sync was paused by the server after repeated failures rather than by the user.
+	Automatic     bool `protobuf:"varint,3,opt,name=automatic,proto3" json:"automatic,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSyncResponse) Reset() {
-	*x = CreateSyncResponse{}
+func (x *SyncPause) Reset() {
+	*x = SyncPause{}
 	mi := &file_myncer_sync_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSyncResponse) String() string {
+func (x *SyncPause) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSyncResponse) ProtoMessage() {}
+func (*SyncPause) ProtoMessage() {}
 
-func (x *CreateSyncResponse) ProtoReflect() protoreflect.Message {
+func (x *SyncPause) ProtoReflect() protoreflect.Message {
 	mi := &file_myncer_sync_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -544,41 +1011,3873 @@ func (x *CreateSyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSyncResponse.ProtoReflect.Descriptor instead.
-func (*CreateSyncResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SyncPause.ProtoReflect.Descriptor instead.
+func (*SyncPause) Descriptor() ([]byte, []int) {
 	return file_myncer_sync_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateSyncResponse) GetSync() *Sync {
+func (x *SyncPause) GetPausedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Sync
+		return x.PausedAt
+	}
+	return nil
+}
+
+func (x *SyncPause) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SyncPause) GetAutomatic() bool {
+	if x != nil {
+		return x.Automatic
+	}
+	return false
+}
+
+// Thresholds and weights for matching songs across datasources.
+// Scores range from 0 to 100. Fields left at zero use the defaults.
+type MatchingProfile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Songs of a merge scoring at least this are considered duplicates. Defaults to 90.
+	DedupeThreshold float64 `protobuf:"fixed64,1,opt,name=dedupe_threshold,json=dedupeThreshold,proto3" json:"dedupe_threshold,omitempty"`
+	// Search results scoring below this are not accepted as a match. Defaults to 65 for Tidal,
+	// while other datasources accept their best result by default.
+	MinAcceptanceScore float64 `protobuf:"fixed64,2,opt,name=min_acceptance_score,json=minAcceptanceScore,proto3" json:"min_acceptance_score,omitempty"`
+	// Relative weights of the title, artist and album similarity in the score.
+	// Default to 45, 45 and 10 when all are zero.
+	TitleWeight   float64 `protobuf:"fixed64,3,opt,name=title_weight,json=titleWeight,proto3" json:"title_weight,omitempty"`
+	ArtistWeight  float64 `protobuf:"fixed64,4,opt,name=artist_weight,json=artistWeight,proto3" json:"artist_weight,omitempty"`
+	AlbumWeight   float64 `protobuf:"fixed64,5,opt,name=album_weight,json=albumWeight,proto3" json:"album_weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchingProfile) Reset() {
+	*x = MatchingProfile{}
+	mi := &file_myncer_sync_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchingProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchingProfile) ProtoMessage() {}
+
+func (x *MatchingProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchingProfile.ProtoReflect.Descriptor instead.
+func (*MatchingProfile) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MatchingProfile) GetDedupeThreshold() float64 {
+	if x != nil {
+		return x.DedupeThreshold
+	}
+	return 0
+}
+
+func (x *MatchingProfile) GetMinAcceptanceScore() float64 {
+	if x != nil {
+		return x.MinAcceptanceScore
+	}
+	return 0
+}
+
+func (x *MatchingProfile) GetTitleWeight() float64 {
+	if x != nil {
+		return x.TitleWeight
+	}
+	return 0
+}
+
+func (x *MatchingProfile) GetArtistWeight() float64 {
+	if x != nil {
+		return x.ArtistWeight
+	}
+	return 0
+}
+
+func (x *MatchingProfile) GetAlbumWeight() float64 {
+	if x != nil {
+		return x.AlbumWeight
+	}
+	return 0
+}
+
+// Decides which songs of the sources a sync carries.
+type SyncFilterRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Rule:
+	//
+	//	*SyncFilterRule_ExcludeArtists
+	//	*SyncFilterRule_ExcludeNamePattern
+	//	*SyncFilterRule_AddedWithinDays
+	//	*SyncFilterRule_ExcludeExplicit
+	Rule          isSyncFilterRule_Rule `protobuf_oneof:"rule"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncFilterRule) Reset() {
+	*x = SyncFilterRule{}
+	mi := &file_myncer_sync_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncFilterRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncFilterRule) ProtoMessage() {}
+
+func (x *SyncFilterRule) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncFilterRule.ProtoReflect.Descriptor instead.
+func (*SyncFilterRule) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SyncFilterRule) GetRule() isSyncFilterRule_Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *SyncFilterRule) GetExcludeArtists() *SyncFilterArtists {
+	if x != nil {
+		if x, ok := x.Rule.(*SyncFilterRule_ExcludeArtists); ok {
+			return x.ExcludeArtists
+		}
+	}
+	return nil
+}
+
+func (x *SyncFilterRule) GetExcludeNamePattern() string {
+	if x != nil {
+		if x, ok := x.Rule.(*SyncFilterRule_ExcludeNamePattern); ok {
+			return x.ExcludeNamePattern
+		}
+	}
+	return ""
+}
+
+func (x *SyncFilterRule) GetAddedWithinDays() int32 {
+	if x != nil {
+		if x, ok := x.Rule.(*SyncFilterRule_AddedWithinDays); ok {
+			return x.AddedWithinDays
+		}
+	}
+	return 0
+}
+
+func (x *SyncFilterRule) GetExcludeExplicit() bool {
+	if x != nil {
+		if x, ok := x.Rule.(*SyncFilterRule_ExcludeExplicit); ok {
+			return x.ExcludeExplicit
+		}
+	}
+	return false
+}
+
+type isSyncFilterRule_Rule interface {
+	isSyncFilterRule_Rule()
+}
+
+type SyncFilterRule_ExcludeArtists struct {
+	// Excludes songs by any of the artists. Artist names are compared ignoring case.
+	ExcludeArtists *SyncFilterArtists `protobuf:"bytes,1,opt,name=exclude_artists,json=excludeArtists,proto3,oneof"`
+}
+
+type SyncFilterRule_ExcludeNamePattern struct {
+	// Excludes songs whose name matches the regular expression (RE2 syntax).
+	ExcludeNamePattern string `protobuf:"bytes,2,opt,name=exclude_name_pattern,json=excludeNamePattern,proto3,oneof"`
+}
+
+type SyncFilterRule_AddedWithinDays struct {
+	// Excludes songs added to their playlist more than this many days before the run.
+	// Songs whose added time is unknown are kept.
+	AddedWithinDays int32 `protobuf:"varint,3,opt,name=added_within_days,json=addedWithinDays,proto3,oneof"`
+}
+
+type SyncFilterRule_ExcludeExplicit struct {
+	// Excludes songs marked as explicit.
+	ExcludeExplicit bool `protobuf:"varint,4,opt,name=exclude_explicit,json=excludeExplicit,proto3,oneof"`
+}
+
+func (*SyncFilterRule_ExcludeArtists) isSyncFilterRule_Rule() {}
+
+func (*SyncFilterRule_ExcludeNamePattern) isSyncFilterRule_Rule() {}
+
+func (*SyncFilterRule_AddedWithinDays) isSyncFilterRule_Rule() {}
+
+func (*SyncFilterRule_ExcludeExplicit) isSyncFilterRule_Rule() {}
+
+type SyncFilterArtists struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArtistNames   []string               `protobuf:"bytes,1,rep,name=artist_names,json=artistNames,proto3" json:"artist_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncFilterArtists) Reset() {
+	*x = SyncFilterArtists{}
+	mi := &file_myncer_sync_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncFilterArtists) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncFilterArtists) ProtoMessage() {}
+
+func (x *SyncFilterArtists) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncFilterArtists.ProtoReflect.Descriptor instead.
+func (*SyncFilterArtists) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SyncFilterArtists) GetArtistNames() []string {
+	if x != nil {
+		return x.ArtistNames
+	}
+	return nil
+}
+
+type RetryPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Total number of attempts for a run, including the first one.
+	// 0 or 1 disables retries.
+	MaxAttempts int32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// Wait before the first retry. Doubles with each further retry.
+	// Defaults to 30 seconds.
+	InitialBackoffSeconds int32 `protobuf:"varint,2,opt,name=initial_backoff_seconds,json=initialBackoffSeconds,proto3" json:"initial_backoff_seconds,omitempty"`
+	// Upper bound on the wait between retries. Defaults to an hour.
+	MaxBackoffSeconds int32 `protobuf:"varint,3,opt,name=max_backoff_seconds,json=maxBackoffSeconds,proto3" json:"max_backoff_seconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_myncer_sync_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetInitialBackoffSeconds() int32 {
+	if x != nil {
+		return x.InitialBackoffSeconds
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxBackoffSeconds() int32 {
+	if x != nil {
+		return x.MaxBackoffSeconds
+	}
+	return 0
+}
+
+type SyncSchedule struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Interval SyncScheduleInterval   `protobuf:"varint,1,opt,name=interval,proto3,enum=myncer.SyncScheduleInterval" json:"interval,omitempty"`
+	// When the scheduler will next run the sync.
+	// Computed by the server, unset for manual syncs.
+	NextRunAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	// When the scheduler last started a run of the sync.
+	LastRunAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncSchedule) Reset() {
+	*x = SyncSchedule{}
+	mi := &file_myncer_sync_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncSchedule) ProtoMessage() {}
+
+func (x *SyncSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncSchedule.ProtoReflect.Descriptor instead.
+func (*SyncSchedule) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SyncSchedule) GetInterval() SyncScheduleInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_UNSPECIFIED
+}
+
+func (x *SyncSchedule) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *SyncSchedule) GetLastRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return nil
+}
+
+type SyncRun struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the sync that was run.
+	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	// The run id of this sync.
+	// This is expected to be randomly generated for each sync run.
+	RunId string `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// The status of this sync run.
+	SyncStatus SyncStatus `protobuf:"varint,3,opt,name=sync_status,json=syncStatus,proto3,enum=myncer.SyncStatus" json:"sync_status,omitempty"`
+	// Metadata which is fetched from SQL (for it's ACID compliance).
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Lista de canciones no encontradas durante la sincronización
+	UnmatchedSongs []*Song `protobuf:"bytes,6,rep,name=unmatched_songs,json=unmatchedSongs,proto3" json:"unmatched_songs,omitempty"`
+	// Mensaje de error detallado (ej. playlist eliminada)
+	ErrorMessage string `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// The last phase the run reached.
+	Phase SyncRunPhase `protobuf:"varint,8,opt,name=phase,proto3,enum=myncer.SyncRunPhase" json:"phase,omitempty"`
+	// Whether the run started making changes to a destination playlist.
+	DestinationModified bool `protobuf:"varint,9,opt,name=destination_modified,json=destinationModified,proto3" json:"destination_modified,omitempty"`
+	// Every attempt at executing this run, oldest first.
+	Attempts []*SyncRunAttempt `protobuf:"bytes,10,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	// Progress of the latest attempt.
+	Progress *SyncRunProgress `protobuf:"bytes,11,opt,name=progress,proto3" json:"progress,omitempty"`
+	// Per playlist outcome for syncs that write to several playlists.
+	TargetResults []*SyncRunTargetResult `protobuf:"bytes,12,rep,name=target_results,json=targetResults,proto3" json:"target_results,omitempty"`
+	// Conflicting changes found by a three-way merge.
+	Conflicts []*MergeConflict `protobuf:"bytes,13,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Kind      SyncRunKind      `protobuf:"varint,14,opt,name=kind,proto3,enum=myncer.SyncRunKind" json:"kind,omitempty"`
+	// The planned changes of a preview run.
+	Preview *SyncPreview `protobuf:"bytes,15,opt,name=preview,proto3" json:"preview,omitempty"`
+	// Source songs left out by the filter rules of the sync or marked to never be synced.
+	ExcludedSongs []*Song `protobuf:"bytes,16,rep,name=excluded_songs,json=excludedSongs,proto3" json:"excluded_songs,omitempty"`
+	// Matches that are probably wrong, for the user to review.
+	LowConfidenceMatches []*SongMatchResult `protobuf:"bytes,17,rep,name=low_confidence_matches,json=lowConfidenceMatches,proto3" json:"low_confidence_matches,omitempty"` // next: 18
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SyncRun) Reset() {
+	*x = SyncRun{}
+	mi := &file_myncer_sync_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRun) ProtoMessage() {}
+
+func (x *SyncRun) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRun.ProtoReflect.Descriptor instead.
+func (*SyncRun) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SyncRun) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *SyncRun) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *SyncRun) GetSyncStatus() SyncStatus {
+	if x != nil {
+		return x.SyncStatus
+	}
+	return SyncStatus_SYNC_STATUS_UNSPECIFIED
+}
+
+func (x *SyncRun) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SyncRun) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SyncRun) GetUnmatchedSongs() []*Song {
+	if x != nil {
+		return x.UnmatchedSongs
+	}
+	return nil
+}
+
+func (x *SyncRun) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *SyncRun) GetPhase() SyncRunPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return SyncRunPhase_SYNC_RUN_PHASE_UNSPECIFIED
+}
+
+func (x *SyncRun) GetDestinationModified() bool {
+	if x != nil {
+		return x.DestinationModified
+	}
+	return false
+}
+
+func (x *SyncRun) GetAttempts() []*SyncRunAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *SyncRun) GetProgress() *SyncRunProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *SyncRun) GetTargetResults() []*SyncRunTargetResult {
+	if x != nil {
+		return x.TargetResults
+	}
+	return nil
+}
+
+func (x *SyncRun) GetConflicts() []*MergeConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *SyncRun) GetKind() SyncRunKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SyncRunKind_SYNC_RUN_KIND_UNSPECIFIED
+}
+
+func (x *SyncRun) GetPreview() *SyncPreview {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
+}
+
+func (x *SyncRun) GetExcludedSongs() []*Song {
+	if x != nil {
+		return x.ExcludedSongs
+	}
+	return nil
+}
+
+func (x *SyncRun) GetLowConfidenceMatches() []*SongMatchResult {
+	if x != nil {
+		return x.LowConfidenceMatches
+	}
+	return nil
+}
+
+// The changes a sync would make.
+type SyncPreview struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The changes planned for each playlist the sync writes to.
+	Targets []*SyncPreviewTarget `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	// How each source song was matched on the destination datasource.
+	Matches       []*SongMatchResult `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncPreview) Reset() {
+	*x = SyncPreview{}
+	mi := &file_myncer_sync_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncPreview) ProtoMessage() {}
+
+func (x *SyncPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncPreview.ProtoReflect.Descriptor instead.
+func (*SyncPreview) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SyncPreview) GetTargets() []*SyncPreviewTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *SyncPreview) GetMatches() []*SongMatchResult {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type SyncPreviewTarget struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Target *MusicSource           `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// Whether every song would be removed before adding songs.
+	ClearsPlaylist bool    `protobuf:"varint,2,opt,name=clears_playlist,json=clearsPlaylist,proto3" json:"clears_playlist,omitempty"`
+	SongsToAdd     []*Song `protobuf:"bytes,3,rep,name=songs_to_add,json=songsToAdd,proto3" json:"songs_to_add,omitempty"`
+	SongsToRemove  []*Song `protobuf:"bytes,4,rep,name=songs_to_remove,json=songsToRemove,proto3" json:"songs_to_remove,omitempty"`
+	// Whether the songs would be reordered.
+	ReordersPlaylist bool `protobuf:"varint,5,opt,name=reorders_playlist,json=reordersPlaylist,proto3" json:"reorders_playlist,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SyncPreviewTarget) Reset() {
+	*x = SyncPreviewTarget{}
+	mi := &file_myncer_sync_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncPreviewTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncPreviewTarget) ProtoMessage() {}
+
+func (x *SyncPreviewTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncPreviewTarget.ProtoReflect.Descriptor instead.
+func (*SyncPreviewTarget) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SyncPreviewTarget) GetTarget() *MusicSource {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *SyncPreviewTarget) GetClearsPlaylist() bool {
+	if x != nil {
+		return x.ClearsPlaylist
+	}
+	return false
+}
+
+func (x *SyncPreviewTarget) GetSongsToAdd() []*Song {
+	if x != nil {
+		return x.SongsToAdd
+	}
+	return nil
+}
+
+func (x *SyncPreviewTarget) GetSongsToRemove() []*Song {
+	if x != nil {
+		return x.SongsToRemove
+	}
+	return nil
+}
+
+func (x *SyncPreviewTarget) GetReordersPlaylist() bool {
+	if x != nil {
+		return x.ReordersPlaylist
+	}
+	return false
+}
+
+// The outcome of a sync run for one of the playlists it writes to.
+type SyncRunTargetResult struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Target *MusicSource           `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// Songs that could not be found on the target's datasource.
+	UnmatchedSongs []*Song `protobuf:"bytes,2,rep,name=unmatched_songs,json=unmatchedSongs,proto3" json:"unmatched_songs,omitempty"`
+	AddedSongs     int32   `protobuf:"varint,3,opt,name=added_songs,json=addedSongs,proto3" json:"added_songs,omitempty"`
+	RemovedSongs   int32   `protobuf:"varint,4,opt,name=removed_songs,json=removedSongs,proto3" json:"removed_songs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SyncRunTargetResult) Reset() {
+	*x = SyncRunTargetResult{}
+	mi := &file_myncer_sync_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncRunTargetResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRunTargetResult) ProtoMessage() {}
+
+func (x *SyncRunTargetResult) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRunTargetResult.ProtoReflect.Descriptor instead.
+func (*SyncRunTargetResult) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SyncRunTargetResult) GetTarget() *MusicSource {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *SyncRunTargetResult) GetUnmatchedSongs() []*Song {
+	if x != nil {
+		return x.UnmatchedSongs
+	}
+	return nil
+}
+
+func (x *SyncRunTargetResult) GetAddedSongs() int32 {
+	if x != nil {
+		return x.AddedSongs
+	}
+	return 0
+}
+
+func (x *SyncRunTargetResult) GetRemovedSongs() int32 {
+	if x != nil {
+		return x.RemovedSongs
+	}
+	return 0
+}
+
+type SyncRunProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of songs to search for on the destination datasource.
+	TotalSongs     int32 `protobuf:"varint,1,opt,name=total_songs,json=totalSongs,proto3" json:"total_songs,omitempty"`
+	MatchedSongs   int32 `protobuf:"varint,2,opt,name=matched_songs,json=matchedSongs,proto3" json:"matched_songs,omitempty"`
+	UnmatchedSongs int32 `protobuf:"varint,3,opt,name=unmatched_songs,json=unmatchedSongs,proto3" json:"unmatched_songs,omitempty"`
+	// Number of songs added to the destination playlist.
+	AddedSongs int32 `protobuf:"varint,4,opt,name=added_songs,json=addedSongs,proto3" json:"added_songs,omitempty"`
+	// Number of songs removed from the destination playlist.
+	RemovedSongs int32 `protobuf:"varint,5,opt,name=removed_songs,json=removedSongs,proto3" json:"removed_songs,omitempty"`
+	// Number of matched songs that are probably wrong.
+	LowConfidenceSongs int32 `protobuf:"varint,6,opt,name=low_confidence_songs,json=lowConfidenceSongs,proto3" json:"low_confidence_songs,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SyncRunProgress) Reset() {
+	*x = SyncRunProgress{}
+	mi := &file_myncer_sync_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncRunProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRunProgress) ProtoMessage() {}
+
+func (x *SyncRunProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRunProgress.ProtoReflect.Descriptor instead.
+func (*SyncRunProgress) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SyncRunProgress) GetTotalSongs() int32 {
+	if x != nil {
+		return x.TotalSongs
+	}
+	return 0
+}
+
+func (x *SyncRunProgress) GetMatchedSongs() int32 {
+	if x != nil {
+		return x.MatchedSongs
+	}
+	return 0
+}
+
+func (x *SyncRunProgress) GetUnmatchedSongs() int32 {
+	if x != nil {
+		return x.UnmatchedSongs
+	}
+	return 0
+}
+
+func (x *SyncRunProgress) GetAddedSongs() int32 {
+	if x != nil {
+		return x.AddedSongs
+	}
+	return 0
+}
+
+func (x *SyncRunProgress) GetRemovedSongs() int32 {
+	if x != nil {
+		return x.RemovedSongs
+	}
+	return 0
+}
+
+func (x *SyncRunProgress) GetLowConfidenceSongs() int32 {
+	if x != nil {
+		return x.LowConfidenceSongs
+	}
+	return 0
+}
+
+// Something that happened while a sync run was running.
+type SyncRunEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RunId     string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*SyncRunEvent_Phase
+	//	*SyncRunEvent_SongMatchResult
+	Event isSyncRunEvent_Event `protobuf_oneof:"event"`
+	// Progress of the run after the event.
+	Progress      *SyncRunProgress `protobuf:"bytes,5,opt,name=progress,proto3" json:"progress,omitempty"` // next: 6
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncRunEvent) Reset() {
+	*x = SyncRunEvent{}
+	mi := &file_myncer_sync_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncRunEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRunEvent) ProtoMessage() {}
+
+func (x *SyncRunEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRunEvent.ProtoReflect.Descriptor instead.
+func (*SyncRunEvent) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SyncRunEvent) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *SyncRunEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SyncRunEvent) GetEvent() isSyncRunEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *SyncRunEvent) GetPhase() SyncRunPhase {
+	if x != nil {
+		if x, ok := x.Event.(*SyncRunEvent_Phase); ok {
+			return x.Phase
+		}
+	}
+	return SyncRunPhase_SYNC_RUN_PHASE_UNSPECIFIED
+}
+
+func (x *SyncRunEvent) GetSongMatchResult() *SongMatchResult {
+	if x != nil {
+		if x, ok := x.Event.(*SyncRunEvent_SongMatchResult); ok {
+			return x.SongMatchResult
+		}
+	}
+	return nil
+}
+
+func (x *SyncRunEvent) GetProgress() *SyncRunProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type isSyncRunEvent_Event interface {
+	isSyncRunEvent_Event()
+}
+
+type SyncRunEvent_Phase struct {
+	// The run entered a new phase.
+	Phase SyncRunPhase `protobuf:"varint,3,opt,name=phase,proto3,enum=myncer.SyncRunPhase,oneof"`
+}
+
+type SyncRunEvent_SongMatchResult struct {
+	// A song was searched for on the destination datasource.
+	SongMatchResult *SongMatchResult `protobuf:"bytes,4,opt,name=song_match_result,json=songMatchResult,proto3,oneof"`
+}
+
+func (*SyncRunEvent_Phase) isSyncRunEvent_Event() {}
+
+func (*SyncRunEvent_SongMatchResult) isSyncRunEvent_Event() {}
+
+type SongMatchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The song as found in the source playlist.
+	SourceSong *Song `protobuf:"bytes,1,opt,name=source_song,json=sourceSong,proto3" json:"source_song,omitempty"`
+	Matched    bool  `protobuf:"varint,2,opt,name=matched,proto3" json:"matched,omitempty"`
+	// The id of the matched song on the destination datasource. Empty if unmatched.
+	DestinationSongId string `protobuf:"bytes,3,opt,name=destination_song_id,json=destinationSongId,proto3" json:"destination_song_id,omitempty"`
+	// How similar the matched song is to the source song, from 0 to 100.
+	Score float64 `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	// Unset if unmatched.
+	DestinationSong *Song `protobuf:"bytes,5,opt,name=destination_song,json=destinationSong,proto3" json:"destination_song,omitempty"`
+	// Other songs the song could have been matched to, best first. For unmatched songs these are the
+	// songs the matching profile did not accept.
+	RunnerUps []*SongMatchCandidate `protobuf:"bytes,6,rep,name=runner_ups,json=runnerUps,proto3" json:"runner_ups,omitempty"`
+	// Whether the match scored below the confident match score of the matching profile, meaning it
+	// is probably wrong. Songs pinned by the user are never low confidence.
+	LowConfidence bool `protobuf:"varint,7,opt,name=low_confidence,json=lowConfidence,proto3" json:"low_confidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SongMatchResult) Reset() {
+	*x = SongMatchResult{}
+	mi := &file_myncer_sync_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SongMatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongMatchResult) ProtoMessage() {}
+
+func (x *SongMatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongMatchResult.ProtoReflect.Descriptor instead.
+func (*SongMatchResult) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SongMatchResult) GetSourceSong() *Song {
+	if x != nil {
+		return x.SourceSong
+	}
+	return nil
+}
+
+func (x *SongMatchResult) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *SongMatchResult) GetDestinationSongId() string {
+	if x != nil {
+		return x.DestinationSongId
+	}
+	return ""
+}
+
+func (x *SongMatchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SongMatchResult) GetDestinationSong() *Song {
+	if x != nil {
+		return x.DestinationSong
+	}
+	return nil
+}
+
+func (x *SongMatchResult) GetRunnerUps() []*SongMatchCandidate {
+	if x != nil {
+		return x.RunnerUps
+	}
+	return nil
+}
+
+func (x *SongMatchResult) GetLowConfidence() bool {
+	if x != nil {
+		return x.LowConfidence
+	}
+	return false
+}
+
+type SyncRunAttempt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1-indexed.
+	AttemptNumber int32                  `protobuf:"varint,1,opt,name=attempt_number,json=attemptNumber,proto3" json:"attempt_number,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// Empty if the attempt succeeded.
+	ErrorMessage string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// Whether the error was considered transient (e.g. rate limits, server errors).
+	Retryable bool `protobuf:"varint,5,opt,name=retryable,proto3" json:"retryable,omitempty"`
+	// When the next attempt is scheduled, if any.
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncRunAttempt) Reset() {
+	*x = SyncRunAttempt{}
+	mi := &file_myncer_sync_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncRunAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRunAttempt) ProtoMessage() {}
+
+func (x *SyncRunAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRunAttempt.ProtoReflect.Descriptor instead.
+func (*SyncRunAttempt) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SyncRunAttempt) GetAttemptNumber() int32 {
+	if x != nil {
+		return x.AttemptNumber
+	}
+	return 0
+}
+
+func (x *SyncRunAttempt) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *SyncRunAttempt) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *SyncRunAttempt) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *SyncRunAttempt) GetRetryable() bool {
+	if x != nil {
+		return x.Retryable
+	}
+	return false
+}
+
+func (x *SyncRunAttempt) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+// Representative of source -> destination.
+type OneWaySync struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Source      *MusicSource           `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Destination *MusicSource           `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	// When true, it overwrites the destination songs.
+	// If a song exists in source but not in destination, the song will be lost from destination.
+	// Ignored in diff mode.
+	OverwriteExisting bool           `protobuf:"varint,3,opt,name=overwrite_existing,json=overwriteExisting,proto3" json:"overwrite_existing,omitempty"`
+	Mode              OneWaySyncMode `protobuf:"varint,4,opt,name=mode,proto3,enum=myncer.OneWaySyncMode" json:"mode,omitempty"`
+	// Diff mode only. When true, songs in the destination that are not in the source are removed.
+	RemoveExtraSongs bool `protobuf:"varint,5,opt,name=remove_extra_songs,json=removeExtraSongs,proto3" json:"remove_extra_songs,omitempty"`
+	// How the destination is ordered after each run.
+	Order         PlaylistOrder `protobuf:"varint,6,opt,name=order,proto3,enum=myncer.PlaylistOrder" json:"order,omitempty"` // next: 7
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OneWaySync) Reset() {
+	*x = OneWaySync{}
+	mi := &file_myncer_sync_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OneWaySync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneWaySync) ProtoMessage() {}
+
+func (x *OneWaySync) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneWaySync.ProtoReflect.Descriptor instead.
+func (*OneWaySync) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OneWaySync) GetSource() *MusicSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *OneWaySync) GetDestination() *MusicSource {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *OneWaySync) GetOverwriteExisting() bool {
+	if x != nil {
+		return x.OverwriteExisting
+	}
+	return false
+}
+
+func (x *OneWaySync) GetMode() OneWaySyncMode {
+	if x != nil {
+		return x.Mode
+	}
+	return OneWaySyncMode_ONE_WAY_SYNC_MODE_UNSPECIFIED
+}
+
+func (x *OneWaySync) GetRemoveExtraSongs() bool {
+	if x != nil {
+		return x.RemoveExtraSongs
+	}
+	return false
+}
+
+func (x *OneWaySync) GetOrder() PlaylistOrder {
+	if x != nil {
+		return x.Order
+	}
+	return PlaylistOrder_PLAYLIST_ORDER_UNSPECIFIED
+}
+
+// Representative of one source -> multiple destinations.
+// Behaves like a one-way sync to each destination, but the source is only fetched and normalized
+// once.
+type FanOutSync struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Source       *MusicSource           `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Destinations []*MusicSource         `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Applies to every destination, see OneWaySync.
+	OverwriteExisting bool           `protobuf:"varint,3,opt,name=overwrite_existing,json=overwriteExisting,proto3" json:"overwrite_existing,omitempty"`
+	Mode              OneWaySyncMode `protobuf:"varint,4,opt,name=mode,proto3,enum=myncer.OneWaySyncMode" json:"mode,omitempty"`
+	RemoveExtraSongs  bool           `protobuf:"varint,5,opt,name=remove_extra_songs,json=removeExtraSongs,proto3" json:"remove_extra_songs,omitempty"`
+	Order             PlaylistOrder  `protobuf:"varint,6,opt,name=order,proto3,enum=myncer.PlaylistOrder" json:"order,omitempty"` // next: 7
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *FanOutSync) Reset() {
+	*x = FanOutSync{}
+	mi := &file_myncer_sync_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FanOutSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FanOutSync) ProtoMessage() {}
+
+func (x *FanOutSync) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FanOutSync.ProtoReflect.Descriptor instead.
+func (*FanOutSync) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FanOutSync) GetSource() *MusicSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *FanOutSync) GetDestinations() []*MusicSource {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *FanOutSync) GetOverwriteExisting() bool {
+	if x != nil {
+		return x.OverwriteExisting
+	}
+	return false
+}
+
+func (x *FanOutSync) GetMode() OneWaySyncMode {
+	if x != nil {
+		return x.Mode
+	}
+	return OneWaySyncMode_ONE_WAY_SYNC_MODE_UNSPECIFIED
+}
+
+func (x *FanOutSync) GetRemoveExtraSongs() bool {
+	if x != nil {
+		return x.RemoveExtraSongs
+	}
+	return false
+}
+
+func (x *FanOutSync) GetOrder() PlaylistOrder {
+	if x != nil {
+		return x.Order
+	}
+	return PlaylistOrder_PLAYLIST_ORDER_UNSPECIFIED
+}
+
+type CreateSyncRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The sync to create.
+	//
+	// Types that are valid to be assigned to SyncVariant:
+	//
+	//	*CreateSyncRequest_OneWaySync
+	//	*CreateSyncRequest_PlaylistMergeSync
+	//	*CreateSyncRequest_FanOutSync
+	SyncVariant isCreateSyncRequest_SyncVariant `protobuf_oneof:"sync_variant"`
+	// How often the sync should run automatically.
+	// Leave unspecified for syncs that are only run manually.
+	ScheduleInterval SyncScheduleInterval `protobuf:"varint,3,opt,name=schedule_interval,json=scheduleInterval,proto3,enum=myncer.SyncScheduleInterval" json:"schedule_interval,omitempty"`
+	// How failed runs are retried. Leave unset to never retry.
+	RetryPolicy *RetryPolicy `protobuf:"bytes,4,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// Creates a new playlist on the datasource of the sync's destination and uses it as the destination.
+	// The destination playlist id must be left empty.
+	NewDestinationPlaylist *NewPlaylist `protobuf:"bytes,5,opt,name=new_destination_playlist,json=newDestinationPlaylist,proto3" json:"new_destination_playlist,omitempty"`
+	// Which songs of the sources the sync carries. Leave empty to sync every song.
+	FilterRules []*SyncFilterRule `protobuf:"bytes,7,rep,name=filter_rules,json=filterRules,proto3" json:"filter_rules,omitempty"`
+	// How songs are matched across datasources. Leave unset for the default profile.
+	MatchingProfile *MatchingProfile `protobuf:"bytes,8,opt,name=matching_profile,json=matchingProfile,proto3" json:"matching_profile,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateSyncRequest) Reset() {
+	*x = CreateSyncRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSyncRequest) ProtoMessage() {}
+
+func (x *CreateSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSyncRequest.ProtoReflect.Descriptor instead.
+func (*CreateSyncRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateSyncRequest) GetSyncVariant() isCreateSyncRequest_SyncVariant {
+	if x != nil {
+		return x.SyncVariant
+	}
+	return nil
+}
+
+func (x *CreateSyncRequest) GetOneWaySync() *OneWaySync {
+	if x != nil {
+		if x, ok := x.SyncVariant.(*CreateSyncRequest_OneWaySync); ok {
+			return x.OneWaySync
+		}
+	}
+	return nil
+}
+
+func (x *CreateSyncRequest) GetPlaylistMergeSync() *PlaylistMergeSync {
+	if x != nil {
+		if x, ok := x.SyncVariant.(*CreateSyncRequest_PlaylistMergeSync); ok {
+			return x.PlaylistMergeSync
+		}
+	}
+	return nil
+}
+
+func (x *CreateSyncRequest) GetFanOutSync() *FanOutSync {
+	if x != nil {
+		if x, ok := x.SyncVariant.(*CreateSyncRequest_FanOutSync); ok {
+			return x.FanOutSync
+		}
+	}
+	return nil
+}
+
+func (x *CreateSyncRequest) GetScheduleInterval() SyncScheduleInterval {
+	if x != nil {
+		return x.ScheduleInterval
+	}
+	return SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_UNSPECIFIED
+}
+
+func (x *CreateSyncRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *CreateSyncRequest) GetNewDestinationPlaylist() *NewPlaylist {
+	if x != nil {
+		return x.NewDestinationPlaylist
+	}
+	return nil
+}
+
+func (x *CreateSyncRequest) GetFilterRules() []*SyncFilterRule {
+	if x != nil {
+		return x.FilterRules
+	}
+	return nil
+}
+
+func (x *CreateSyncRequest) GetMatchingProfile() *MatchingProfile {
+	if x != nil {
+		return x.MatchingProfile
+	}
+	return nil
+}
+
+type isCreateSyncRequest_SyncVariant interface {
+	isCreateSyncRequest_SyncVariant()
+}
+
+type CreateSyncRequest_OneWaySync struct {
+	OneWaySync *OneWaySync `protobuf:"bytes,1,opt,name=one_way_sync,json=oneWaySync,proto3,oneof"`
+}
+
+type CreateSyncRequest_PlaylistMergeSync struct {
+	PlaylistMergeSync *PlaylistMergeSync `protobuf:"bytes,2,opt,name=playlist_merge_sync,json=playlistMergeSync,proto3,oneof"`
+}
+
+type CreateSyncRequest_FanOutSync struct {
+	FanOutSync *FanOutSync `protobuf:"bytes,6,opt,name=fan_out_sync,json=fanOutSync,proto3,oneof"`
+}
+
+func (*CreateSyncRequest_OneWaySync) isCreateSyncRequest_SyncVariant() {}
+
+func (*CreateSyncRequest_PlaylistMergeSync) isCreateSyncRequest_SyncVariant() {}
+
+func (*CreateSyncRequest_FanOutSync) isCreateSyncRequest_SyncVariant() {}
+
+type NewPlaylist struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Public        bool                   `protobuf:"varint,3,opt,name=public,proto3" json:"public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewPlaylist) Reset() {
+	*x = NewPlaylist{}
+	mi := &file_myncer_sync_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewPlaylist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewPlaylist) ProtoMessage() {}
+
+func (x *NewPlaylist) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewPlaylist.ProtoReflect.Descriptor instead.
+func (*NewPlaylist) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NewPlaylist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NewPlaylist) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NewPlaylist) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+type CreateSyncResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created sync.
+	Sync          *Sync `protobuf:"bytes,1,opt,name=sync,proto3" json:"sync,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSyncResponse) Reset() {
+	*x = CreateSyncResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSyncResponse) ProtoMessage() {}
+
+func (x *CreateSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSyncResponse.ProtoReflect.Descriptor instead.
+func (*CreateSyncResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateSyncResponse) GetSync() *Sync {
+	if x != nil {
+		return x.Sync
+	}
+	return nil
+}
+
+type UpdateSyncRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the sync to update.
+	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	// The new settings of the sync, see CreateSyncRequest.
+	// Every setting is replaced, so unchanged settings must be passed as they are.
+	//
+	// Types that are valid to be assigned to SyncVariant:
+	//
+	//	*UpdateSyncRequest_OneWaySync
+	//	*UpdateSyncRequest_PlaylistMergeSync
+	//	*UpdateSyncRequest_FanOutSync
+	SyncVariant isUpdateSyncRequest_SyncVariant `protobuf_oneof:"sync_variant"`
+	// The next scheduled run is kept unless the interval changes.
+	ScheduleInterval SyncScheduleInterval `protobuf:"varint,5,opt,name=schedule_interval,json=scheduleInterval,proto3,enum=myncer.SyncScheduleInterval" json:"schedule_interval,omitempty"`
+	RetryPolicy      *RetryPolicy         `protobuf:"bytes,6,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	FilterRules      []*SyncFilterRule    `protobuf:"bytes,7,rep,name=filter_rules,json=filterRules,proto3" json:"filter_rules,omitempty"`
+	MatchingProfile  *MatchingProfile     `protobuf:"bytes,8,opt,name=matching_profile,json=matchingProfile,proto3" json:"matching_profile,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateSyncRequest) Reset() {
+	*x = UpdateSyncRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSyncRequest) ProtoMessage() {}
+
+func (x *UpdateSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSyncRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSyncRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateSyncRequest) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *UpdateSyncRequest) GetSyncVariant() isUpdateSyncRequest_SyncVariant {
+	if x != nil {
+		return x.SyncVariant
+	}
+	return nil
+}
+
+func (x *UpdateSyncRequest) GetOneWaySync() *OneWaySync {
+	if x != nil {
+		if x, ok := x.SyncVariant.(*UpdateSyncRequest_OneWaySync); ok {
+			return x.OneWaySync
+		}
+	}
+	return nil
+}
+
+func (x *UpdateSyncRequest) GetPlaylistMergeSync() *PlaylistMergeSync {
+	if x != nil {
+		if x, ok := x.SyncVariant.(*UpdateSyncRequest_PlaylistMergeSync); ok {
+			return x.PlaylistMergeSync
+		}
+	}
+	return nil
+}
+
+func (x *UpdateSyncRequest) GetFanOutSync() *FanOutSync {
+	if x != nil {
+		if x, ok := x.SyncVariant.(*UpdateSyncRequest_FanOutSync); ok {
+			return x.FanOutSync
+		}
+	}
+	return nil
+}
+
+func (x *UpdateSyncRequest) GetScheduleInterval() SyncScheduleInterval {
+	if x != nil {
+		return x.ScheduleInterval
+	}
+	return SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_UNSPECIFIED
+}
+
+func (x *UpdateSyncRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *UpdateSyncRequest) GetFilterRules() []*SyncFilterRule {
+	if x != nil {
+		return x.FilterRules
+	}
+	return nil
+}
+
+func (x *UpdateSyncRequest) GetMatchingProfile() *MatchingProfile {
+	if x != nil {
+		return x.MatchingProfile
+	}
+	return nil
+}
+
+type isUpdateSyncRequest_SyncVariant interface {
+	isUpdateSyncRequest_SyncVariant()
+}
+
+type UpdateSyncRequest_OneWaySync struct {
+	OneWaySync *OneWaySync `protobuf:"bytes,2,opt,name=one_way_sync,json=oneWaySync,proto3,oneof"`
+}
+
+type UpdateSyncRequest_PlaylistMergeSync struct {
+	PlaylistMergeSync *PlaylistMergeSync `protobuf:"bytes,3,opt,name=playlist_merge_sync,json=playlistMergeSync,proto3,oneof"`
+}
+
+type UpdateSyncRequest_FanOutSync struct {
+	FanOutSync *FanOutSync `protobuf:"bytes,4,opt,name=fan_out_sync,json=fanOutSync,proto3,oneof"`
+}
+
+func (*UpdateSyncRequest_OneWaySync) isUpdateSyncRequest_SyncVariant() {}
+
+func (*UpdateSyncRequest_PlaylistMergeSync) isUpdateSyncRequest_SyncVariant() {}
+
+func (*UpdateSyncRequest_FanOutSync) isUpdateSyncRequest_SyncVariant() {}
+
+type UpdateSyncResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated sync.
+	Sync          *Sync `protobuf:"bytes,1,opt,name=sync,proto3" json:"sync,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSyncResponse) Reset() {
+	*x = UpdateSyncResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSyncResponse) ProtoMessage() {}
+
+func (x *UpdateSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSyncResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSyncResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateSyncResponse) GetSync() *Sync {
+	if x != nil {
+		return x.Sync
+	}
+	return nil
+}
+
+type PauseSyncRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SyncId string                 `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	// Why the sync is paused, e.g. the playlist is being reorganized by hand.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseSyncRequest) Reset() {
+	*x = PauseSyncRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSyncRequest) ProtoMessage() {}
+
+func (x *PauseSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSyncRequest.ProtoReflect.Descriptor instead.
+func (*PauseSyncRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PauseSyncRequest) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *PauseSyncRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PauseSyncResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The paused sync.
+	Sync          *Sync `protobuf:"bytes,1,opt,name=sync,proto3" json:"sync,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseSyncResponse) Reset() {
+	*x = PauseSyncResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSyncResponse) ProtoMessage() {}
+
+func (x *PauseSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSyncResponse.ProtoReflect.Descriptor instead.
+func (*PauseSyncResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PauseSyncResponse) GetSync() *Sync {
+	if x != nil {
+		return x.Sync
+	}
+	return nil
+}
+
+type ResumeSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SyncId        string                 `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSyncRequest) Reset() {
+	*x = ResumeSyncRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSyncRequest) ProtoMessage() {}
+
+func (x *ResumeSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSyncRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSyncRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ResumeSyncRequest) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+type ResumeSyncResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resumed sync.
+	Sync          *Sync `protobuf:"bytes,1,opt,name=sync,proto3" json:"sync,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSyncResponse) Reset() {
+	*x = ResumeSyncResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSyncResponse) ProtoMessage() {}
+
+func (x *ResumeSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSyncResponse.ProtoReflect.Descriptor instead.
+func (*ResumeSyncResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ResumeSyncResponse) GetSync() *Sync {
+	if x != nil {
+		return x.Sync
+	}
+	return nil
+}
+
+type DeleteSyncRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the sync to delete.
+	SyncId        string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSyncRequest) Reset() {
+	*x = DeleteSyncRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSyncRequest) ProtoMessage() {}
+
+func (x *DeleteSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSyncRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSyncRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteSyncRequest) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+type DeleteSyncResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the deleted sync.
+	SyncId        string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSyncResponse) Reset() {
+	*x = DeleteSyncResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSyncResponse) ProtoMessage() {}
+
+func (x *DeleteSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSyncResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSyncResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteSyncResponse) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+type ListSyncsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSyncsRequest) Reset() {
+	*x = ListSyncsRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSyncsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyncsRequest) ProtoMessage() {}
+
+func (x *ListSyncsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyncsRequest.ProtoReflect.Descriptor instead.
+func (*ListSyncsRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{32}
+}
+
+type ListSyncsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of syncs for the current user.
+	Syncs         []*Sync `protobuf:"bytes,1,rep,name=syncs,proto3" json:"syncs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSyncsResponse) Reset() {
+	*x = ListSyncsResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSyncsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyncsResponse) ProtoMessage() {}
+
+func (x *ListSyncsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyncsResponse.ProtoReflect.Descriptor instead.
+func (*ListSyncsResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListSyncsResponse) GetSyncs() []*Sync {
+	if x != nil {
+		return x.Syncs
+	}
+	return nil
+}
+
+type GetSyncRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the sync to get.
+	SyncId        string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncRequest) Reset() {
+	*x = GetSyncRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncRequest) ProtoMessage() {}
+
+func (x *GetSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncRequest.ProtoReflect.Descriptor instead.
+func (*GetSyncRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetSyncRequest) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+type GetSyncResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The requested sync.
+	Sync          *Sync `protobuf:"bytes,1,opt,name=sync,proto3" json:"sync,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncResponse) Reset() {
+	*x = GetSyncResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncResponse) ProtoMessage() {}
+
+func (x *GetSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncResponse.ProtoReflect.Descriptor instead.
+func (*GetSyncResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetSyncResponse) GetSync() *Sync {
+	if x != nil {
+		return x.Sync
+	}
+	return nil
+}
+
+type RunSyncRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the sync to run.
+	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	// Plans the sync without changing any playlist.
+	// The plan is stored on the sync run, which can be watched like any other run.
+	// Paused syncs can only be run as a dry run.
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunSyncRequest) Reset() {
+	*x = RunSyncRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunSyncRequest) ProtoMessage() {}
+
+func (x *RunSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunSyncRequest.ProtoReflect.Descriptor instead.
+func (*RunSyncRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RunSyncRequest) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *RunSyncRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RunSyncResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the sync that was run.
+	SyncId string     `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	Status SyncStatus `protobuf:"varint,2,opt,name=status,proto3,enum=myncer.SyncStatus" json:"status,omitempty"`
+	// If the sync failed, this will contain the error message.
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// The sync run that was queued.
+	RunId         string `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunSyncResponse) Reset() {
+	*x = RunSyncResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunSyncResponse) ProtoMessage() {}
+
+func (x *RunSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunSyncResponse.ProtoReflect.Descriptor instead.
+func (*RunSyncResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RunSyncResponse) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *RunSyncResponse) GetStatus() SyncStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SyncStatus_SYNC_STATUS_UNSPECIFIED
+}
+
+func (x *RunSyncResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *RunSyncResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type ListSyncRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSyncRunsRequest) Reset() {
+	*x = ListSyncRunsRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSyncRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyncRunsRequest) ProtoMessage() {}
+
+func (x *ListSyncRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyncRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListSyncRunsRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{38}
+}
+
+type ListSyncRunsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of sync runs for the current user.
+	SyncRuns      []*SyncRun `protobuf:"bytes,1,rep,name=sync_runs,json=syncRuns,proto3" json:"sync_runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSyncRunsResponse) Reset() {
+	*x = ListSyncRunsResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSyncRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyncRunsResponse) ProtoMessage() {}
+
+func (x *ListSyncRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyncRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListSyncRunsResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListSyncRunsResponse) GetSyncRuns() []*SyncRun {
+	if x != nil {
+		return x.SyncRuns
+	}
+	return nil
+}
+
+type CancelSyncRunRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the sync run to cancel.
+	RunId         string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSyncRunRequest) Reset() {
+	*x = CancelSyncRunRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSyncRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSyncRunRequest) ProtoMessage() {}
+
+func (x *CancelSyncRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSyncRunRequest.ProtoReflect.Descriptor instead.
+func (*CancelSyncRunRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CancelSyncRunRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type CancelSyncRunResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	RunId string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// CANCELLED if the run had not started yet.
+	// Otherwise RUNNING, and the run stops at the next song or datasource call.
+	Status        SyncStatus `protobuf:"varint,2,opt,name=status,proto3,enum=myncer.SyncStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSyncRunResponse) Reset() {
+	*x = CancelSyncRunResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSyncRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSyncRunResponse) ProtoMessage() {}
+
+func (x *CancelSyncRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSyncRunResponse.ProtoReflect.Descriptor instead.
+func (*CancelSyncRunResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CancelSyncRunResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *CancelSyncRunResponse) GetStatus() SyncStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SyncStatus_SYNC_STATUS_UNSPECIFIED
+}
+
+type WatchSyncRunRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the sync run to watch.
+	RunId         string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSyncRunRequest) Reset() {
+	*x = WatchSyncRunRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSyncRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSyncRunRequest) ProtoMessage() {}
+
+func (x *WatchSyncRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSyncRunRequest.ProtoReflect.Descriptor instead.
+func (*WatchSyncRunRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WatchSyncRunRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type WatchSyncRunResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Update:
+	//
+	//	*WatchSyncRunResponse_SyncRun
+	//	*WatchSyncRunResponse_Event
+	Update        isWatchSyncRunResponse_Update `protobuf_oneof:"update"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSyncRunResponse) Reset() {
+	*x = WatchSyncRunResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSyncRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSyncRunResponse) ProtoMessage() {}
+
+func (x *WatchSyncRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSyncRunResponse.ProtoReflect.Descriptor instead.
+func (*WatchSyncRunResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WatchSyncRunResponse) GetUpdate() isWatchSyncRunResponse_Update {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *WatchSyncRunResponse) GetSyncRun() *SyncRun {
+	if x != nil {
+		if x, ok := x.Update.(*WatchSyncRunResponse_SyncRun); ok {
+			return x.SyncRun
+		}
+	}
+	return nil
+}
+
+func (x *WatchSyncRunResponse) GetEvent() *SyncRunEvent {
+	if x != nil {
+		if x, ok := x.Update.(*WatchSyncRunResponse_Event); ok {
+			return x.Event
+		}
+	}
+	return nil
+}
+
+type isWatchSyncRunResponse_Update interface {
+	isWatchSyncRunResponse_Update()
+}
+
+type WatchSyncRunResponse_SyncRun struct {
+	// The run as currently stored.
+	// Sent first so that late subscribers catch up, and again once the run finishes.
+	SyncRun *SyncRun `protobuf:"bytes,1,opt,name=sync_run,json=syncRun,proto3,oneof"`
+}
+
+type WatchSyncRunResponse_Event struct {
+	// Sent as the run progresses.
+	Event *SyncRunEvent `protobuf:"bytes,2,opt,name=event,proto3,oneof"`
+}
+
+func (*WatchSyncRunResponse_SyncRun) isWatchSyncRunResponse_Update() {}
+
+func (*WatchSyncRunResponse_Event) isWatchSyncRunResponse_Update() {}
+
+// The songs of a playlist before they were changed.
+type PlaylistSnapshot struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The sync run about to change the playlist.
+	// Empty for snapshots taken before restoring another snapshot.
+	SyncId   string       `protobuf:"bytes,3,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	RunId    string       `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Playlist *MusicSource `protobuf:"bytes,5,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	// In playlist order.
+	Songs         []*Song                `protobuf:"bytes,6,rep,name=songs,proto3" json:"songs,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaylistSnapshot) Reset() {
+	*x = PlaylistSnapshot{}
+	mi := &file_myncer_sync_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaylistSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistSnapshot) ProtoMessage() {}
+
+func (x *PlaylistSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistSnapshot.ProtoReflect.Descriptor instead.
+func (*PlaylistSnapshot) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PlaylistSnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlaylistSnapshot) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PlaylistSnapshot) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *PlaylistSnapshot) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *PlaylistSnapshot) GetPlaylist() *MusicSource {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+func (x *PlaylistSnapshot) GetSongs() []*Song {
+	if x != nil {
+		return x.Songs
+	}
+	return nil
+}
+
+func (x *PlaylistSnapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListPlaylistSnapshotsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only lists the snapshots of the playlist if set.
+	Playlist *MusicSource `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	// Only lists the snapshots taken by the sync run if set.
+	RunId         string `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlaylistSnapshotsRequest) Reset() {
+	*x = ListPlaylistSnapshotsRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlaylistSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaylistSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListPlaylistSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaylistSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlaylistSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListPlaylistSnapshotsRequest) GetPlaylist() *MusicSource {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+func (x *ListPlaylistSnapshotsRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type ListPlaylistSnapshotsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first.
+	Snapshots     []*PlaylistSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlaylistSnapshotsResponse) Reset() {
+	*x = ListPlaylistSnapshotsResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlaylistSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaylistSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListPlaylistSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaylistSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlaylistSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListPlaylistSnapshotsResponse) GetSnapshots() []*PlaylistSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type DiffPlaylistSnapshotsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotId string                 `protobuf:"bytes,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	// The snapshot to compare against.
+	// If empty, the snapshot is compared against the playlist as it is now.
+	OtherSnapshotId string `protobuf:"bytes,2,opt,name=other_snapshot_id,json=otherSnapshotId,proto3" json:"other_snapshot_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DiffPlaylistSnapshotsRequest) Reset() {
+	*x = DiffPlaylistSnapshotsRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffPlaylistSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffPlaylistSnapshotsRequest) ProtoMessage() {}
+
+func (x *DiffPlaylistSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffPlaylistSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*DiffPlaylistSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DiffPlaylistSnapshotsRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *DiffPlaylistSnapshotsRequest) GetOtherSnapshotId() string {
+	if x != nil {
+		return x.OtherSnapshotId
+	}
+	return ""
+}
+
+type DiffPlaylistSnapshotsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Songs the other side has that the snapshot doesn't.
+	AddedSongs []*Song `protobuf:"bytes,1,rep,name=added_songs,json=addedSongs,proto3" json:"added_songs,omitempty"`
+	// Songs the snapshot has that the other side doesn't.
+	RemovedSongs  []*Song `protobuf:"bytes,2,rep,name=removed_songs,json=removedSongs,proto3" json:"removed_songs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffPlaylistSnapshotsResponse) Reset() {
+	*x = DiffPlaylistSnapshotsResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffPlaylistSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffPlaylistSnapshotsResponse) ProtoMessage() {}
+
+func (x *DiffPlaylistSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffPlaylistSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*DiffPlaylistSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DiffPlaylistSnapshotsResponse) GetAddedSongs() []*Song {
+	if x != nil {
+		return x.AddedSongs
+	}
+	return nil
+}
+
+func (x *DiffPlaylistSnapshotsResponse) GetRemovedSongs() []*Song {
+	if x != nil {
+		return x.RemovedSongs
+	}
+	return nil
+}
+
+type RestorePlaylistSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotId    string                 `protobuf:"bytes,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePlaylistSnapshotRequest) Reset() {
+	*x = RestorePlaylistSnapshotRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePlaylistSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePlaylistSnapshotRequest) ProtoMessage() {}
+
+func (x *RestorePlaylistSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePlaylistSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*RestorePlaylistSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RestorePlaylistSnapshotRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+type RestorePlaylistSnapshotResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The snapshot of the playlist taken before it was restored, which can be used to undo the restore.
+	Snapshot      *PlaylistSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePlaylistSnapshotResponse) Reset() {
+	*x = RestorePlaylistSnapshotResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePlaylistSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePlaylistSnapshotResponse) ProtoMessage() {}
+
+func (x *RestorePlaylistSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePlaylistSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*RestorePlaylistSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RestorePlaylistSnapshotResponse) GetSnapshot() *PlaylistSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type GetSyncGraphRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncGraphRequest) Reset() {
+	*x = GetSyncGraphRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncGraphRequest) ProtoMessage() {}
+
+func (x *GetSyncGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetSyncGraphRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{51}
+}
+
+type GetSyncGraphResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Graph         *SyncGraph             `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncGraphResponse) Reset() {
+	*x = GetSyncGraphResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncGraphResponse) ProtoMessage() {}
+
+func (x *GetSyncGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetSyncGraphResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetSyncGraphResponse) GetGraph() *SyncGraph {
+	if x != nil {
+		return x.Graph
+	}
+	return nil
+}
+
+// The user's syncs as edges between the playlists they read from and write to.
+type SyncGraph struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Nodes []*MusicSource         `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges []*SyncGraphEdge       `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	// Problems between syncs, like syncs undoing each other's changes.
+	// New syncs can't introduce any, but syncs created before these checks existed may have them.
+	Issues        []*SyncGraphIssue `protobuf:"bytes,3,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncGraph) Reset() {
+	*x = SyncGraph{}
+	mi := &file_myncer_sync_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncGraph) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncGraph) ProtoMessage() {}
+
+func (x *SyncGraph) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncGraph.ProtoReflect.Descriptor instead.
+func (*SyncGraph) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SyncGraph) GetNodes() []*MusicSource {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *SyncGraph) GetEdges() []*SyncGraphEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *SyncGraph) GetIssues() []*SyncGraphIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+// A sync carrying songs from one playlist to another.
+// Merge syncs that write back to their sources have an edge for every pair of their playlists.
+type SyncGraphEdge struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	SyncId      string                 `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	Source      *MusicSource           `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Destination *MusicSource           `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	// Whether the sync replaces the songs of the destination instead of adding to them.
+	Overwrites    bool `protobuf:"varint,4,opt,name=overwrites,proto3" json:"overwrites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncGraphEdge) Reset() {
+	*x = SyncGraphEdge{}
+	mi := &file_myncer_sync_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncGraphEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncGraphEdge) ProtoMessage() {}
+
+func (x *SyncGraphEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncGraphEdge.ProtoReflect.Descriptor instead.
+func (*SyncGraphEdge) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SyncGraphEdge) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *SyncGraphEdge) GetSource() *MusicSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *SyncGraphEdge) GetDestination() *MusicSource {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *SyncGraphEdge) GetOverwrites() bool {
+	if x != nil {
+		return x.Overwrites
+	}
+	return false
+}
+
+type SyncGraphIssue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The syncs involved, sorted.
+	SyncIds       []string `protobuf:"bytes,1,rep,name=sync_ids,json=syncIds,proto3" json:"sync_ids,omitempty"`
+	Message       string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncGraphIssue) Reset() {
+	*x = SyncGraphIssue{}
+	mi := &file_myncer_sync_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncGraphIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncGraphIssue) ProtoMessage() {}
+
+func (x *SyncGraphIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncGraphIssue.ProtoReflect.Descriptor instead.
+func (*SyncGraphIssue) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SyncGraphIssue) GetSyncIds() []string {
+	if x != nil {
+		return x.SyncIds
+	}
+	return nil
+}
+
+func (x *SyncGraphIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type TransferLibraryRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	SourceDatasource      Datasource             `protobuf:"varint,1,opt,name=source_datasource,json=sourceDatasource,proto3,enum=myncer.Datasource" json:"source_datasource,omitempty"`
+	DestinationDatasource Datasource             `protobuf:"varint,2,opt,name=destination_datasource,json=destinationDatasource,proto3,enum=myncer.Datasource" json:"destination_datasource,omitempty"`
+	// Ids of the source playlists to transfer. Leave empty to transfer every playlist.
+	PlaylistIds []string `protobuf:"bytes,3,rep,name=playlist_ids,json=playlistIds,proto3" json:"playlist_ids,omitempty"`
+	// Whether the created destination playlists are public.
+	Public        bool `protobuf:"varint,4,opt,name=public,proto3" json:"public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferLibraryRequest) Reset() {
+	*x = TransferLibraryRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferLibraryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferLibraryRequest) ProtoMessage() {}
+
+func (x *TransferLibraryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferLibraryRequest.ProtoReflect.Descriptor instead.
+func (*TransferLibraryRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TransferLibraryRequest) GetSourceDatasource() Datasource {
+	if x != nil {
+		return x.SourceDatasource
+	}
+	return Datasource_DATASOURCE_UNSPECIFIED
+}
+
+func (x *TransferLibraryRequest) GetDestinationDatasource() Datasource {
+	if x != nil {
+		return x.DestinationDatasource
+	}
+	return Datasource_DATASOURCE_UNSPECIFIED
+}
+
+func (x *TransferLibraryRequest) GetPlaylistIds() []string {
+	if x != nil {
+		return x.PlaylistIds
+	}
+	return nil
+}
+
+func (x *TransferLibraryRequest) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+type TransferLibraryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *LibraryTransfer       `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferLibraryResponse) Reset() {
+	*x = TransferLibraryResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferLibraryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferLibraryResponse) ProtoMessage() {}
+
+func (x *TransferLibraryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferLibraryResponse.ProtoReflect.Descriptor instead.
+func (*TransferLibraryResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TransferLibraryResponse) GetTransfer() *LibraryTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type GetLibraryTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLibraryTransferRequest) Reset() {
+	*x = GetLibraryTransferRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLibraryTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLibraryTransferRequest) ProtoMessage() {}
+
+func (x *GetLibraryTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLibraryTransferRequest.ProtoReflect.Descriptor instead.
+func (*GetLibraryTransferRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetLibraryTransferRequest) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+type GetLibraryTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *LibraryTransfer       `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLibraryTransferResponse) Reset() {
+	*x = GetLibraryTransferResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLibraryTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLibraryTransferResponse) ProtoMessage() {}
+
+func (x *GetLibraryTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLibraryTransferResponse.ProtoReflect.Descriptor instead.
+func (*GetLibraryTransferResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetLibraryTransferResponse) GetTransfer() *LibraryTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type ListLibraryTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLibraryTransfersRequest) Reset() {
+	*x = ListLibraryTransfersRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLibraryTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLibraryTransfersRequest) ProtoMessage() {}
+
+func (x *ListLibraryTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLibraryTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListLibraryTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{60}
+}
+
+type ListLibraryTransfersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first.
+	Transfers     []*LibraryTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLibraryTransfersResponse) Reset() {
+	*x = ListLibraryTransfersResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLibraryTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLibraryTransfersResponse) ProtoMessage() {}
+
+func (x *ListLibraryTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLibraryTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListLibraryTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListLibraryTransfersResponse) GetTransfers() []*LibraryTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+// A batch of one-way syncs copying playlists from one datasource to another.
+type LibraryTransfer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// google/uuid generated UUID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Myncer user id.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Metadata which is fetched from SQL (for it's ACID compliance).
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SourceDatasource      Datasource             `protobuf:"varint,5,opt,name=source_datasource,json=sourceDatasource,proto3,enum=myncer.Datasource" json:"source_datasource,omitempty"`
+	DestinationDatasource Datasource             `protobuf:"varint,6,opt,name=destination_datasource,json=destinationDatasource,proto3,enum=myncer.Datasource" json:"destination_datasource,omitempty"`
+	Items                 []*LibraryTransferItem `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`
+	// The fields below are computed from the runs of the items when the transfer is read.
+	// Running until every run finished, then completed only if every playlist was transferred.
+	Status SyncStatus `protobuf:"varint,8,opt,name=status,proto3,enum=myncer.SyncStatus" json:"status,omitempty"`
+	// Sum of the progress of the runs.
+	Progress *SyncRunProgress `protobuf:"bytes,9,opt,name=progress,proto3" json:"progress,omitempty"`
+	// Songs that could not be found on the destination datasource, across every playlist.
+	UnmatchedSongs []*Song `protobuf:"bytes,10,rep,name=unmatched_songs,json=unmatchedSongs,proto3" json:"unmatched_songs,omitempty"` // next: 11
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LibraryTransfer) Reset() {
+	*x = LibraryTransfer{}
+	mi := &file_myncer_sync_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LibraryTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryTransfer) ProtoMessage() {}
+
+func (x *LibraryTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryTransfer.ProtoReflect.Descriptor instead.
+func (*LibraryTransfer) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *LibraryTransfer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LibraryTransfer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LibraryTransfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LibraryTransfer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *LibraryTransfer) GetSourceDatasource() Datasource {
+	if x != nil {
+		return x.SourceDatasource
+	}
+	return Datasource_DATASOURCE_UNSPECIFIED
+}
+
+func (x *LibraryTransfer) GetDestinationDatasource() Datasource {
+	if x != nil {
+		return x.DestinationDatasource
+	}
+	return Datasource_DATASOURCE_UNSPECIFIED
+}
+
+func (x *LibraryTransfer) GetItems() []*LibraryTransferItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *LibraryTransfer) GetStatus() SyncStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SyncStatus_SYNC_STATUS_UNSPECIFIED
+}
+
+func (x *LibraryTransfer) GetProgress() *SyncRunProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *LibraryTransfer) GetUnmatchedSongs() []*Song {
+	if x != nil {
+		return x.UnmatchedSongs
+	}
+	return nil
+}
+
+// The transfer of a single playlist.
+type LibraryTransferItem struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Source     *MusicSource           `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	SourceName string                 `protobuf:"bytes,2,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
+	// Unset if the destination playlist could not be created.
+	Destination *MusicSource `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	// Unset if the sync could not be set up.
+	SyncId string `protobuf:"bytes,4,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	RunId  string `protobuf:"bytes,5,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Why the playlist could not be set up for transfer.
+	ErrorMessage string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// Computed from the run when the transfer is read.
+	Status        SyncStatus `protobuf:"varint,7,opt,name=status,proto3,enum=myncer.SyncStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LibraryTransferItem) Reset() {
+	*x = LibraryTransferItem{}
+	mi := &file_myncer_sync_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LibraryTransferItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryTransferItem) ProtoMessage() {}
+
+func (x *LibraryTransferItem) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryTransferItem.ProtoReflect.Descriptor instead.
+func (*LibraryTransferItem) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *LibraryTransferItem) GetSource() *MusicSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *LibraryTransferItem) GetSourceName() string {
+	if x != nil {
+		return x.SourceName
+	}
+	return ""
+}
+
+func (x *LibraryTransferItem) GetDestination() *MusicSource {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *LibraryTransferItem) GetSyncId() string {
+	if x != nil {
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *LibraryTransferItem) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *LibraryTransferItem) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *LibraryTransferItem) GetStatus() SyncStatus {
+	if x != nil {
+		return x.Status
 	}
-	return nil
+	return SyncStatus_SYNC_STATUS_UNSPECIFIED
 }
 
-type DeleteSyncRequest struct {
+type ClearSongResolutionsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The ID of the sync to delete.
-	SyncId        string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Only clears the songs resolved on this datasource. Unspecified clears all of them.
+	DestinationDatasource Datasource `protobuf:"varint,1,opt,name=destination_datasource,json=destinationDatasource,proto3,enum=myncer.Datasource" json:"destination_datasource,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *DeleteSyncRequest) Reset() {
-	*x = DeleteSyncRequest{}
-	mi := &file_myncer_sync_proto_msgTypes[6]
+func (x *ClearSongResolutionsRequest) Reset() {
+	*x = ClearSongResolutionsRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSyncRequest) String() string {
+func (x *ClearSongResolutionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSyncRequest) ProtoMessage() {}
+func (*ClearSongResolutionsRequest) ProtoMessage() {}
 
-func (x *DeleteSyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_myncer_sync_proto_msgTypes[6]
+func (x *ClearSongResolutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,41 +4888,39 @@ func (x *DeleteSyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSyncRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSyncRequest) Descriptor() ([]byte, []int) {
-	return file_myncer_sync_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ClearSongResolutionsRequest.ProtoReflect.Descriptor instead.
+func (*ClearSongResolutionsRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *DeleteSyncRequest) GetSyncId() string {
+func (x *ClearSongResolutionsRequest) GetDestinationDatasource() Datasource {
 	if x != nil {
-		return x.SyncId
+		return x.DestinationDatasource
 	}
-	return ""
+	return Datasource_DATASOURCE_UNSPECIFIED
 }
 
-type DeleteSyncResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The ID of the deleted sync.
-	SyncId        string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+type ClearSongResolutionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSyncResponse) Reset() {
-	*x = DeleteSyncResponse{}
-	mi := &file_myncer_sync_proto_msgTypes[7]
+func (x *ClearSongResolutionsResponse) Reset() {
+	*x = ClearSongResolutionsResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSyncResponse) String() string {
+func (x *ClearSongResolutionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSyncResponse) ProtoMessage() {}
+func (*ClearSongResolutionsResponse) ProtoMessage() {}
 
-func (x *DeleteSyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_myncer_sync_proto_msgTypes[7]
+func (x *ClearSongResolutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -634,39 +4931,37 @@ func (x *DeleteSyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSyncResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSyncResponse) Descriptor() ([]byte, []int) {
-	return file_myncer_sync_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *DeleteSyncResponse) GetSyncId() string {
-	if x != nil {
-		return x.SyncId
-	}
-	return ""
+// Deprecated: Use ClearSongResolutionsResponse.ProtoReflect.Descriptor instead.
+func (*ClearSongResolutionsResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{65}
 }
 
-type ListSyncsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SearchSongsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Datasource Datasource             `protobuf:"varint,1,opt,name=datasource,proto3,enum=myncer.Datasource" json:"datasource,omitempty"`
+	// Only the name, artists, album and ISRC of the song are searched for.
+	Song *Song `protobuf:"bytes,2,opt,name=song,proto3" json:"song,omitempty"`
+	// Unset uses the default profile.
+	MatchingProfile *MatchingProfile `protobuf:"bytes,3,opt,name=matching_profile,json=matchingProfile,proto3" json:"matching_profile,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListSyncsRequest) Reset() {
-	*x = ListSyncsRequest{}
-	mi := &file_myncer_sync_proto_msgTypes[8]
+func (x *SearchSongsRequest) Reset() {
+	*x = SearchSongsRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSyncsRequest) String() string {
+func (x *SearchSongsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSyncsRequest) ProtoMessage() {}
+func (*SearchSongsRequest) ProtoMessage() {}
 
-func (x *ListSyncsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_myncer_sync_proto_msgTypes[8]
+func (x *SearchSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -677,34 +4972,55 @@ func (x *ListSyncsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSyncsRequest.ProtoReflect.Descriptor instead.
-func (*ListSyncsRequest) Descriptor() ([]byte, []int) {
-	return file_myncer_sync_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SearchSongsRequest.ProtoReflect.Descriptor instead.
+func (*SearchSongsRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{66}
 }
 
-type ListSyncsResponse struct {
+func (x *SearchSongsRequest) GetDatasource() Datasource {
+	if x != nil {
+		return x.Datasource
+	}
+	return Datasource_DATASOURCE_UNSPECIFIED
+}
+
+func (x *SearchSongsRequest) GetSong() *Song {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+func (x *SearchSongsRequest) GetMatchingProfile() *MatchingProfile {
+	if x != nil {
+		return x.MatchingProfile
+	}
+	return nil
+}
+
+type SearchSongsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of syncs for the current user.
-	Syncs         []*Sync `protobuf:"bytes,1,rep,name=syncs,proto3" json:"syncs,omitempty"`
+	// Best match first, including songs the matching profile would not accept.
+	Candidates    []*SongMatchCandidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSyncsResponse) Reset() {
-	*x = ListSyncsResponse{}
-	mi := &file_myncer_sync_proto_msgTypes[9]
+func (x *SearchSongsResponse) Reset() {
+	*x = SearchSongsResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSyncsResponse) String() string {
+func (x *SearchSongsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSyncsResponse) ProtoMessage() {}
+func (*SearchSongsResponse) ProtoMessage() {}
 
-func (x *ListSyncsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_myncer_sync_proto_msgTypes[9]
+func (x *SearchSongsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -715,41 +5031,45 @@ func (x *ListSyncsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSyncsResponse.ProtoReflect.Descriptor instead.
-func (*ListSyncsResponse) Descriptor() ([]byte, []int) {
-	return file_myncer_sync_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SearchSongsResponse.ProtoReflect.Descriptor instead.
+func (*SearchSongsResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ListSyncsResponse) GetSyncs() []*Sync {
+func (x *SearchSongsResponse) GetCandidates() []*SongMatchCandidate {
 	if x != nil {
-		return x.Syncs
+		return x.Candidates
 	}
 	return nil
 }
 
-type GetSyncRequest struct {
+type SetSongOverrideRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The ID of the sync to get.
-	SyncId        string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetSyncRequest) Reset() {
-	*x = GetSyncRequest{}
-	mi := &file_myncer_sync_proto_msgTypes[10]
+	// Must have a datasource and datasource song id.
+	SourceSong            *Song            `protobuf:"bytes,1,opt,name=source_song,json=sourceSong,proto3" json:"source_song,omitempty"`
+	DestinationDatasource Datasource       `protobuf:"varint,2,opt,name=destination_datasource,json=destinationDatasource,proto3,enum=myncer.Datasource" json:"destination_datasource,omitempty"`
+	Kind                  SongOverrideKind `protobuf:"varint,3,opt,name=kind,proto3,enum=myncer.SongOverrideKind" json:"kind,omitempty"`
+	// Required for pins, e.g. a song returned by SearchSongs. Must be unset otherwise.
+	DestinationSong *Song `protobuf:"bytes,4,opt,name=destination_song,json=destinationSong,proto3" json:"destination_song,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetSongOverrideRequest) Reset() {
+	*x = SetSongOverrideRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSyncRequest) String() string {
+func (x *SetSongOverrideRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSyncRequest) ProtoMessage() {}
+func (*SetSongOverrideRequest) ProtoMessage() {}
 
-func (x *GetSyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_myncer_sync_proto_msgTypes[10]
+func (x *SetSongOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -760,41 +5080,61 @@ func (x *GetSyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSyncRequest.ProtoReflect.Descriptor instead.
-func (*GetSyncRequest) Descriptor() ([]byte, []int) {
-	return file_myncer_sync_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use SetSongOverrideRequest.ProtoReflect.Descriptor instead.
+func (*SetSongOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetSyncRequest) GetSyncId() string {
+func (x *SetSongOverrideRequest) GetSourceSong() *Song {
 	if x != nil {
-		return x.SyncId
+		return x.SourceSong
 	}
-	return ""
+	return nil
 }
 
-type GetSyncResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The requested sync.
-	Sync          *Sync `protobuf:"bytes,1,opt,name=sync,proto3" json:"sync,omitempty"`
+func (x *SetSongOverrideRequest) GetDestinationDatasource() Datasource {
+	if x != nil {
+		return x.DestinationDatasource
+	}
+	return Datasource_DATASOURCE_UNSPECIFIED
+}
+
+func (x *SetSongOverrideRequest) GetKind() SongOverrideKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SongOverrideKind_SONG_OVERRIDE_KIND_UNSPECIFIED
+}
+
+func (x *SetSongOverrideRequest) GetDestinationSong() *Song {
+	if x != nil {
+		return x.DestinationSong
+	}
+	return nil
+}
+
+type SetSongOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SongOverride  *SongOverride          `protobuf:"bytes,1,opt,name=song_override,json=songOverride,proto3" json:"song_override,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSyncResponse) Reset() {
-	*x = GetSyncResponse{}
-	mi := &file_myncer_sync_proto_msgTypes[11]
+func (x *SetSongOverrideResponse) Reset() {
+	*x = SetSongOverrideResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSyncResponse) String() string {
+func (x *SetSongOverrideResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSyncResponse) ProtoMessage() {}
+func (*SetSongOverrideResponse) ProtoMessage() {}
 
-func (x *GetSyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_myncer_sync_proto_msgTypes[11]
+func (x *SetSongOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,41 +5145,42 @@ func (x *GetSyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSyncResponse.ProtoReflect.Descriptor instead.
-func (*GetSyncResponse) Descriptor() ([]byte, []int) {
-	return file_myncer_sync_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SetSongOverrideResponse.ProtoReflect.Descriptor instead.
+func (*SetSongOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetSyncResponse) GetSync() *Sync {
+func (x *SetSongOverrideResponse) GetSongOverride() *SongOverride {
 	if x != nil {
-		return x.Sync
+		return x.SongOverride
 	}
 	return nil
 }
 
-type RunSyncRequest struct {
+type DeleteSongOverrideRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The ID of the sync to run.
-	SyncId        string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Only the datasource and datasource song id are used.
+	SourceSong            *Song      `protobuf:"bytes,1,opt,name=source_song,json=sourceSong,proto3" json:"source_song,omitempty"`
+	DestinationDatasource Datasource `protobuf:"varint,2,opt,name=destination_datasource,json=destinationDatasource,proto3,enum=myncer.Datasource" json:"destination_datasource,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *RunSyncRequest) Reset() {
-	*x = RunSyncRequest{}
-	mi := &file_myncer_sync_proto_msgTypes[12]
+func (x *DeleteSongOverrideRequest) Reset() {
+	*x = DeleteSongOverrideRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RunSyncRequest) String() string {
+func (x *DeleteSongOverrideRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunSyncRequest) ProtoMessage() {}
+func (*DeleteSongOverrideRequest) ProtoMessage() {}
 
-func (x *RunSyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_myncer_sync_proto_msgTypes[12]
+func (x *DeleteSongOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,44 +5191,46 @@ func (x *RunSyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunSyncRequest.ProtoReflect.Descriptor instead.
-func (*RunSyncRequest) Descriptor() ([]byte, []int) {
-	return file_myncer_sync_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DeleteSongOverrideRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSongOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *RunSyncRequest) GetSyncId() string {
+func (x *DeleteSongOverrideRequest) GetSourceSong() *Song {
 	if x != nil {
-		return x.SyncId
+		return x.SourceSong
 	}
-	return ""
+	return nil
 }
 
-type RunSyncResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The ID of the sync that was run.
-	SyncId string     `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
-	Status SyncStatus `protobuf:"varint,2,opt,name=status,proto3,enum=myncer.SyncStatus" json:"status,omitempty"`
-	// If the sync failed, this will contain the error message.
-	ErrorMessage  string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+func (x *DeleteSongOverrideRequest) GetDestinationDatasource() Datasource {
+	if x != nil {
+		return x.DestinationDatasource
+	}
+	return Datasource_DATASOURCE_UNSPECIFIED
+}
+
+type DeleteSongOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RunSyncResponse) Reset() {
-	*x = RunSyncResponse{}
-	mi := &file_myncer_sync_proto_msgTypes[13]
+func (x *DeleteSongOverrideResponse) Reset() {
+	*x = DeleteSongOverrideResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RunSyncResponse) String() string {
+func (x *DeleteSongOverrideResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunSyncResponse) ProtoMessage() {}
+func (*DeleteSongOverrideResponse) ProtoMessage() {}
 
-func (x *RunSyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_myncer_sync_proto_msgTypes[13]
+func (x *DeleteSongOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,53 +5241,32 @@ func (x *RunSyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunSyncResponse.ProtoReflect.Descriptor instead.
-func (*RunSyncResponse) Descriptor() ([]byte, []int) {
-	return file_myncer_sync_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *RunSyncResponse) GetSyncId() string {
-	if x != nil {
-		return x.SyncId
-	}
-	return ""
-}
-
-func (x *RunSyncResponse) GetStatus() SyncStatus {
-	if x != nil {
-		return x.Status
-	}
-	return SyncStatus_SYNC_STATUS_UNSPECIFIED
-}
-
-func (x *RunSyncResponse) GetErrorMessage() string {
-	if x != nil {
-		return x.ErrorMessage
-	}
-	return ""
+// Deprecated: Use DeleteSongOverrideResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSongOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{71}
 }
 
-type ListSyncRunsRequest struct {
+type ListSongOverridesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSyncRunsRequest) Reset() {
-	*x = ListSyncRunsRequest{}
-	mi := &file_myncer_sync_proto_msgTypes[14]
+func (x *ListSongOverridesRequest) Reset() {
+	*x = ListSongOverridesRequest{}
+	mi := &file_myncer_sync_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSyncRunsRequest) String() string {
+func (x *ListSongOverridesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSyncRunsRequest) ProtoMessage() {}
+func (*ListSongOverridesRequest) ProtoMessage() {}
 
-func (x *ListSyncRunsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_myncer_sync_proto_msgTypes[14]
+func (x *ListSongOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,34 +5277,34 @@ func (x *ListSyncRunsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSyncRunsRequest.ProtoReflect.Descriptor instead.
-func (*ListSyncRunsRequest) Descriptor() ([]byte, []int) {
-	return file_myncer_sync_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListSongOverridesRequest.ProtoReflect.Descriptor instead.
+func (*ListSongOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{72}
 }
 
-type ListSyncRunsResponse struct {
+type ListSongOverridesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of sync runs for the current user.
-	SyncRuns      []*SyncRun `protobuf:"bytes,1,rep,name=sync_runs,json=syncRuns,proto3" json:"sync_runs,omitempty"`
+	// Most recently updated first.
+	SongOverrides []*SongOverride `protobuf:"bytes,1,rep,name=song_overrides,json=songOverrides,proto3" json:"song_overrides,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSyncRunsResponse) Reset() {
-	*x = ListSyncRunsResponse{}
-	mi := &file_myncer_sync_proto_msgTypes[15]
+func (x *ListSongOverridesResponse) Reset() {
+	*x = ListSongOverridesResponse{}
+	mi := &file_myncer_sync_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSyncRunsResponse) String() string {
+func (x *ListSongOverridesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSyncRunsResponse) ProtoMessage() {}
+func (*ListSongOverridesResponse) ProtoMessage() {}
 
-func (x *ListSyncRunsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_myncer_sync_proto_msgTypes[15]
+func (x *ListSongOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myncer_sync_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -993,14 +5315,14 @@ func (x *ListSyncRunsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSyncRunsResponse.ProtoReflect.Descriptor instead.
-func (*ListSyncRunsResponse) Descriptor() ([]byte, []int) {
-	return file_myncer_sync_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListSongOverridesResponse.ProtoReflect.Descriptor instead.
+func (*ListSongOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_myncer_sync_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *ListSyncRunsResponse) GetSyncRuns() []*SyncRun {
+func (x *ListSongOverridesResponse) GetSongOverrides() []*SongOverride {
 	if x != nil {
-		return x.SyncRuns
+		return x.SongOverrides
 	}
 	return nil
 }
@@ -1009,11 +5331,34 @@ var File_myncer_sync_proto protoreflect.FileDescriptor
 
 const file_myncer_sync_proto_rawDesc = "" +
 	"\n" +
-	"\x11myncer/sync.proto\x12\x06myncer\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17myncer/datasource.proto\x1a\x11myncer/song.proto\"\xa8\x01\n" +
+	"\x11myncer/sync.proto\x12\x06myncer\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17myncer/datasource.proto\x1a\x11myncer/song.proto\"\xce\x02\n" +
 	"\x11PlaylistMergeSync\x12-\n" +
 	"\asources\x18\x01 \x03(\v2\x13.myncer.MusicSourceR\asources\x125\n" +
 	"\vdestination\x18\x02 \x01(\v2\x13.myncer.MusicSourceR\vdestination\x12-\n" +
-	"\x12overwrite_existing\x18\x03 \x01(\bR\x11overwriteExisting\"\xba\x02\n" +
+	"\x12overwrite_existing\x18\x03 \x01(\bR\x11overwriteExisting\x121\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x1d.myncer.PlaylistMergeSyncModeR\x04mode\x12D\n" +
+	"\x0fconflict_policy\x18\x05 \x01(\x0e2\x1b.myncer.MergeConflictPolicyR\x0econflictPolicy\x12+\n" +
+	"\x05order\x18\x06 \x01(\x0e2\x15.myncer.PlaylistOrderR\x05order\"\xf0\x01\n" +
+	"\fSyncBaseline\x12\x17\n" +
+	"\async_id\x18\x01 \x01(\tR\x06syncId\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12:\n" +
+	"\tplaylists\x18\x03 \x03(\v2\x1c.myncer.SyncBaselinePlaylistR\tplaylists\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"k\n" +
+	"\x14SyncBaselinePlaylist\x12/\n" +
+	"\bplaylist\x18\x01 \x01(\v2\x13.myncer.MusicSourceR\bplaylist\x12\"\n" +
+	"\x05songs\x18\x02 \x03(\v2\f.myncer.SongR\x05songs\"\x92\x02\n" +
+	"\rMergeConflict\x12/\n" +
+	"\fremoved_song\x18\x01 \x01(\v2\f.myncer.SongR\vremovedSong\x126\n" +
+	"\fremoved_from\x18\x02 \x01(\v2\x13.myncer.MusicSourceR\vremovedFrom\x12+\n" +
+	"\n" +
+	"added_song\x18\x03 \x01(\v2\f.myncer.SongR\taddedSong\x12.\n" +
+	"\badded_to\x18\x04 \x01(\v2\x13.myncer.MusicSourceR\aaddedTo\x12;\n" +
+	"\n" +
+	"resolution\x18\x05 \x01(\x0e2\x1b.myncer.MergeConflictPolicyR\n" +
+	"resolution\"\xb7\x05\n" +
 	"\x04Sync\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x129\n" +
@@ -1023,8 +5368,43 @@ const file_myncer_sync_proto_rawDesc = "" +
 	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x126\n" +
 	"\fone_way_sync\x18\x05 \x01(\v2\x12.myncer.OneWaySyncH\x00R\n" +
 	"oneWaySync\x12K\n" +
-	"\x13playlist_merge_sync\x18\x06 \x01(\v2\x19.myncer.PlaylistMergeSyncH\x00R\x11playlistMergeSyncB\x0e\n" +
-	"\fsync_variant\"\xc0\x02\n" +
+	"\x13playlist_merge_sync\x18\x06 \x01(\v2\x19.myncer.PlaylistMergeSyncH\x00R\x11playlistMergeSync\x126\n" +
+	"\ffan_out_sync\x18\t \x01(\v2\x12.myncer.FanOutSyncH\x00R\n" +
+	"fanOutSync\x120\n" +
+	"\bschedule\x18\a \x01(\v2\x14.myncer.SyncScheduleR\bschedule\x126\n" +
+	"\fretry_policy\x18\b \x01(\v2\x13.myncer.RetryPolicyR\vretryPolicy\x129\n" +
+	"\ffilter_rules\x18\n" +
+	" \x03(\v2\x16.myncer.SyncFilterRuleR\vfilterRules\x12B\n" +
+	"\x10matching_profile\x18\v \x01(\v2\x17.myncer.MatchingProfileR\x0fmatchingProfile\x12'\n" +
+	"\x05pause\x18\f \x01(\v2\x11.myncer.SyncPauseR\x05pause\x121\n" +
+	"\x14consecutive_failures\x18\r \x01(\x05R\x13consecutiveFailuresB\x0e\n" +
+	"\fsync_variant\"z\n" +
+	"\tSyncPause\x127\n" +
+	"\tpaused_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bpausedAt\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1c\n" +
+	"\tautomatic\x18\x03 \x01(\bR\tautomatic\"\xd9\x01\n" +
+	"\x0fMatchingProfile\x12)\n" +
+	"\x10dedupe_threshold\x18\x01 \x01(\x01R\x0fdedupeThreshold\x120\n" +
+	"\x14min_acceptance_score\x18\x02 \x01(\x01R\x12minAcceptanceScore\x12!\n" +
+	"\ftitle_weight\x18\x03 \x01(\x01R\vtitleWeight\x12#\n" +
+	"\rartist_weight\x18\x04 \x01(\x01R\fartistWeight\x12!\n" +
+	"\falbum_weight\x18\x05 \x01(\x01R\valbumWeight\"\xed\x01\n" +
+	"\x0eSyncFilterRule\x12D\n" +
+	"\x0fexclude_artists\x18\x01 \x01(\v2\x19.myncer.SyncFilterArtistsH\x00R\x0eexcludeArtists\x122\n" +
+	"\x14exclude_name_pattern\x18\x02 \x01(\tH\x00R\x12excludeNamePattern\x12,\n" +
+	"\x11added_within_days\x18\x03 \x01(\x05H\x00R\x0faddedWithinDays\x12+\n" +
+	"\x10exclude_explicit\x18\x04 \x01(\bH\x00R\x0fexcludeExplicitB\x06\n" +
+	"\x04rule\"6\n" +
+	"\x11SyncFilterArtists\x12!\n" +
+	"\fartist_names\x18\x01 \x03(\tR\vartistNames\"\x98\x01\n" +
+	"\vRetryPolicy\x12!\n" +
+	"\fmax_attempts\x18\x01 \x01(\x05R\vmaxAttempts\x126\n" +
+	"\x17initial_backoff_seconds\x18\x02 \x01(\x05R\x15initialBackoffSeconds\x12.\n" +
+	"\x13max_backoff_seconds\x18\x03 \x01(\x05R\x11maxBackoffSeconds\"\xc0\x01\n" +
+	"\fSyncSchedule\x128\n" +
+	"\binterval\x18\x01 \x01(\x0e2\x1c.myncer.SyncScheduleIntervalR\binterval\x12:\n" +
+	"\vnext_run_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tnextRunAt\x12:\n" +
+	"\vlast_run_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tlastRunAt\"\xdd\x06\n" +
 	"\aSyncRun\x12\x17\n" +
 	"\async_id\x18\x01 \x01(\tR\x06syncId\x12\x15\n" +
 	"\x06run_id\x18\x02 \x01(\tR\x05runId\x123\n" +
@@ -1035,18 +5415,126 @@ const file_myncer_sync_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x125\n" +
 	"\x0funmatched_songs\x18\x06 \x03(\v2\f.myncer.SongR\x0eunmatchedSongs\x12#\n" +
-	"\rerror_message\x18\a \x01(\tR\ferrorMessage\"\x9f\x01\n" +
+	"\rerror_message\x18\a \x01(\tR\ferrorMessage\x12*\n" +
+	"\x05phase\x18\b \x01(\x0e2\x14.myncer.SyncRunPhaseR\x05phase\x121\n" +
+	"\x14destination_modified\x18\t \x01(\bR\x13destinationModified\x122\n" +
+	"\battempts\x18\n" +
+	" \x03(\v2\x16.myncer.SyncRunAttemptR\battempts\x123\n" +
+	"\bprogress\x18\v \x01(\v2\x17.myncer.SyncRunProgressR\bprogress\x12B\n" +
+	"\x0etarget_results\x18\f \x03(\v2\x1b.myncer.SyncRunTargetResultR\rtargetResults\x123\n" +
+	"\tconflicts\x18\r \x03(\v2\x15.myncer.MergeConflictR\tconflicts\x12'\n" +
+	"\x04kind\x18\x0e \x01(\x0e2\x13.myncer.SyncRunKindR\x04kind\x12-\n" +
+	"\apreview\x18\x0f \x01(\v2\x13.myncer.SyncPreviewR\apreview\x123\n" +
+	"\x0eexcluded_songs\x18\x10 \x03(\v2\f.myncer.SongR\rexcludedSongs\x12M\n" +
+	"\x16low_confidence_matches\x18\x11 \x03(\v2\x17.myncer.SongMatchResultR\x14lowConfidenceMatches\"u\n" +
+	"\vSyncPreview\x123\n" +
+	"\atargets\x18\x01 \x03(\v2\x19.myncer.SyncPreviewTargetR\atargets\x121\n" +
+	"\amatches\x18\x02 \x03(\v2\x17.myncer.SongMatchResultR\amatches\"\xfc\x01\n" +
+	"\x11SyncPreviewTarget\x12+\n" +
+	"\x06target\x18\x01 \x01(\v2\x13.myncer.MusicSourceR\x06target\x12'\n" +
+	"\x0fclears_playlist\x18\x02 \x01(\bR\x0eclearsPlaylist\x12.\n" +
+	"\fsongs_to_add\x18\x03 \x03(\v2\f.myncer.SongR\n" +
+	"songsToAdd\x124\n" +
+	"\x0fsongs_to_remove\x18\x04 \x03(\v2\f.myncer.SongR\rsongsToRemove\x12+\n" +
+	"\x11reorders_playlist\x18\x05 \x01(\bR\x10reordersPlaylist\"\xbf\x01\n" +
+	"\x13SyncRunTargetResult\x12+\n" +
+	"\x06target\x18\x01 \x01(\v2\x13.myncer.MusicSourceR\x06target\x125\n" +
+	"\x0funmatched_songs\x18\x02 \x03(\v2\f.myncer.SongR\x0eunmatchedSongs\x12\x1f\n" +
+	"\vadded_songs\x18\x03 \x01(\x05R\n" +
+	"addedSongs\x12#\n" +
+	"\rremoved_songs\x18\x04 \x01(\x05R\fremovedSongs\"\xf8\x01\n" +
+	"\x0fSyncRunProgress\x12\x1f\n" +
+	"\vtotal_songs\x18\x01 \x01(\x05R\n" +
+	"totalSongs\x12#\n" +
+	"\rmatched_songs\x18\x02 \x01(\x05R\fmatchedSongs\x12'\n" +
+	"\x0funmatched_songs\x18\x03 \x01(\x05R\x0eunmatchedSongs\x12\x1f\n" +
+	"\vadded_songs\x18\x04 \x01(\x05R\n" +
+	"addedSongs\x12#\n" +
+	"\rremoved_songs\x18\x05 \x01(\x05R\fremovedSongs\x120\n" +
+	"\x14low_confidence_songs\x18\x06 \x01(\x05R\x12lowConfidenceSongs\"\x93\x02\n" +
+	"\fSyncRunEvent\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12,\n" +
+	"\x05phase\x18\x03 \x01(\x0e2\x14.myncer.SyncRunPhaseH\x00R\x05phase\x12E\n" +
+	"\x11song_match_result\x18\x04 \x01(\v2\x17.myncer.SongMatchResultH\x00R\x0fsongMatchResult\x123\n" +
+	"\bprogress\x18\x05 \x01(\v2\x17.myncer.SyncRunProgressR\bprogressB\a\n" +
+	"\x05event\"\xbb\x02\n" +
+	"\x0fSongMatchResult\x12-\n" +
+	"\vsource_song\x18\x01 \x01(\v2\f.myncer.SongR\n" +
+	"sourceSong\x12\x18\n" +
+	"\amatched\x18\x02 \x01(\bR\amatched\x12.\n" +
+	"\x13destination_song_id\x18\x03 \x01(\tR\x11destinationSongId\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\x127\n" +
+	"\x10destination_song\x18\x05 \x01(\v2\f.myncer.SongR\x0fdestinationSong\x129\n" +
+	"\n" +
+	"runner_ups\x18\x06 \x03(\v2\x1a.myncer.SongMatchCandidateR\trunnerUps\x12%\n" +
+	"\x0elow_confidence\x18\a \x01(\bR\rlowConfidence\"\xb6\x02\n" +
+	"\x0eSyncRunAttempt\x12%\n" +
+	"\x0eattempt_number\x18\x01 \x01(\x05R\rattemptNumber\x129\n" +
+	"\n" +
+	"started_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\x12\x1c\n" +
+	"\tretryable\x18\x05 \x01(\bR\tretryable\x12B\n" +
+	"\x0fnext_attempt_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\"\xa6\x02\n" +
 	"\n" +
 	"OneWaySync\x12+\n" +
 	"\x06source\x18\x01 \x01(\v2\x13.myncer.MusicSourceR\x06source\x125\n" +
 	"\vdestination\x18\x02 \x01(\v2\x13.myncer.MusicSourceR\vdestination\x12-\n" +
-	"\x12overwrite_existing\x18\x03 \x01(\bR\x11overwriteExisting\"\xa8\x01\n" +
+	"\x12overwrite_existing\x18\x03 \x01(\bR\x11overwriteExisting\x12*\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x16.myncer.OneWaySyncModeR\x04mode\x12,\n" +
+	"\x12remove_extra_songs\x18\x05 \x01(\bR\x10removeExtraSongs\x12+\n" +
+	"\x05order\x18\x06 \x01(\x0e2\x15.myncer.PlaylistOrderR\x05order\"\xa8\x02\n" +
+	"\n" +
+	"FanOutSync\x12+\n" +
+	"\x06source\x18\x01 \x01(\v2\x13.myncer.MusicSourceR\x06source\x127\n" +
+	"\fdestinations\x18\x02 \x03(\v2\x13.myncer.MusicSourceR\fdestinations\x12-\n" +
+	"\x12overwrite_existing\x18\x03 \x01(\bR\x11overwriteExisting\x12*\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x16.myncer.OneWaySyncModeR\x04mode\x12,\n" +
+	"\x12remove_extra_songs\x18\x05 \x01(\bR\x10removeExtraSongs\x12+\n" +
+	"\x05order\x18\x06 \x01(\x0e2\x15.myncer.PlaylistOrderR\x05order\"\xb1\x04\n" +
 	"\x11CreateSyncRequest\x126\n" +
 	"\fone_way_sync\x18\x01 \x01(\v2\x12.myncer.OneWaySyncH\x00R\n" +
 	"oneWaySync\x12K\n" +
-	"\x13playlist_merge_sync\x18\x02 \x01(\v2\x19.myncer.PlaylistMergeSyncH\x00R\x11playlistMergeSyncB\x0e\n" +
-	"\fsync_variant\"6\n" +
+	"\x13playlist_merge_sync\x18\x02 \x01(\v2\x19.myncer.PlaylistMergeSyncH\x00R\x11playlistMergeSync\x126\n" +
+	"\ffan_out_sync\x18\x06 \x01(\v2\x12.myncer.FanOutSyncH\x00R\n" +
+	"fanOutSync\x12I\n" +
+	"\x11schedule_interval\x18\x03 \x01(\x0e2\x1c.myncer.SyncScheduleIntervalR\x10scheduleInterval\x126\n" +
+	"\fretry_policy\x18\x04 \x01(\v2\x13.myncer.RetryPolicyR\vretryPolicy\x12M\n" +
+	"\x18new_destination_playlist\x18\x05 \x01(\v2\x13.myncer.NewPlaylistR\x16newDestinationPlaylist\x129\n" +
+	"\ffilter_rules\x18\a \x03(\v2\x16.myncer.SyncFilterRuleR\vfilterRules\x12B\n" +
+	"\x10matching_profile\x18\b \x01(\v2\x17.myncer.MatchingProfileR\x0fmatchingProfileB\x0e\n" +
+	"\fsync_variant\"[\n" +
+	"\vNewPlaylist\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06public\x18\x03 \x01(\bR\x06public\"6\n" +
 	"\x12CreateSyncResponse\x12 \n" +
+	"\x04sync\x18\x01 \x01(\v2\f.myncer.SyncR\x04sync\"\xfb\x03\n" +
+	"\x11UpdateSyncRequest\x12\x17\n" +
+	"\async_id\x18\x01 \x01(\tR\x06syncId\x126\n" +
+	"\fone_way_sync\x18\x02 \x01(\v2\x12.myncer.OneWaySyncH\x00R\n" +
+	"oneWaySync\x12K\n" +
+	"\x13playlist_merge_sync\x18\x03 \x01(\v2\x19.myncer.PlaylistMergeSyncH\x00R\x11playlistMergeSync\x126\n" +
+	"\ffan_out_sync\x18\x04 \x01(\v2\x12.myncer.FanOutSyncH\x00R\n" +
+	"fanOutSync\x12I\n" +
+	"\x11schedule_interval\x18\x05 \x01(\x0e2\x1c.myncer.SyncScheduleIntervalR\x10scheduleInterval\x126\n" +
+	"\fretry_policy\x18\x06 \x01(\v2\x13.myncer.RetryPolicyR\vretryPolicy\x129\n" +
+	"\ffilter_rules\x18\a \x03(\v2\x16.myncer.SyncFilterRuleR\vfilterRules\x12B\n" +
+	"\x10matching_profile\x18\b \x01(\v2\x17.myncer.MatchingProfileR\x0fmatchingProfileB\x0e\n" +
+	"\fsync_variant\"6\n" +
+	"\x12UpdateSyncResponse\x12 \n" +
+	"\x04sync\x18\x01 \x01(\v2\f.myncer.SyncR\x04sync\"C\n" +
+	"\x10PauseSyncRequest\x12\x17\n" +
+	"\async_id\x18\x01 \x01(\tR\x06syncId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"5\n" +
+	"\x11PauseSyncResponse\x12 \n" +
+	"\x04sync\x18\x01 \x01(\v2\f.myncer.SyncR\x04sync\",\n" +
+	"\x11ResumeSyncRequest\x12\x17\n" +
+	"\async_id\x18\x01 \x01(\tR\x06syncId\"6\n" +
+	"\x12ResumeSyncResponse\x12 \n" +
 	"\x04sync\x18\x01 \x01(\v2\f.myncer.SyncR\x04sync\",\n" +
 	"\x11DeleteSyncRequest\x12\x17\n" +
 	"\async_id\x18\x01 \x01(\tR\x06syncId\"-\n" +
@@ -1058,16 +5546,176 @@ const file_myncer_sync_proto_rawDesc = "" +
 	"\x0eGetSyncRequest\x12\x17\n" +
 	"\async_id\x18\x01 \x01(\tR\x06syncId\"3\n" +
 	"\x0fGetSyncResponse\x12 \n" +
-	"\x04sync\x18\x01 \x01(\v2\f.myncer.SyncR\x04sync\")\n" +
+	"\x04sync\x18\x01 \x01(\v2\f.myncer.SyncR\x04sync\"B\n" +
 	"\x0eRunSyncRequest\x12\x17\n" +
-	"\async_id\x18\x01 \x01(\tR\x06syncId\"{\n" +
+	"\async_id\x18\x01 \x01(\tR\x06syncId\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\x92\x01\n" +
 	"\x0fRunSyncResponse\x12\x17\n" +
 	"\async_id\x18\x01 \x01(\tR\x06syncId\x12*\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x12.myncer.SyncStatusR\x06status\x12#\n" +
-	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\x15\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x12\x15\n" +
+	"\x06run_id\x18\x04 \x01(\tR\x05runId\"\x15\n" +
 	"\x13ListSyncRunsRequest\"D\n" +
 	"\x14ListSyncRunsResponse\x12,\n" +
-	"\tsync_runs\x18\x01 \x03(\v2\x0f.myncer.SyncRunR\bsyncRuns*\xa9\x01\n" +
+	"\tsync_runs\x18\x01 \x03(\v2\x0f.myncer.SyncRunR\bsyncRuns\"-\n" +
+	"\x14CancelSyncRunRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\"Z\n" +
+	"\x15CancelSyncRunResponse\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12*\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x12.myncer.SyncStatusR\x06status\",\n" +
+	"\x13WatchSyncRunRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\"|\n" +
+	"\x14WatchSyncRunResponse\x12,\n" +
+	"\bsync_run\x18\x01 \x01(\v2\x0f.myncer.SyncRunH\x00R\asyncRun\x12,\n" +
+	"\x05event\x18\x02 \x01(\v2\x14.myncer.SyncRunEventH\x00R\x05eventB\b\n" +
+	"\x06update\"\xfb\x01\n" +
+	"\x10PlaylistSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\async_id\x18\x03 \x01(\tR\x06syncId\x12\x15\n" +
+	"\x06run_id\x18\x04 \x01(\tR\x05runId\x12/\n" +
+	"\bplaylist\x18\x05 \x01(\v2\x13.myncer.MusicSourceR\bplaylist\x12\"\n" +
+	"\x05songs\x18\x06 \x03(\v2\f.myncer.SongR\x05songs\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"f\n" +
+	"\x1cListPlaylistSnapshotsRequest\x12/\n" +
+	"\bplaylist\x18\x01 \x01(\v2\x13.myncer.MusicSourceR\bplaylist\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\"W\n" +
+	"\x1dListPlaylistSnapshotsResponse\x126\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x18.myncer.PlaylistSnapshotR\tsnapshots\"k\n" +
+	"\x1cDiffPlaylistSnapshotsRequest\x12\x1f\n" +
+	"\vsnapshot_id\x18\x01 \x01(\tR\n" +
+	"snapshotId\x12*\n" +
+	"\x11other_snapshot_id\x18\x02 \x01(\tR\x0fotherSnapshotId\"\x81\x01\n" +
+	"\x1dDiffPlaylistSnapshotsResponse\x12-\n" +
+	"\vadded_songs\x18\x01 \x03(\v2\f.myncer.SongR\n" +
+	"addedSongs\x121\n" +
+	"\rremoved_songs\x18\x02 \x03(\v2\f.myncer.SongR\fremovedSongs\"A\n" +
+	"\x1eRestorePlaylistSnapshotRequest\x12\x1f\n" +
+	"\vsnapshot_id\x18\x01 \x01(\tR\n" +
+	"snapshotId\"W\n" +
+	"\x1fRestorePlaylistSnapshotResponse\x124\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x18.myncer.PlaylistSnapshotR\bsnapshot\"\x15\n" +
+	"\x13GetSyncGraphRequest\"?\n" +
+	"\x14GetSyncGraphResponse\x12'\n" +
+	"\x05graph\x18\x01 \x01(\v2\x11.myncer.SyncGraphR\x05graph\"\x93\x01\n" +
+	"\tSyncGraph\x12)\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x13.myncer.MusicSourceR\x05nodes\x12+\n" +
+	"\x05edges\x18\x02 \x03(\v2\x15.myncer.SyncGraphEdgeR\x05edges\x12.\n" +
+	"\x06issues\x18\x03 \x03(\v2\x16.myncer.SyncGraphIssueR\x06issues\"\xac\x01\n" +
+	"\rSyncGraphEdge\x12\x17\n" +
+	"\async_id\x18\x01 \x01(\tR\x06syncId\x12+\n" +
+	"\x06source\x18\x02 \x01(\v2\x13.myncer.MusicSourceR\x06source\x125\n" +
+	"\vdestination\x18\x03 \x01(\v2\x13.myncer.MusicSourceR\vdestination\x12\x1e\n" +
+	"\n" +
+	"overwrites\x18\x04 \x01(\bR\n" +
+	"overwrites\"E\n" +
+	"\x0eSyncGraphIssue\x12\x19\n" +
+	"\bsync_ids\x18\x01 \x03(\tR\asyncIds\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xdf\x01\n" +
+	"\x16TransferLibraryRequest\x12?\n" +
+	"\x11source_datasource\x18\x01 \x01(\x0e2\x12.myncer.DatasourceR\x10sourceDatasource\x12I\n" +
+	"\x16destination_datasource\x18\x02 \x01(\x0e2\x12.myncer.DatasourceR\x15destinationDatasource\x12!\n" +
+	"\fplaylist_ids\x18\x03 \x03(\tR\vplaylistIds\x12\x16\n" +
+	"\x06public\x18\x04 \x01(\bR\x06public\"N\n" +
+	"\x17TransferLibraryResponse\x123\n" +
+	"\btransfer\x18\x01 \x01(\v2\x17.myncer.LibraryTransferR\btransfer\"<\n" +
+	"\x19GetLibraryTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId\"Q\n" +
+	"\x1aGetLibraryTransferResponse\x123\n" +
+	"\btransfer\x18\x01 \x01(\v2\x17.myncer.LibraryTransferR\btransfer\"\x1d\n" +
+	"\x1bListLibraryTransfersRequest\"U\n" +
+	"\x1cListLibraryTransfersResponse\x125\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x17.myncer.LibraryTransferR\ttransfers\"\x87\x04\n" +
+	"\x0fLibraryTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12?\n" +
+	"\x11source_datasource\x18\x05 \x01(\x0e2\x12.myncer.DatasourceR\x10sourceDatasource\x12I\n" +
+	"\x16destination_datasource\x18\x06 \x01(\x0e2\x12.myncer.DatasourceR\x15destinationDatasource\x121\n" +
+	"\x05items\x18\a \x03(\v2\x1b.myncer.LibraryTransferItemR\x05items\x12*\n" +
+	"\x06status\x18\b \x01(\x0e2\x12.myncer.SyncStatusR\x06status\x123\n" +
+	"\bprogress\x18\t \x01(\v2\x17.myncer.SyncRunProgressR\bprogress\x125\n" +
+	"\x0funmatched_songs\x18\n" +
+	" \x03(\v2\f.myncer.SongR\x0eunmatchedSongs\"\x9b\x02\n" +
+	"\x13LibraryTransferItem\x12+\n" +
+	"\x06source\x18\x01 \x01(\v2\x13.myncer.MusicSourceR\x06source\x12\x1f\n" +
+	"\vsource_name\x18\x02 \x01(\tR\n" +
+	"sourceName\x125\n" +
+	"\vdestination\x18\x03 \x01(\v2\x13.myncer.MusicSourceR\vdestination\x12\x17\n" +
+	"\async_id\x18\x04 \x01(\tR\x06syncId\x12\x15\n" +
+	"\x06run_id\x18\x05 \x01(\tR\x05runId\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\x12*\n" +
+	"\x06status\x18\a \x01(\x0e2\x12.myncer.SyncStatusR\x06status\"h\n" +
+	"\x1bClearSongResolutionsRequest\x12I\n" +
+	"\x16destination_datasource\x18\x01 \x01(\x0e2\x12.myncer.DatasourceR\x15destinationDatasource\"\x1e\n" +
+	"\x1cClearSongResolutionsResponse\"\xae\x01\n" +
+	"\x12SearchSongsRequest\x122\n" +
+	"\n" +
+	"datasource\x18\x01 \x01(\x0e2\x12.myncer.DatasourceR\n" +
+	"datasource\x12 \n" +
+	"\x04song\x18\x02 \x01(\v2\f.myncer.SongR\x04song\x12B\n" +
+	"\x10matching_profile\x18\x03 \x01(\v2\x17.myncer.MatchingProfileR\x0fmatchingProfile\"Q\n" +
+	"\x13SearchSongsResponse\x12:\n" +
+	"\n" +
+	"candidates\x18\x01 \x03(\v2\x1a.myncer.SongMatchCandidateR\n" +
+	"candidates\"\xf9\x01\n" +
+	"\x16SetSongOverrideRequest\x12-\n" +
+	"\vsource_song\x18\x01 \x01(\v2\f.myncer.SongR\n" +
+	"sourceSong\x12I\n" +
+	"\x16destination_datasource\x18\x02 \x01(\x0e2\x12.myncer.DatasourceR\x15destinationDatasource\x12,\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x18.myncer.SongOverrideKindR\x04kind\x127\n" +
+	"\x10destination_song\x18\x04 \x01(\v2\f.myncer.SongR\x0fdestinationSong\"T\n" +
+	"\x17SetSongOverrideResponse\x129\n" +
+	"\rsong_override\x18\x01 \x01(\v2\x14.myncer.SongOverrideR\fsongOverride\"\x95\x01\n" +
+	"\x19DeleteSongOverrideRequest\x12-\n" +
+	"\vsource_song\x18\x01 \x01(\v2\f.myncer.SongR\n" +
+	"sourceSong\x12I\n" +
+	"\x16destination_datasource\x18\x02 \x01(\x0e2\x12.myncer.DatasourceR\x15destinationDatasource\"\x1c\n" +
+	"\x1aDeleteSongOverrideResponse\"\x1a\n" +
+	"\x18ListSongOverridesRequest\"X\n" +
+	"\x19ListSongOverridesResponse\x12;\n" +
+	"\x0esong_overrides\x18\x01 \x03(\v2\x14.myncer.SongOverrideR\rsongOverrides*\x95\x01\n" +
+	"\x15PlaylistMergeSyncMode\x12(\n" +
+	"$PLAYLIST_MERGE_SYNC_MODE_UNSPECIFIED\x10\x00\x12*\n" +
+	"&PLAYLIST_MERGE_SYNC_MODE_BIDIRECTIONAL\x10\x01\x12&\n" +
+	"\"PLAYLIST_MERGE_SYNC_MODE_THREE_WAY\x10\x02*~\n" +
+	"\x13MergeConflictPolicy\x12%\n" +
+	"!MERGE_CONFLICT_POLICY_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aMERGE_CONFLICT_POLICY_KEEP\x10\x01\x12 \n" +
+	"\x1cMERGE_CONFLICT_POLICY_REMOVE\x10\x02*\xce\x01\n" +
+	"\x14SyncScheduleInterval\x12&\n" +
+	"\"SYNC_SCHEDULE_INTERVAL_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dSYNC_SCHEDULE_INTERVAL_HOURLY\x10\x01\x12!\n" +
+	"\x1dSYNC_SCHEDULE_INTERVAL_WEEKLY\x10\x02\x12$\n" +
+	" SYNC_SCHEDULE_INTERVAL_BI_WEEKLY\x10\x03\x12\"\n" +
+	"\x1eSYNC_SCHEDULE_INTERVAL_MONTHLY\x10\x04*G\n" +
+	"\vSyncRunKind\x12\x1d\n" +
+	"\x19SYNC_RUN_KIND_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SYNC_RUN_KIND_PREVIEW\x10\x01*\xcf\x02\n" +
+	"\fSyncRunPhase\x12\x1e\n" +
+	"\x1aSYNC_RUN_PHASE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSYNC_RUN_PHASE_FETCH_SOURCE\x10\x01\x12\x1c\n" +
+	"\x18SYNC_RUN_PHASE_NORMALIZE\x10\x02\x12\x19\n" +
+	"\x15SYNC_RUN_PHASE_SEARCH\x10\x03\x12$\n" +
+	" SYNC_RUN_PHASE_CLEAR_DESTINATION\x10\x04\x12%\n" +
+	"!SYNC_RUN_PHASE_ADD_TO_DESTINATION\x10\x05\x12$\n" +
+	" SYNC_RUN_PHASE_FETCH_DESTINATION\x10\x06\x12*\n" +
+	"&SYNC_RUN_PHASE_REMOVE_FROM_DESTINATION\x10\a\x12&\n" +
+	"\"SYNC_RUN_PHASE_REORDER_DESTINATION\x10\b*\x98\x01\n" +
+	"\rPlaylistOrder\x12\x1e\n" +
+	"\x1aPLAYLIST_ORDER_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15PLAYLIST_ORDER_SOURCE\x10\x01\x12\x17\n" +
+	"\x13PLAYLIST_ORDER_NAME\x10\x02\x12\x19\n" +
+	"\x15PLAYLIST_ORDER_ARTIST\x10\x03\x12\x18\n" +
+	"\x14PLAYLIST_ORDER_ALBUM\x10\x04*O\n" +
+	"\x0eOneWaySyncMode\x12!\n" +
+	"\x1dONE_WAY_SYNC_MODE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16ONE_WAY_SYNC_MODE_DIFF\x10\x01*\xa9\x01\n" +
 	"\n" +
 	"SyncStatus\x12\x1b\n" +
 	"\x17SYNC_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
@@ -1075,16 +5723,35 @@ const file_myncer_sync_proto_rawDesc = "" +
 	"\x13SYNC_STATUS_RUNNING\x10\x02\x12\x19\n" +
 	"\x15SYNC_STATUS_COMPLETED\x10\x03\x12\x16\n" +
 	"\x12SYNC_STATUS_FAILED\x10\x04\x12\x19\n" +
-	"\x15SYNC_STATUS_CANCELLED\x10\x052\x9c\x03\n" +
+	"\x15SYNC_STATUS_CANCELLED\x10\x052\xd0\x0e\n" +
 	"\vSyncService\x12C\n" +
 	"\n" +
 	"CreateSync\x12\x19.myncer.CreateSyncRequest\x1a\x1a.myncer.CreateSyncResponse\x12C\n" +
 	"\n" +
-	"DeleteSync\x12\x19.myncer.DeleteSyncRequest\x1a\x1a.myncer.DeleteSyncResponse\x12@\n" +
+	"DeleteSync\x12\x19.myncer.DeleteSyncRequest\x1a\x1a.myncer.DeleteSyncResponse\x12C\n" +
+	"\n" +
+	"UpdateSync\x12\x19.myncer.UpdateSyncRequest\x1a\x1a.myncer.UpdateSyncResponse\x12@\n" +
+	"\tPauseSync\x12\x18.myncer.PauseSyncRequest\x1a\x19.myncer.PauseSyncResponse\x12C\n" +
+	"\n" +
+	"ResumeSync\x12\x19.myncer.ResumeSyncRequest\x1a\x1a.myncer.ResumeSyncResponse\x12@\n" +
 	"\tListSyncs\x12\x18.myncer.ListSyncsRequest\x1a\x19.myncer.ListSyncsResponse\x12:\n" +
 	"\aGetSync\x12\x16.myncer.GetSyncRequest\x1a\x17.myncer.GetSyncResponse\x12:\n" +
 	"\aRunSync\x12\x16.myncer.RunSyncRequest\x1a\x17.myncer.RunSyncResponse\x12I\n" +
-	"\fListSyncRuns\x12\x1b.myncer.ListSyncRunsRequest\x1a\x1c.myncer.ListSyncRunsResponseB3Z1github.com/hansbala/myncer/proto/myncer;myncer_pbb\x06proto3"
+	"\fListSyncRuns\x12\x1b.myncer.ListSyncRunsRequest\x1a\x1c.myncer.ListSyncRunsResponse\x12L\n" +
+	"\rCancelSyncRun\x12\x1c.myncer.CancelSyncRunRequest\x1a\x1d.myncer.CancelSyncRunResponse\x12K\n" +
+	"\fWatchSyncRun\x12\x1b.myncer.WatchSyncRunRequest\x1a\x1c.myncer.WatchSyncRunResponse0\x01\x12d\n" +
+	"\x15ListPlaylistSnapshots\x12$.myncer.ListPlaylistSnapshotsRequest\x1a%.myncer.ListPlaylistSnapshotsResponse\x12d\n" +
+	"\x15DiffPlaylistSnapshots\x12$.myncer.DiffPlaylistSnapshotsRequest\x1a%.myncer.DiffPlaylistSnapshotsResponse\x12j\n" +
+	"\x17RestorePlaylistSnapshot\x12&.myncer.RestorePlaylistSnapshotRequest\x1a'.myncer.RestorePlaylistSnapshotResponse\x12I\n" +
+	"\fGetSyncGraph\x12\x1b.myncer.GetSyncGraphRequest\x1a\x1c.myncer.GetSyncGraphResponse\x12R\n" +
+	"\x0fTransferLibrary\x12\x1e.myncer.TransferLibraryRequest\x1a\x1f.myncer.TransferLibraryResponse\x12[\n" +
+	"\x12GetLibraryTransfer\x12!.myncer.GetLibraryTransferRequest\x1a\".myncer.GetLibraryTransferResponse\x12a\n" +
+	"\x14ListLibraryTransfers\x12#.myncer.ListLibraryTransfersRequest\x1a$.myncer.ListLibraryTransfersResponse\x12a\n" +
+	"\x14ClearSongResolutions\x12#.myncer.ClearSongResolutionsRequest\x1a$.myncer.ClearSongResolutionsResponse\x12F\n" +
+	"\vSearchSongs\x12\x1a.myncer.SearchSongsRequest\x1a\x1b.myncer.SearchSongsResponse\x12R\n" +
+	"\x0fSetSongOverride\x12\x1e.myncer.SetSongOverrideRequest\x1a\x1f.myncer.SetSongOverrideResponse\x12[\n" +
+	"\x12DeleteSongOverride\x12!.myncer.DeleteSongOverrideRequest\x1a\".myncer.DeleteSongOverrideResponse\x12X\n" +
+	"\x11ListSongOverrides\x12 .myncer.ListSongOverridesRequest\x1a!.myncer.ListSongOverridesResponseB3Z1github.com/hansbala/myncer/proto/myncer;myncer_pbb\x06proto3"
 
 var (
 	file_myncer_sync_proto_rawDescOnce sync.Once
@@ -1098,67 +5765,288 @@ func file_myncer_sync_proto_rawDescGZIP() []byte {
 	return file_myncer_sync_proto_rawDescData
 }
 
-var file_myncer_sync_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_myncer_sync_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_myncer_sync_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_myncer_sync_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
 var file_myncer_sync_proto_goTypes = []any{
-	(SyncStatus)(0),               // 0: myncer.SyncStatus
-	(*PlaylistMergeSync)(nil),     // 1: myncer.PlaylistMergeSync
-	(*Sync)(nil),                  // 2: myncer.Sync
-	(*SyncRun)(nil),               // 3: myncer.SyncRun
-	(*OneWaySync)(nil),            // 4: myncer.OneWaySync
-	(*CreateSyncRequest)(nil),     // 5: myncer.CreateSyncRequest
-	(*CreateSyncResponse)(nil),    // 6: myncer.CreateSyncResponse
-	(*DeleteSyncRequest)(nil),     // 7: myncer.DeleteSyncRequest
-	(*DeleteSyncResponse)(nil),    // 8: myncer.DeleteSyncResponse
-	(*ListSyncsRequest)(nil),      // 9: myncer.ListSyncsRequest
-	(*ListSyncsResponse)(nil),     // 10: myncer.ListSyncsResponse
-	(*GetSyncRequest)(nil),        // 11: myncer.GetSyncRequest
-	(*GetSyncResponse)(nil),       // 12: myncer.GetSyncResponse
-	(*RunSyncRequest)(nil),        // 13: myncer.RunSyncRequest
-	(*RunSyncResponse)(nil),       // 14: myncer.RunSyncResponse
-	(*ListSyncRunsRequest)(nil),   // 15: myncer.ListSyncRunsRequest
-	(*ListSyncRunsResponse)(nil),  // 16: myncer.ListSyncRunsResponse
-	(*MusicSource)(nil),           // 17: myncer.MusicSource
-	(*timestamppb.Timestamp)(nil), // 18: google.protobuf.Timestamp
-	(*Song)(nil),                  // 19: myncer.Song
+	(PlaylistMergeSyncMode)(0),              // 0: myncer.PlaylistMergeSyncMode
+	(MergeConflictPolicy)(0),                // 1: myncer.MergeConflictPolicy
+	(SyncScheduleInterval)(0),               // 2: myncer.SyncScheduleInterval
+	(SyncRunKind)(0),                        // 3: myncer.SyncRunKind
+	(SyncRunPhase)(0),                       // 4: myncer.SyncRunPhase
+	(PlaylistOrder)(0),                      // 5: myncer.PlaylistOrder
+	(OneWaySyncMode)(0),                     // 6: myncer.OneWaySyncMode
+	(SyncStatus)(0),                         // 7: myncer.SyncStatus
+	(*PlaylistMergeSync)(nil),               // 8: myncer.PlaylistMergeSync
+	(*SyncBaseline)(nil),                    // 9: myncer.SyncBaseline
+	(*SyncBaselinePlaylist)(nil),            // 10: myncer.SyncBaselinePlaylist
+	(*MergeConflict)(nil),                   // 11: myncer.MergeConflict
+	(*Sync)(nil),                            // 12: myncer.Sync
+	(*SyncPause)(nil),                       // 13: myncer.SyncPause
+	(*MatchingProfile)(nil),                 // 14: myncer.MatchingProfile
+	(*SyncFilterRule)(nil),                  // 15: myncer.SyncFilterRule
+	(*SyncFilterArtists)(nil),               // 16: myncer.SyncFilterArtists
+	(*RetryPolicy)(nil),                     // 17: myncer.RetryPolicy
+	(*SyncSchedule)(nil),                    // 18: myncer.SyncSchedule
+	(*SyncRun)(nil),                         // 19: myncer.SyncRun
+	(*SyncPreview)(nil),                     // 20: myncer.SyncPreview
+	(*SyncPreviewTarget)(nil),               // 21: myncer.SyncPreviewTarget
+	(*SyncRunTargetResult)(nil),             // 22: myncer.SyncRunTargetResult
+	(*SyncRunProgress)(nil),                 // 23: myncer.SyncRunProgress
+	(*SyncRunEvent)(nil),                    // 24: myncer.SyncRunEvent
+	(*SongMatchResult)(nil),                 // 25: myncer.SongMatchResult
+	(*SyncRunAttempt)(nil),                  // 26: myncer.SyncRunAttempt
+	(*OneWaySync)(nil),                      // 27: myncer.OneWaySync
+	(*FanOutSync)(nil),                      // 28: myncer.FanOutSync
+	(*CreateSyncRequest)(nil),               // 29: myncer.CreateSyncRequest
+	(*NewPlaylist)(nil),                     // 30: myncer.NewPlaylist
+	(*CreateSyncResponse)(nil),              // 31: myncer.CreateSyncResponse
+	(*UpdateSyncRequest)(nil),               // 32: myncer.UpdateSyncRequest
+	(*UpdateSyncResponse)(nil),              // 33: myncer.UpdateSyncResponse
+	(*PauseSyncRequest)(nil),                // 34: myncer.PauseSyncRequest
+	(*PauseSyncResponse)(nil),               // 35: myncer.PauseSyncResponse
+	(*ResumeSyncRequest)(nil),               // 36: myncer.ResumeSyncRequest
+	(*ResumeSyncResponse)(nil),              // 37: myncer.ResumeSyncResponse
+	(*DeleteSyncRequest)(nil),               // 38: myncer.DeleteSyncRequest
+	(*DeleteSyncResponse)(nil),              // 39: myncer.DeleteSyncResponse
+	(*ListSyncsRequest)(nil),                // 40: myncer.ListSyncsRequest
+	(*ListSyncsResponse)(nil),               // 41: myncer.ListSyncsResponse
+	(*GetSyncRequest)(nil),                  // 42: myncer.GetSyncRequest
+	(*GetSyncResponse)(nil),                 // 43: myncer.GetSyncResponse
+	(*RunSyncRequest)(nil),                  // 44: myncer.RunSyncRequest
+	(*RunSyncResponse)(nil),                 // 45: myncer.RunSyncResponse
+	(*ListSyncRunsRequest)(nil),             // 46: myncer.ListSyncRunsRequest
+	(*ListSyncRunsResponse)(nil),            // 47: myncer.ListSyncRunsResponse
+	(*CancelSyncRunRequest)(nil),            // 48: myncer.CancelSyncRunRequest
+	(*CancelSyncRunResponse)(nil),           // 49: myncer.CancelSyncRunResponse
+	(*WatchSyncRunRequest)(nil),             // 50: myncer.WatchSyncRunRequest
+	(*WatchSyncRunResponse)(nil),            // 51: myncer.WatchSyncRunResponse
+	(*PlaylistSnapshot)(nil),                // 52: myncer.PlaylistSnapshot
+	(*ListPlaylistSnapshotsRequest)(nil),    // 53: myncer.ListPlaylistSnapshotsRequest
+	(*ListPlaylistSnapshotsResponse)(nil),   // 54: myncer.ListPlaylistSnapshotsResponse
+	(*DiffPlaylistSnapshotsRequest)(nil),    // 55: myncer.DiffPlaylistSnapshotsRequest
+	(*DiffPlaylistSnapshotsResponse)(nil),   // 56: myncer.DiffPlaylistSnapshotsResponse
+	(*RestorePlaylistSnapshotRequest)(nil),  // 57: myncer.RestorePlaylistSnapshotRequest
+	(*RestorePlaylistSnapshotResponse)(nil), // 58: myncer.RestorePlaylistSnapshotResponse
+	(*GetSyncGraphRequest)(nil),             // 59: myncer.GetSyncGraphRequest
+	(*GetSyncGraphResponse)(nil),            // 60: myncer.GetSyncGraphResponse
+	(*SyncGraph)(nil),                       // 61: myncer.SyncGraph
+	(*SyncGraphEdge)(nil),                   // 62: myncer.SyncGraphEdge
+	(*SyncGraphIssue)(nil),                  // 63: myncer.SyncGraphIssue
+	(*TransferLibraryRequest)(nil),          // 64: myncer.TransferLibraryRequest
+	(*TransferLibraryResponse)(nil),         // 65: myncer.TransferLibraryResponse
+	(*GetLibraryTransferRequest)(nil),       // 66: myncer.GetLibraryTransferRequest
+	(*GetLibraryTransferResponse)(nil),      // 67: myncer.GetLibraryTransferResponse
+	(*ListLibraryTransfersRequest)(nil),     // 68: myncer.ListLibraryTransfersRequest
+	(*ListLibraryTransfersResponse)(nil),    // 69: myncer.ListLibraryTransfersResponse
+	(*LibraryTransfer)(nil),                 // 70: myncer.LibraryTransfer
+	(*LibraryTransferItem)(nil),             // 71: myncer.LibraryTransferItem
+	(*ClearSongResolutionsRequest)(nil),     // 72: myncer.ClearSongResolutionsRequest
+	(*ClearSongResolutionsResponse)(nil),    // 73: myncer.ClearSongResolutionsResponse
+	(*SearchSongsRequest)(nil),              // 74: myncer.SearchSongsRequest
+	(*SearchSongsResponse)(nil),             // 75: myncer.SearchSongsResponse
+	(*SetSongOverrideRequest)(nil),          // 76: myncer.SetSongOverrideRequest
+	(*SetSongOverrideResponse)(nil),         // 77: myncer.SetSongOverrideResponse
+	(*DeleteSongOverrideRequest)(nil),       // 78: myncer.DeleteSongOverrideRequest
+	(*DeleteSongOverrideResponse)(nil),      // 79: myncer.DeleteSongOverrideResponse
+	(*ListSongOverridesRequest)(nil),        // 80: myncer.ListSongOverridesRequest
+	(*ListSongOverridesResponse)(nil),       // 81: myncer.ListSongOverridesResponse
+	(*MusicSource)(nil),                     // 82: myncer.MusicSource
+	(*timestamppb.Timestamp)(nil),           // 83: google.protobuf.Timestamp
+	(*Song)(nil),                            // 84: myncer.Song
+	(*SongMatchCandidate)(nil),              // 85: myncer.SongMatchCandidate
+	(Datasource)(0),                         // 86: myncer.Datasource
+	(SongOverrideKind)(0),                   // 87: myncer.SongOverrideKind
+	(*SongOverride)(nil),                    // 88: myncer.SongOverride
 }
 var file_myncer_sync_proto_depIdxs = []int32{
-	17, // 0: myncer.PlaylistMergeSync.sources:type_name -> myncer.MusicSource
-	17, // 1: myncer.PlaylistMergeSync.destination:type_name -> myncer.MusicSource
-	18, // 2: myncer.Sync.created_at:type_name -> google.protobuf.Timestamp
-	18, // 3: myncer.Sync.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 4: myncer.Sync.one_way_sync:type_name -> myncer.OneWaySync
-	1,  // 5: myncer.Sync.playlist_merge_sync:type_name -> myncer.PlaylistMergeSync
-	0,  // 6: myncer.SyncRun.sync_status:type_name -> myncer.SyncStatus
-	18, // 7: myncer.SyncRun.created_at:type_name -> google.protobuf.Timestamp
-	18, // 8: myncer.SyncRun.updated_at:type_name -> google.protobuf.Timestamp
-	19, // 9: myncer.SyncRun.unmatched_songs:type_name -> myncer.Song
-	17, // 10: myncer.OneWaySync.source:type_name -> myncer.MusicSource
-	17, // 11: myncer.OneWaySync.destination:type_name -> myncer.MusicSource
-	4,  // 12: myncer.CreateSyncRequest.one_way_sync:type_name -> myncer.OneWaySync
-	1,  // 13: myncer.CreateSyncRequest.playlist_merge_sync:type_name -> myncer.PlaylistMergeSync
-	2,  // 14: myncer.CreateSyncResponse.sync:type_name -> myncer.Sync
-	2,  // 15: myncer.ListSyncsResponse.syncs:type_name -> myncer.Sync
-	2,  // 16: myncer.GetSyncResponse.sync:type_name -> myncer.Sync
-	0,  // 17: myncer.RunSyncResponse.status:type_name -> myncer.SyncStatus
-	3,  // 18: myncer.ListSyncRunsResponse.sync_runs:type_name -> myncer.SyncRun
-	5,  // 19: myncer.SyncService.CreateSync:input_type -> myncer.CreateSyncRequest
-	7,  // 20: myncer.SyncService.DeleteSync:input_type -> myncer.DeleteSyncRequest
-	9,  // 21: myncer.SyncService.ListSyncs:input_type -> myncer.ListSyncsRequest
-	11, // 22: myncer.SyncService.GetSync:input_type -> myncer.GetSyncRequest
-	13, // 23: myncer.SyncService.RunSync:input_type -> myncer.RunSyncRequest
-	15, // 24: myncer.SyncService.ListSyncRuns:input_type -> myncer.ListSyncRunsRequest
-	6,  // 25: myncer.SyncService.CreateSync:output_type -> myncer.CreateSyncResponse
-	8,  // 26: myncer.SyncService.DeleteSync:output_type -> myncer.DeleteSyncResponse
-	10, // 27: myncer.SyncService.ListSyncs:output_type -> myncer.ListSyncsResponse
-	12, // 28: myncer.SyncService.GetSync:output_type -> myncer.GetSyncResponse
-	14, // 29: myncer.SyncService.RunSync:output_type -> myncer.RunSyncResponse
-	16, // 30: myncer.SyncService.ListSyncRuns:output_type -> myncer.ListSyncRunsResponse
-	25, // [25:31] is the sub-list for method output_type
-	19, // [19:25] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	82,  // 0: myncer.PlaylistMergeSync.sources:type_name -> myncer.MusicSource
+	82,  // 1: myncer.PlaylistMergeSync.destination:type_name -> myncer.MusicSource
+	0,   // 2: myncer.PlaylistMergeSync.mode:type_name -> myncer.PlaylistMergeSyncMode
+	1,   // 3: myncer.PlaylistMergeSync.conflict_policy:type_name -> myncer.MergeConflictPolicy
+	5,   // 4: myncer.PlaylistMergeSync.order:type_name -> myncer.PlaylistOrder
+	10,  // 5: myncer.SyncBaseline.playlists:type_name -> myncer.SyncBaselinePlaylist
+	83,  // 6: myncer.SyncBaseline.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 7: myncer.SyncBaseline.updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 8: myncer.SyncBaselinePlaylist.playlist:type_name -> myncer.MusicSource
+	84,  // 9: myncer.SyncBaselinePlaylist.songs:type_name -> myncer.Song
+	84,  // 10: myncer.MergeConflict.removed_song:type_name -> myncer.Song
+	82,  // 11: myncer.MergeConflict.removed_from:type_name -> myncer.MusicSource
+	84,  // 12: myncer.MergeConflict.added_song:type_name -> myncer.Song
+	82,  // 13: myncer.MergeConflict.added_to:type_name -> myncer.MusicSource
+	1,   // 14: myncer.MergeConflict.resolution:type_name -> myncer.MergeConflictPolicy
+	83,  // 15: myncer.Sync.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 16: myncer.Sync.updated_at:type_name -> google.protobuf.Timestamp
+	27,  // 17: myncer.Sync.one_way_sync:type_name -> myncer.OneWaySync
+	8,   // 18: myncer.Sync.playlist_merge_sync:type_name -> myncer.PlaylistMergeSync
+	28,  // 19: myncer.Sync.fan_out_sync:type_name -> myncer.FanOutSync
+	18,  // 20: myncer.Sync.schedule:type_name -> myncer.SyncSchedule
+	17,  // 21: myncer.Sync.retry_policy:type_name -> myncer.RetryPolicy
+	15,  // 22: myncer.Sync.filter_rules:type_name -> myncer.SyncFilterRule
+	14,  // 23: myncer.Sync.matching_profile:type_name -> myncer.MatchingProfile
+	13,  // 24: myncer.Sync.pause:type_name -> myncer.SyncPause
+	83,  // 25: myncer.SyncPause.paused_at:type_name -> google.protobuf.Timestamp
+	16,  // 26: myncer.SyncFilterRule.exclude_artists:type_name -> myncer.SyncFilterArtists
+	2,   // 27: myncer.SyncSchedule.interval:type_name -> myncer.SyncScheduleInterval
+	83,  // 28: myncer.SyncSchedule.next_run_at:type_name -> google.protobuf.Timestamp
+	83,  // 29: myncer.SyncSchedule.last_run_at:type_name -> google.protobuf.Timestamp
+	7,   // 30: myncer.SyncRun.sync_status:type_name -> myncer.SyncStatus
+	83,  // 31: myncer.SyncRun.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 32: myncer.SyncRun.updated_at:type_name -> google.protobuf.Timestamp
+	84,  // 33: myncer.SyncRun.unmatched_songs:type_name -> myncer.Song
+	4,   // 34: myncer.SyncRun.phase:type_name -> myncer.SyncRunPhase
+	26,  // 35: myncer.SyncRun.attempts:type_name -> myncer.SyncRunAttempt
+	23,  // 36: myncer.SyncRun.progress:type_name -> myncer.SyncRunProgress
+	22,  // 37: myncer.SyncRun.target_results:type_name -> myncer.SyncRunTargetResult
+	11,  // 38: myncer.SyncRun.conflicts:type_name -> myncer.MergeConflict
+	3,   // 39: myncer.SyncRun.kind:type_name -> myncer.SyncRunKind
+	20,  // 40: myncer.SyncRun.preview:type_name -> myncer.SyncPreview
+	84,  // 41: myncer.SyncRun.excluded_songs:type_name -> myncer.Song
+	25,  // 42: myncer.SyncRun.low_confidence_matches:type_name -> myncer.SongMatchResult
+	21,  // 43: myncer.SyncPreview.targets:type_name -> myncer.SyncPreviewTarget
+	25,  // 44: myncer.SyncPreview.matches:type_name -> myncer.SongMatchResult
+	82,  // 45: myncer.SyncPreviewTarget.target:type_name -> myncer.MusicSource
+	84,  // 46: myncer.SyncPreviewTarget.songs_to_add:type_name -> myncer.Song
+	84,  // 47: myncer.SyncPreviewTarget.songs_to_remove:type_name -> myncer.Song
+	82,  // 48: myncer.SyncRunTargetResult.target:type_name -> myncer.MusicSource
+	84,  // 49: myncer.SyncRunTargetResult.unmatched_songs:type_name -> myncer.Song
+	83,  // 50: myncer.SyncRunEvent.created_at:type_name -> google.protobuf.Timestamp
+	4,   // 51: myncer.SyncRunEvent.phase:type_name -> myncer.SyncRunPhase
+	25,  // 52: myncer.SyncRunEvent.song_match_result:type_name -> myncer.SongMatchResult
+	23,  // 53: myncer.SyncRunEvent.progress:type_name -> myncer.SyncRunProgress
+	84,  // 54: myncer.SongMatchResult.source_song:type_name -> myncer.Song
+	84,  // 55: myncer.SongMatchResult.destination_song:type_name -> myncer.Song
+	85,  // 56: myncer.SongMatchResult.runner_ups:type_name -> myncer.SongMatchCandidate
+	83,  // 57: myncer.SyncRunAttempt.started_at:type_name -> google.protobuf.Timestamp
+	83,  // 58: myncer.SyncRunAttempt.finished_at:type_name -> google.protobuf.Timestamp
+	83,  // 59: myncer.SyncRunAttempt.next_attempt_at:type_name -> google.protobuf.Timestamp
+	82,  // 60: myncer.OneWaySync.source:type_name -> myncer.MusicSource
+	82,  // 61: myncer.OneWaySync.destination:type_name -> myncer.MusicSource
+	6,   // 62: myncer.OneWaySync.mode:type_name -> myncer.OneWaySyncMode
+	5,   // 63: myncer.OneWaySync.order:type_name -> myncer.PlaylistOrder
+	82,  // 64: myncer.FanOutSync.source:type_name -> myncer.MusicSource
+	82,  // 65: myncer.FanOutSync.destinations:type_name -> myncer.MusicSource
+	6,   // 66: myncer.FanOutSync.mode:type_name -> myncer.OneWaySyncMode
+	5,   // 67: myncer.FanOutSync.order:type_name -> myncer.PlaylistOrder
+	27,  // 68: myncer.CreateSyncRequest.one_way_sync:type_name -> myncer.OneWaySync
+	8,   // 69: myncer.CreateSyncRequest.playlist_merge_sync:type_name -> myncer.PlaylistMergeSync
+	28,  // 70: myncer.CreateSyncRequest.fan_out_sync:type_name -> myncer.FanOutSync
+	2,   // 71: myncer.CreateSyncRequest.schedule_interval:type_name -> myncer.SyncScheduleInterval
+	17,  // 72: myncer.CreateSyncRequest.retry_policy:type_name -> myncer.RetryPolicy
+	30,  // 73: myncer.CreateSyncRequest.new_destination_playlist:type_name -> myncer.NewPlaylist
+	15,  // 74: myncer.CreateSyncRequest.filter_rules:type_name -> myncer.SyncFilterRule
+	14,  // 75: myncer.CreateSyncRequest.matching_profile:type_name -> myncer.MatchingProfile
+	12,  // 76: myncer.CreateSyncResponse.sync:type_name -> myncer.Sync
+	27,  // 77: myncer.UpdateSyncRequest.one_way_sync:type_name -> myncer.OneWaySync
+	8,   // 78: myncer.UpdateSyncRequest.playlist_merge_sync:type_name -> myncer.PlaylistMergeSync
+	28,  // 79: myncer.UpdateSyncRequest.fan_out_sync:type_name -> myncer.FanOutSync
+	2,   // 80: myncer.UpdateSyncRequest.schedule_interval:type_name -> myncer.SyncScheduleInterval
+	17,  // 81: myncer.UpdateSyncRequest.retry_policy:type_name -> myncer.RetryPolicy
+	15,  // 82: myncer.UpdateSyncRequest.filter_rules:type_name -> myncer.SyncFilterRule
+	14,  // 83: myncer.UpdateSyncRequest.matching_profile:type_name -> myncer.MatchingProfile
+	12,  // 84: myncer.UpdateSyncResponse.sync:type_name -> myncer.Sync
+	12,  // 85: myncer.PauseSyncResponse.sync:type_name -> myncer.Sync
+	12,  // 86: myncer.ResumeSyncResponse.sync:type_name -> myncer.Sync
+	12,  // 87: myncer.ListSyncsResponse.syncs:type_name -> myncer.Sync
+	12,  // 88: myncer.GetSyncResponse.sync:type_name -> myncer.Sync
+	7,   // 89: myncer.RunSyncResponse.status:type_name -> myncer.SyncStatus
+	19,  // 90: myncer.ListSyncRunsResponse.sync_runs:type_name -> myncer.SyncRun
+	7,   // 91: myncer.CancelSyncRunResponse.status:type_name -> myncer.SyncStatus
+	19,  // 92: myncer.WatchSyncRunResponse.sync_run:type_name -> myncer.SyncRun
+	24,  // 93: myncer.WatchSyncRunResponse.event:type_name -> myncer.SyncRunEvent
+	82,  // 94: myncer.PlaylistSnapshot.playlist:type_name -> myncer.MusicSource
+	84,  // 95: myncer.PlaylistSnapshot.songs:type_name -> myncer.Song
+	83,  // 96: myncer.PlaylistSnapshot.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 97: myncer.ListPlaylistSnapshotsRequest.playlist:type_name -> myncer.MusicSource
+	52,  // 98: myncer.ListPlaylistSnapshotsResponse.snapshots:type_name -> myncer.PlaylistSnapshot
+	84,  // 99: myncer.DiffPlaylistSnapshotsResponse.added_songs:type_name -> myncer.Song
+	84,  // 100: myncer.DiffPlaylistSnapshotsResponse.removed_songs:type_name -> myncer.Song
+	52,  // 101: myncer.RestorePlaylistSnapshotResponse.snapshot:type_name -> myncer.PlaylistSnapshot
+	61,  // 102: myncer.GetSyncGraphResponse.graph:type_name -> myncer.SyncGraph
+	82,  // 103: myncer.SyncGraph.nodes:type_name -> myncer.MusicSource
+	62,  // 104: myncer.SyncGraph.edges:type_name -> myncer.SyncGraphEdge
+	63,  // 105: myncer.SyncGraph.issues:type_name -> myncer.SyncGraphIssue
+	82,  // 106: myncer.SyncGraphEdge.source:type_name -> myncer.MusicSource
+	82,  // 107: myncer.SyncGraphEdge.destination:type_name -> myncer.MusicSource
+	86,  // 108: myncer.TransferLibraryRequest.source_datasource:type_name -> myncer.Datasource
+	86,  // 109: myncer.TransferLibraryRequest.destination_datasource:type_name -> myncer.Datasource
+	70,  // 110: myncer.TransferLibraryResponse.transfer:type_name -> myncer.LibraryTransfer
+	70,  // 111: myncer.GetLibraryTransferResponse.transfer:type_name -> myncer.LibraryTransfer
+	70,  // 112: myncer.ListLibraryTransfersResponse.transfers:type_name -> myncer.LibraryTransfer
+	83,  // 113: myncer.LibraryTransfer.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 114: myncer.LibraryTransfer.updated_at:type_name -> google.protobuf.Timestamp
+	86,  // 115: myncer.LibraryTransfer.source_datasource:type_name -> myncer.Datasource
+	86,  // 116: myncer.LibraryTransfer.destination_datasource:type_name -> myncer.Datasource
+	71,  // 117: myncer.LibraryTransfer.items:type_name -> myncer.LibraryTransferItem
+	7,   // 118: myncer.LibraryTransfer.status:type_name -> myncer.SyncStatus
+	23,  // 119: myncer.LibraryTransfer.progress:type_name -> myncer.SyncRunProgress
+	84,  // 120: myncer.LibraryTransfer.unmatched_songs:type_name -> myncer.Song
+	82,  // 121: myncer.LibraryTransferItem.source:type_name -> myncer.MusicSource
+	82,  // 122: myncer.LibraryTransferItem.destination:type_name -> myncer.MusicSource
+	7,   // 123: myncer.LibraryTransferItem.status:type_name -> myncer.SyncStatus
+	86,  // 124: myncer.ClearSongResolutionsRequest.destination_datasource:type_name -> myncer.Datasource
+	86,  // 125: myncer.SearchSongsRequest.datasource:type_name -> myncer.Datasource
+	84,  // 126: myncer.SearchSongsRequest.song:type_name -> myncer.Song
+	14,  // 127: myncer.SearchSongsRequest.matching_profile:type_name -> myncer.MatchingProfile
+	85,  // 128: myncer.SearchSongsResponse.candidates:type_name -> myncer.SongMatchCandidate
+	84,  // 129: myncer.SetSongOverrideRequest.source_song:type_name -> myncer.Song
+	86,  // 130: myncer.SetSongOverrideRequest.destination_datasource:type_name -> myncer.Datasource
+	87,  // 131: myncer.SetSongOverrideRequest.kind:type_name -> myncer.SongOverrideKind
+	84,  // 132: myncer.SetSongOverrideRequest.destination_song:type_name -> myncer.Song
+	88,  // 133: myncer.SetSongOverrideResponse.song_override:type_name -> myncer.SongOverride
+	84,  // 134: myncer.DeleteSongOverrideRequest.source_song:type_name -> myncer.Song
+	86,  // 135: myncer.DeleteSongOverrideRequest.destination_datasource:type_name -> myncer.Datasource
+	88,  // 136: myncer.ListSongOverridesResponse.song_overrides:type_name -> myncer.SongOverride
+	29,  // 137: myncer.SyncService.CreateSync:input_type -> myncer.CreateSyncRequest
+	38,  // 138: myncer.SyncService.DeleteSync:input_type -> myncer.DeleteSyncRequest
+	32,  // 139: myncer.SyncService.UpdateSync:input_type -> myncer.UpdateSyncRequest
+	34,  // 140: myncer.SyncService.PauseSync:input_type -> myncer.PauseSyncRequest
+	36,  // 141: myncer.SyncService.ResumeSync:input_type -> myncer.ResumeSyncRequest
+	40,  // 142: myncer.SyncService.ListSyncs:input_type -> myncer.ListSyncsRequest
+	42,  // 143: myncer.SyncService.GetSync:input_type -> myncer.GetSyncRequest
+	44,  // 144: myncer.SyncService.RunSync:input_type -> myncer.RunSyncRequest
+	46,  // 145: myncer.SyncService.ListSyncRuns:input_type -> myncer.ListSyncRunsRequest
+	48,  // 146: myncer.SyncService.CancelSyncRun:input_type -> myncer.CancelSyncRunRequest
+	50,  // 147: myncer.SyncService.WatchSyncRun:input_type -> myncer.WatchSyncRunRequest
+	53,  // 148: myncer.SyncService.ListPlaylistSnapshots:input_type -> myncer.ListPlaylistSnapshotsRequest
+	55,  // 149: myncer.SyncService.DiffPlaylistSnapshots:input_type -> myncer.DiffPlaylistSnapshotsRequest
+	57,  // 150: myncer.SyncService.RestorePlaylistSnapshot:input_type -> myncer.RestorePlaylistSnapshotRequest
+	59,  // 151: myncer.SyncService.GetSyncGraph:input_type -> myncer.GetSyncGraphRequest
+	64,  // 152: myncer.SyncService.TransferLibrary:input_type -> myncer.TransferLibraryRequest
+	66,  // 153: myncer.SyncService.GetLibraryTransfer:input_type -> myncer.GetLibraryTransferRequest
+	68,  // 154: myncer.SyncService.ListLibraryTransfers:input_type -> myncer.ListLibraryTransfersRequest
+	72,  // 155: myncer.SyncService.ClearSongResolutions:input_type -> myncer.ClearSongResolutionsRequest
+	74,  // 156: myncer.SyncService.SearchSongs:input_type -> myncer.SearchSongsRequest
+	76,  // 157: myncer.SyncService.SetSongOverride:input_type -> myncer.SetSongOverrideRequest
+	78,  // 158: myncer.SyncService.DeleteSongOverride:input_type -> myncer.DeleteSongOverrideRequest
+	80,  // 159: myncer.SyncService.ListSongOverrides:input_type -> myncer.ListSongOverridesRequest
+	31,  // 160: myncer.SyncService.CreateSync:output_type -> myncer.CreateSyncResponse
+	39,  // 161: myncer.SyncService.DeleteSync:output_type -> myncer.DeleteSyncResponse
+	33,  // 162: myncer.SyncService.UpdateSync:output_type -> myncer.UpdateSyncResponse
+	35,  // 163: myncer.SyncService.PauseSync:output_type -> myncer.PauseSyncResponse
+	37,  // 164: myncer.SyncService.ResumeSync:output_type -> myncer.ResumeSyncResponse
+	41,  // 165: myncer.SyncService.ListSyncs:output_type -> myncer.ListSyncsResponse
+	43,  // 166: myncer.SyncService.GetSync:output_type -> myncer.GetSyncResponse
+	45,  // 167: myncer.SyncService.RunSync:output_type -> myncer.RunSyncResponse
+	47,  // 168: myncer.SyncService.ListSyncRuns:output_type -> myncer.ListSyncRunsResponse
+	49,  // 169: myncer.SyncService.CancelSyncRun:output_type -> myncer.CancelSyncRunResponse
+	51,  // 170: myncer.SyncService.WatchSyncRun:output_type -> myncer.WatchSyncRunResponse
+	54,  // 171: myncer.SyncService.ListPlaylistSnapshots:output_type -> myncer.ListPlaylistSnapshotsResponse
+	56,  // 172: myncer.SyncService.DiffPlaylistSnapshots:output_type -> myncer.DiffPlaylistSnapshotsResponse
+	58,  // 173: myncer.SyncService.RestorePlaylistSnapshot:output_type -> myncer.RestorePlaylistSnapshotResponse
+	60,  // 174: myncer.SyncService.GetSyncGraph:output_type -> myncer.GetSyncGraphResponse
+	65,  // 175: myncer.SyncService.TransferLibrary:output_type -> myncer.TransferLibraryResponse
+	67,  // 176: myncer.SyncService.GetLibraryTransfer:output_type -> myncer.GetLibraryTransferResponse
+	69,  // 177: myncer.SyncService.ListLibraryTransfers:output_type -> myncer.ListLibraryTransfersResponse
+	73,  // 178: myncer.SyncService.ClearSongResolutions:output_type -> myncer.ClearSongResolutionsResponse
+	75,  // 179: myncer.SyncService.SearchSongs:output_type -> myncer.SearchSongsResponse
+	77,  // 180: myncer.SyncService.SetSongOverride:output_type -> myncer.SetSongOverrideResponse
+	79,  // 181: myncer.SyncService.DeleteSongOverride:output_type -> myncer.DeleteSongOverrideResponse
+	81,  // 182: myncer.SyncService.ListSongOverrides:output_type -> myncer.ListSongOverridesResponse
+	160, // [160:183] is the sub-list for method output_type
+	137, // [137:160] is the sub-list for method input_type
+	137, // [137:137] is the sub-list for extension type_name
+	137, // [137:137] is the sub-list for extension extendee
+	0,   // [0:137] is the sub-list for field type_name
 }
 
 func init() { file_myncer_sync_proto_init() }
@@ -1168,21 +6056,42 @@ func file_myncer_sync_proto_init() {
 	}
 	file_myncer_datasource_proto_init()
 	file_myncer_song_proto_init()
-	file_myncer_sync_proto_msgTypes[1].OneofWrappers = []any{
+	file_myncer_sync_proto_msgTypes[4].OneofWrappers = []any{
 		(*Sync_OneWaySync)(nil),
 		(*Sync_PlaylistMergeSync)(nil),
+		(*Sync_FanOutSync)(nil),
 	}
-	file_myncer_sync_proto_msgTypes[4].OneofWrappers = []any{
+	file_myncer_sync_proto_msgTypes[7].OneofWrappers = []any{
+		(*SyncFilterRule_ExcludeArtists)(nil),
+		(*SyncFilterRule_ExcludeNamePattern)(nil),
+		(*SyncFilterRule_AddedWithinDays)(nil),
+		(*SyncFilterRule_ExcludeExplicit)(nil),
+	}
+	file_myncer_sync_proto_msgTypes[16].OneofWrappers = []any{
+		(*SyncRunEvent_Phase)(nil),
+		(*SyncRunEvent_SongMatchResult)(nil),
+	}
+	file_myncer_sync_proto_msgTypes[21].OneofWrappers = []any{
 		(*CreateSyncRequest_OneWaySync)(nil),
 		(*CreateSyncRequest_PlaylistMergeSync)(nil),
+		(*CreateSyncRequest_FanOutSync)(nil),
+	}
+	file_myncer_sync_proto_msgTypes[24].OneofWrappers = []any{
+		(*UpdateSyncRequest_OneWaySync)(nil),
+		(*UpdateSyncRequest_PlaylistMergeSync)(nil),
+		(*UpdateSyncRequest_FanOutSync)(nil),
+	}
+	file_myncer_sync_proto_msgTypes[43].OneofWrappers = []any{
+		(*WatchSyncRunResponse_SyncRun)(nil),
+		(*WatchSyncRunResponse_Event)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_myncer_sync_proto_rawDesc), len(file_myncer_sync_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   16,
+			NumEnums:      8,
+			NumMessages:   74,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,88 @@
+package rpc_handlers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+func NewCancelSyncRunHandler() core.GrpcHandler[
+	*myncer_pb.CancelSyncRunRequest,
+	*myncer_pb.CancelSyncRunResponse,
+] {
+	return &cancelSyncRunImpl{}
+}
+
+type cancelSyncRunImpl struct{}
+
+func (c *cancelSyncRunImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.CancelSyncRunRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to cancel a sync run")
+	}
+	if _, err := uuid.Parse(reqBody.GetRunId()); err != nil {
+		return core.NewError("invalid run id: %v", err)
+	}
+	syncRun, err := core.ToMyncerCtx(ctx).DB.SyncRunStore.GetSyncRun(ctx, reqBody.GetRunId())
+	if err != nil {
+		return core.WrappedError(err, "failed to get sync run")
+	}
+	sync, err := core.ToMyncerCtx(ctx).DB.SyncStore.GetSync(ctx, syncRun.GetSyncId())
+	if err != nil {
+		return core.WrappedError(err, "failed to get sync for sync run")
+	}
+	if sync.GetUserId() != userInfo.GetId() {
+		return core.NewError("user does not have permission to cancel this sync run")
+	}
+	return nil
+}
+
+func (c *cancelSyncRunImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.CancelSyncRunRequest, /*const*/
+) *core.GrpcHandlerResponse[*myncer_pb.CancelSyncRunResponse] {
+	dbStores := core.ToMyncerCtx(ctx).DB
+	jobStatus, err := dbStores.SyncJobStore.RequestSyncJobCancellation(ctx, reqBody.GetRunId())
+	if err != nil {
+		return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.CancelSyncRunResponse](
+			core.WrappedError(err, "failed to cancel sync run"),
+		)
+	}
+
+	// Running jobs are stopped by their worker, which records how far the run got.
+	if jobStatus != core.SyncJobStatus_Cancelled {
+		return core.NewGrpcHandlerResponse_OK(
+			&myncer_pb.CancelSyncRunResponse{
+				RunId:  reqBody.GetRunId(),
+				Status: myncer_pb.SyncStatus_SYNC_STATUS_RUNNING,
+			},
+		)
+	}
+
+	// The run never started so nothing else will record the cancellation.
+	syncRun, err := dbStores.SyncRunStore.GetSyncRun(ctx, reqBody.GetRunId())
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.CancelSyncRunResponse](
+			core.WrappedError(err, "failed to get sync run"),
+		)
+	}
+	syncRun.SyncStatus = myncer_pb.SyncStatus_SYNC_STATUS_CANCELLED
+	syncRun.ErrorMessage = "sync run was cancelled before it started"
+	if err := dbStores.SyncRunStore.UpdateSyncRun(ctx, syncRun); err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.CancelSyncRunResponse](
+			core.WrappedError(err, "failed to update sync run"),
+		)
+	}
+	return core.NewGrpcHandlerResponse_OK(
+		&myncer_pb.CancelSyncRunResponse{
+			RunId:  syncRun.GetRunId(),
+			Status: syncRun.GetSyncStatus(),
+		},
+	)
+}
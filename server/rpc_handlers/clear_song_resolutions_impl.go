@@ -0,0 +1,45 @@
+package rpc_handlers
+
+import (
+	"context"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+func NewClearSongResolutionsHandler() core.GrpcHandler[
+	*myncer_pb.ClearSongResolutionsRequest,
+	*myncer_pb.ClearSongResolutionsResponse,
+] {
+	return &clearSongResolutionsImpl{}
+}
+
+type clearSongResolutionsImpl struct{}
+
+func (csr *clearSongResolutionsImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.ClearSongResolutionsRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to clear song resolutions")
+	}
+	return nil
+}
+
+func (csr *clearSongResolutionsImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.ClearSongResolutionsRequest, /*const*/
+) *core.GrpcHandlerResponse[*myncer_pb.ClearSongResolutionsResponse] {
+	if err := core.ToMyncerCtx(ctx).DB.SongResolutionStore.DeleteSongResolutions(
+		ctx,
+		userInfo.GetId(),
+		reqBody.GetDestinationDatasource(),
+	); err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.ClearSongResolutionsResponse](
+			core.WrappedError(err, "failed to clear song resolutions"),
+		)
+	}
+	return core.NewGrpcHandlerResponse_OK(&myncer_pb.ClearSongResolutionsResponse{})
+}
@@ -5,10 +5,14 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hansbala/myncer/core"
+	"github.com/hansbala/myncer/filtering"
+	"github.com/hansbala/myncer/matching"
 	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/proto"
 )
 
 func NewCreateSyncHandler() core.GrpcHandler[
@@ -36,7 +40,13 @@ func (cs *createSyncImpl) ProcessRequest(
 	userInfo *myncer_pb.User, /*const,@nullable*/
 	reqBody *myncer_pb.CreateSyncRequest, /*const*/
 ) *core.GrpcHandlerResponse[*myncer_pb.CreateSyncResponse] {
-	if err := cs.validateRequest(ctx, reqBody, userInfo); err != nil {
+	existingSyncs, err := core.ToMyncerCtx(ctx).DB.SyncStore.GetSyncs(ctx, userInfo)
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.CreateSyncResponse](
+			core.WrappedError(err, "failed to get existing syncs"),
+		)
+	}
+	if err := cs.validateRequest(ctx, reqBody, userInfo, existingSyncs); err != nil {
 		return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.CreateSyncResponse](
 			core.WrappedError(err, "failed to validate create sync request"),
 		)
@@ -49,9 +59,41 @@ func (cs *createSyncImpl) ProcessRequest(
 			core.WrappedError(err, "failed to create sync from request"),
 		)
 	}
+	sync.Schedule = core.NewSyncSchedule(reqBody.GetScheduleInterval(), time.Now())
+	sync.RetryPolicy = reqBody.GetRetryPolicy()
+	sync.FilterRules = reqBody.GetFilterRules()
+	sync.MatchingProfile = reqBody.GetMatchingProfile()
+
+	// Checked against the syncs as a whole, which the checks on the request alone can't catch.
+	if err := core.ValidateSyncGraph(existingSyncs.ToArray(), sync); err != nil {
+		return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.CreateSyncResponse](
+			core.WrappedError(err, "sync conflicts with existing syncs"),
+		)
+	}
+
+	var newDestination *myncer_pb.MusicSource /*@nullable*/
+	if reqBody.GetNewDestinationPlaylist() != nil {
+		// The sync shares messages with the request, which must not be modified.
+		sync = proto.Clone(sync).(*myncer_pb.Sync)
+		newDestination, err = cs.createDestinationPlaylist(ctx, userInfo, sync, reqBody.GetNewDestinationPlaylist())
+		if err != nil {
+			return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.CreateSyncResponse](
+				core.WrappedError(err, "failed to create destination playlist"),
+			)
+		}
+	}
 
 	// Persist the sync to the database.
 	if err := core.ToMyncerCtx(ctx).DB.SyncStore.CreateSync(ctx, sync); err != nil {
+		if newDestination != nil {
+			// Datasource clients can't delete playlists, so the user is told which one was left behind.
+			err = core.WrappedError(
+				err,
+				"created destination playlist %s on %v is not used by any sync",
+				newDestination.GetPlaylistId(),
+				newDestination.GetDatasource(),
+			)
+		}
 		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.CreateSyncResponse](
 			core.WrappedError(err, "failed to create sync in database"),
 		)
@@ -64,23 +106,66 @@ func (cs *createSyncImpl) validateRequest(
 	ctx context.Context,
 	req *myncer_pb.CreateSyncRequest, /*const*/
 	userInfo *myncer_pb.User, /*const*/
+	existingSyncs core.Set[*myncer_pb.Sync],
 ) error {
-	existingSyncs, err := core.ToMyncerCtx(ctx).DB.SyncStore.GetSyncs(ctx, userInfo)
-	if err != nil {
-		return core.WrappedError(err, "failed to check for existing syncs")
+	if err := validateSyncSettings(
+		req.GetScheduleInterval(),
+		req.GetRetryPolicy(),
+		req.GetFilterRules(),
+		req.GetMatchingProfile(),
+	); err != nil {
+		return err
+	}
+
+	createsDestination := req.GetNewDestinationPlaylist() != nil
+	if createsDestination && strings.TrimSpace(req.GetNewDestinationPlaylist().GetName()) == "" {
+		return core.NewError("new destination playlist name must be specified")
 	}
 
 	syncVariant := req.GetSyncVariant()
 	switch syncVariant.(type) {
 	case *myncer_pb.CreateSyncRequest_OneWaySync:
-		return validateOneWaySync(ctx, userInfo, req.GetOneWaySync(), existingSyncs)
+		return validateOneWaySync(ctx, userInfo, req.GetOneWaySync(), existingSyncs, createsDestination)
 	case *myncer_pb.CreateSyncRequest_PlaylistMergeSync:
-		return validatePlaylistMergeSync(ctx, userInfo, req.GetPlaylistMergeSync(), existingSyncs)
+		return validatePlaylistMergeSync(
+			ctx,
+			userInfo,
+			req.GetPlaylistMergeSync(),
+			existingSyncs,
+			createsDestination,
+		)
+	case *myncer_pb.CreateSyncRequest_FanOutSync:
+		if createsDestination {
+			return core.NewError("creating a new destination playlist is not supported for fan-out syncs")
+		}
+		return validateFanOutSync(ctx, userInfo, req.GetFanOutSync(), existingSyncs)
 	default:
 		return core.NewError("unknown sync type in validate request: %T", syncVariant)
 	}
 }
 
+// Validates the settings that apply to every sync variant.
+func validateSyncSettings(
+	scheduleInterval myncer_pb.SyncScheduleInterval,
+	retryPolicy *myncer_pb.RetryPolicy, /*const,@nullable*/
+	filterRules []*myncer_pb.SyncFilterRule, /*const*/
+	matchingProfile *myncer_pb.MatchingProfile, /*const,@nullable*/
+) error {
+	if _, ok := myncer_pb.SyncScheduleInterval_name[int32(scheduleInterval)]; !ok {
+		return core.NewError("unknown schedule interval: %v", scheduleInterval)
+	}
+	if err := core.ValidateRetryPolicy(retryPolicy); err != nil {
+		return core.WrappedError(err, "invalid retry policy")
+	}
+	if _, err := filtering.NewRuleEvaluator(filterRules, time.Now()); err != nil {
+		return core.WrappedError(err, "invalid filter rules")
+	}
+	if err := matching.ValidateMatchingProfile(matchingProfile); err != nil {
+		return core.WrappedError(err, "invalid matching profile")
+	}
+	return nil
+}
+
 func (cs *createSyncImpl) createSyncFromRequest(
 	req *myncer_pb.CreateSyncRequest, /*const*/
 	userInfo *myncer_pb.User, /*const*/
@@ -91,16 +176,54 @@ func (cs *createSyncImpl) createSyncFromRequest(
 		return NewSync_OneWaySync(userInfo.GetId(), req.GetOneWaySync()), nil
 	case *myncer_pb.CreateSyncRequest_PlaylistMergeSync:
 		return NewSync_PlaylistMergeSync(userInfo.GetId(), req.GetPlaylistMergeSync()), nil
+	case *myncer_pb.CreateSyncRequest_FanOutSync:
+		return NewSync_FanOutSync(userInfo.GetId(), req.GetFanOutSync()), nil
 	default:
 		return nil, core.NewError("unknown sync type in create sync from request: %T", syncVariant)
 	}
 }
 
+// Creates the new playlist on the datasource of the sync's destination and points the sync at it.
+// Returns the destination of the sync.
+func (cs *createSyncImpl) createDestinationPlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	sync *myncer_pb.Sync,
+	newPlaylist *myncer_pb.NewPlaylist, /*const*/
+) (*myncer_pb.MusicSource, error) {
+	var destination *myncer_pb.MusicSource
+	switch v := sync.GetSyncVariant().(type) {
+	case *myncer_pb.Sync_OneWaySync:
+		destination = v.OneWaySync.GetDestination()
+	case *myncer_pb.Sync_PlaylistMergeSync:
+		destination = v.PlaylistMergeSync.GetDestination()
+	default:
+		return nil, core.NewError("unknown sync type in create destination playlist: %T", v)
+	}
+	client, err := core.ToMyncerCtx(ctx).DatasourceClients.GetClient(destination.GetDatasource())
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to get destination datasource client")
+	}
+	playlist, err := client.CreatePlaylist(
+		ctx,
+		userInfo,
+		newPlaylist.GetName(),
+		newPlaylist.GetDescription(),
+		newPlaylist.GetPublic(),
+	)
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to create playlist %s", newPlaylist.GetName())
+	}
+	destination.PlaylistId = playlist.GetMusicSource().GetPlaylistId()
+	return destination, nil
+}
+
 func validateOneWaySync(
 	ctx context.Context,
 	userInfo *myncer_pb.User, /*const*/
 	req *myncer_pb.OneWaySync, /*const*/
 	existingSyncs core.Set[*myncer_pb.Sync],
+	createsDestination bool,
 ) error {
 	// Validate the source and destination datasources are valid.
 	if req.GetSource().GetDatasource() == myncer_pb.Datasource_DATASOURCE_UNSPECIFIED {
@@ -123,20 +246,33 @@ func validateOneWaySync(
 	if !connectedDatasources.Contains(req.GetDestination().GetDatasource()) {
 		return core.NewError("destination datasource is not connected")
 	}
+	if _, ok := myncer_pb.OneWaySyncMode_name[int32(req.GetMode())]; !ok {
+		return core.NewError("unknown one-way sync mode: %v", req.GetMode())
+	}
+	if req.GetMode() == myncer_pb.OneWaySyncMode_ONE_WAY_SYNC_MODE_DIFF && req.GetOverwriteExisting() {
+		return core.NewError("overwrite existing can not be used with diff mode")
+	}
+	if req.GetMode() != myncer_pb.OneWaySyncMode_ONE_WAY_SYNC_MODE_DIFF && req.GetRemoveExtraSongs() {
+		return core.NewError("removing extra songs is only supported in diff mode")
+	}
+	if _, ok := myncer_pb.PlaylistOrder_name[int32(req.GetOrder())]; !ok {
+		return core.NewError("unknown playlist order: %v", req.GetOrder())
+	}
 	// Basic playlist id checks.
-	if len(req.GetSource().GetPlaylistId()) == 0 {
-		return core.NewError("source playlist id must be specified")
+	if err := validateMusicSource(req.GetSource(), "source"); err != nil {
+		return err
+	}
+	if err := validateDestinationPlaylistId(req.GetDestination(), createsDestination); err != nil {
+		return err
 	}
-	if len(req.GetDestination().GetPlaylistId()) == 0 {
-		return core.NewError("destination playlist id must be specified")
+	if err := validateWritableMusicSource(req.GetDestination(), "destination", req.GetOrder()); err != nil {
+		return err
 	}
 
 	for _, existingSync := range existingSyncs.ToArray() {
 		if ows := existingSync.GetOneWaySync(); ows != nil {
-			if ows.GetSource().GetPlaylistId() == req.GetSource().GetPlaylistId() &&
-				ows.GetSource().GetDatasource() == req.GetSource().GetDatasource() &&
-				ows.GetDestination().GetPlaylistId() == req.GetDestination().GetPlaylistId() &&
-				ows.GetDestination().GetDatasource() == req.GetDestination().GetDatasource() {
+			if core.GetPlaylistLockKey(ows.GetSource()) == core.GetPlaylistLockKey(req.GetSource()) &&
+				core.GetPlaylistLockKey(ows.GetDestination()) == core.GetPlaylistLockKey(req.GetDestination()) {
 				return core.NewError("A sync with these exact source and destination playlists already exists.")
 			}
 		}
@@ -160,7 +296,7 @@ func NewSync_OneWaySync(
 func canonicalSourceKey(sources []*myncer_pb.MusicSource) string {
 	keys := make([]string, len(sources))
 	for i, s := range sources {
-		keys[i] = fmt.Sprintf("%d:%s", s.GetDatasource(), s.GetPlaylistId())
+		keys[i] = core.GetPlaylistLockKey(s)
 	}
 	sort.Strings(keys)
 	return strings.Join(keys, "|")
@@ -171,20 +307,49 @@ func validatePlaylistMergeSync(
 	userInfo *myncer_pb.User, /*const*/
 	req *myncer_pb.PlaylistMergeSync, /*const*/
 	existingSyncs core.Set[*myncer_pb.Sync],
+	createsDestination bool,
 ) error {
 	// Validate that there are at least two sources
 	if len(req.GetSources()) < 2 {
 		return core.NewError("at least two source playlists are required for a merge sync")
 	}
-	
-	// Validate the destination
-	if req.GetDestination().GetDatasource() == myncer_pb.Datasource_DATASOURCE_UNSPECIFIED {
-		return core.NewError("destination datasource must be specified")
+
+	if _, ok := myncer_pb.PlaylistMergeSyncMode_name[int32(req.GetMode())]; !ok {
+		return core.NewError("unknown merge sync mode: %v", req.GetMode())
+	}
+	if _, ok := myncer_pb.MergeConflictPolicy_name[int32(req.GetConflictPolicy())]; !ok {
+		return core.NewError("unknown merge conflict policy: %v", req.GetConflictPolicy())
+	}
+	if _, ok := myncer_pb.PlaylistOrder_name[int32(req.GetOrder())]; !ok {
+		return core.NewError("unknown playlist order: %v", req.GetOrder())
 	}
-	if len(req.GetDestination().GetPlaylistId()) == 0 {
-		return core.NewError("destination playlist id must be specified")
+	if req.GetMode() != myncer_pb.PlaylistMergeSyncMode_PLAYLIST_MERGE_SYNC_MODE_THREE_WAY &&
+		req.GetConflictPolicy() != myncer_pb.MergeConflictPolicy_MERGE_CONFLICT_POLICY_UNSPECIFIED {
+		return core.NewError("conflict policy is only supported in three-way mode")
 	}
-	
+	writesToSources := req.GetMode() != myncer_pb.PlaylistMergeSyncMode_PLAYLIST_MERGE_SYNC_MODE_UNSPECIFIED
+	if writesToSources && req.GetOverwriteExisting() {
+		// Clearing every source would lose the songs that are meant to be merged.
+		return core.NewError("overwrite existing can only be used when merging into a destination")
+	}
+	hasDestination := req.GetDestination() != nil
+	if (!writesToSources || createsDestination) && !hasDestination {
+		return core.NewError("destination must be specified")
+	}
+
+	// Validate the destination
+	if hasDestination {
+		if req.GetDestination().GetDatasource() == myncer_pb.Datasource_DATASOURCE_UNSPECIFIED {
+			return core.NewError("destination datasource must be specified")
+		}
+		if err := validateDestinationPlaylistId(req.GetDestination(), createsDestination); err != nil {
+			return err
+		}
+		if err := validateWritableMusicSource(req.GetDestination(), "destination", req.GetOrder()); err != nil {
+			return err
+		}
+	}
+
 	// Get user's connected datasources
 	connectedDatasources, err := core.ToMyncerCtx(ctx).DB.DatasourceTokenStore.GetConnectedDatasources(
 		ctx,
@@ -193,19 +358,24 @@ func validatePlaylistMergeSync(
 	if err != nil {
 		return core.WrappedError(err, "failed to get connected datasources for user")
 	}
-	
+
 	// Validate that the destination is connected
-	if !connectedDatasources.Contains(req.GetDestination().GetDatasource()) {
+	if hasDestination && !connectedDatasources.Contains(req.GetDestination().GetDatasource()) {
 		return core.NewError("destination datasource is not connected")
 	}
-	
+
 	// Validate each source
 	for i, source := range req.GetSources() {
 		if source.GetDatasource() == myncer_pb.Datasource_DATASOURCE_UNSPECIFIED {
 			return core.NewError("source datasource %d must be specified", i+1)
 		}
-		if len(source.GetPlaylistId()) == 0 {
-			return core.NewError("source playlist id %d must be specified", i+1)
+		if err := validateMusicSource(source, fmt.Sprintf("source %d", i+1)); err != nil {
+			return err
+		}
+		if writesToSources {
+			if err := validateWritableMusicSource(source, fmt.Sprintf("source %d", i+1), req.GetOrder()); err != nil {
+				return err
+			}
 		}
 		if !connectedDatasources.Contains(source.GetDatasource()) {
 			return core.NewError("source datasource %d is not connected", i+1)
@@ -220,13 +390,62 @@ func validatePlaylistMergeSync(
 			existingKey := canonicalSourceKey(pms.GetSources())
 			existingDest := pms.GetDestination()
 			if existingKey == newKey &&
-				existingDest.GetPlaylistId() == newDest.GetPlaylistId() &&
-				existingDest.GetDatasource() == newDest.GetDatasource() {
+				core.GetPlaylistLockKey(existingDest) == core.GetPlaylistLockKey(newDest) {
 				return core.NewError("A merge sync with these exact playlists already exists.")
 			}
 		}
 	}
-	
+
+	return nil
+}
+
+// The playlist id is filled in once a new destination playlist is created.
+func validateDestinationPlaylistId(
+	destination *myncer_pb.MusicSource, /*const*/
+	createsDestination bool,
+) error {
+	if !createsDestination {
+		return validateMusicSource(destination, "destination")
+	}
+	if core.IsLibraryCollection(destination) {
+		return core.NewError("a new destination playlist can not be created for a library collection")
+	}
+	if len(destination.GetPlaylistId()) > 0 {
+		return core.NewError("destination playlist id must be empty when creating a new destination playlist")
+	}
+	return nil
+}
+
+// Checks the music source is either a playlist with an id or a library collection its datasource
+// has. `name` refers to the music source in errors.
+func validateMusicSource(musicSource *myncer_pb.MusicSource /*const*/, name string) error {
+	if _, ok := myncer_pb.MusicSourceKind_name[int32(musicSource.GetKind())]; !ok {
+		return core.NewError("unknown %s kind: %v", name, musicSource.GetKind())
+	}
+	if !core.IsMusicSourceSupported(musicSource) {
+		return core.NewError("%s %v is not available on %v", name, musicSource.GetKind(), musicSource.GetDatasource())
+	}
+	if core.IsLibraryCollection(musicSource) && len(musicSource.GetPlaylistId()) > 0 {
+		return core.NewError("%s playlist id must be empty for library collections", name)
+	}
+	if !core.IsLibraryCollection(musicSource) && len(musicSource.GetPlaylistId()) == 0 {
+		return core.NewError("%s playlist id must be specified", name)
+	}
+	return nil
+}
+
+// Checks songs can be written to the music source and kept in the order.
+func validateWritableMusicSource(
+	musicSource *myncer_pb.MusicSource, /*const*/
+	name string,
+	order myncer_pb.PlaylistOrder,
+) error {
+	if !core.IsMusicSourceWritable(musicSource) {
+		return core.NewError("%s %v can only be read", name, musicSource.GetKind())
+	}
+	if order != myncer_pb.PlaylistOrder_PLAYLIST_ORDER_UNSPECIFIED && !core.IsMusicSourceReorderable(musicSource) {
+		return core.NewError("%s %v can not be reordered", name, musicSource.GetKind())
+	}
 	return nil
 }
 
@@ -242,3 +461,91 @@ func NewSync_PlaylistMergeSync(
 		},
 	}
 }
+
+func validateFanOutSync(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	req *myncer_pb.FanOutSync, /*const*/
+	existingSyncs core.Set[*myncer_pb.Sync],
+) error {
+	if req.GetSource().GetDatasource() == myncer_pb.Datasource_DATASOURCE_UNSPECIFIED {
+		return core.NewError("source datasource must be specified")
+	}
+	if err := validateMusicSource(req.GetSource(), "source"); err != nil {
+		return err
+	}
+	if len(req.GetDestinations()) == 0 {
+		return core.NewError("at least one destination playlist is required for a fan-out sync")
+	}
+	if _, ok := myncer_pb.OneWaySyncMode_name[int32(req.GetMode())]; !ok {
+		return core.NewError("unknown one-way sync mode: %v", req.GetMode())
+	}
+	if req.GetMode() == myncer_pb.OneWaySyncMode_ONE_WAY_SYNC_MODE_DIFF && req.GetOverwriteExisting() {
+		return core.NewError("overwrite existing can not be used with diff mode")
+	}
+	if req.GetMode() != myncer_pb.OneWaySyncMode_ONE_WAY_SYNC_MODE_DIFF && req.GetRemoveExtraSongs() {
+		return core.NewError("removing extra songs is only supported in diff mode")
+	}
+	if _, ok := myncer_pb.PlaylistOrder_name[int32(req.GetOrder())]; !ok {
+		return core.NewError("unknown playlist order: %v", req.GetOrder())
+	}
+
+	connectedDatasources, err := core.ToMyncerCtx(ctx).DB.DatasourceTokenStore.GetConnectedDatasources(
+		ctx,
+		userInfo.GetId(),
+	)
+	if err != nil {
+		return core.WrappedError(err, "failed to get connected datasources for user")
+	}
+	if !connectedDatasources.Contains(req.GetSource().GetDatasource()) {
+		return core.NewError("source datasource is not connected")
+	}
+
+	sourceKey := core.GetPlaylistLockKey(req.GetSource())
+	destinationKeys := core.NewSet[string]()
+	for i, destination := range req.GetDestinations() {
+		if destination.GetDatasource() == myncer_pb.Datasource_DATASOURCE_UNSPECIFIED {
+			return core.NewError("destination datasource %d must be specified", i+1)
+		}
+		if err := validateMusicSource(destination, fmt.Sprintf("destination %d", i+1)); err != nil {
+			return err
+		}
+		if err := validateWritableMusicSource(destination, fmt.Sprintf("destination %d", i+1), req.GetOrder()); err != nil {
+			return err
+		}
+		if !connectedDatasources.Contains(destination.GetDatasource()) {
+			return core.NewError("destination datasource %d is not connected", i+1)
+		}
+		key := core.GetPlaylistLockKey(destination)
+		if key == sourceKey {
+			return core.NewError("destination %d is the source playlist", i+1)
+		}
+		if destinationKeys.Contains(key) {
+			return core.NewError("destination %d is listed more than once", i+1)
+		}
+		destinationKeys.Add(key)
+	}
+
+	for _, existingSync := range existingSyncs.ToArray() {
+		if fos := existingSync.GetFanOutSync(); fos != nil {
+			if core.GetPlaylistLockKey(fos.GetSource()) == sourceKey &&
+				canonicalSourceKey(fos.GetDestinations()) == canonicalSourceKey(req.GetDestinations()) {
+				return core.NewError("A fan-out sync with these exact playlists already exists.")
+			}
+		}
+	}
+	return nil
+}
+
+func NewSync_FanOutSync(
+	userId string, /*const*/
+	fanOutSync *myncer_pb.FanOutSync, /*const*/
+) *myncer_pb.Sync {
+	return &myncer_pb.Sync{
+		Id:     uuid.NewString(),
+		UserId: userId,
+		SyncVariant: &myncer_pb.Sync_FanOutSync{
+			FanOutSync: fanOutSync,
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package rpc_handlers
+
+import (
+	"context"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+func NewDeleteSongOverrideHandler() core.GrpcHandler[
+	*myncer_pb.DeleteSongOverrideRequest,
+	*myncer_pb.DeleteSongOverrideResponse,
+] {
+	return &deleteSongOverrideImpl{}
+}
+
+type deleteSongOverrideImpl struct{}
+
+func (dso *deleteSongOverrideImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.DeleteSongOverrideRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to delete a song override")
+	}
+	return nil
+}
+
+func (dso *deleteSongOverrideImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.DeleteSongOverrideRequest, /*const*/
+) *core.GrpcHandlerResponse[*myncer_pb.DeleteSongOverrideResponse] {
+	// Overrides are keyed by user so only the user's own override can be deleted.
+	if err := core.ToMyncerCtx(ctx).DB.SongOverrideStore.DeleteSongOverride(
+		ctx,
+		userInfo.GetId(),
+		reqBody.GetSourceSong(),
+		reqBody.GetDestinationDatasource(),
+	); err != nil {
+		return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.DeleteSongOverrideResponse](
+			core.WrappedError(err, "failed to delete song override"),
+		)
+	}
+	return core.NewGrpcHandlerResponse_OK(&myncer_pb.DeleteSongOverrideResponse{})
+}
@@ -0,0 +1,84 @@
+package rpc_handlers
+
+import (
+	"context"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+func NewDiffPlaylistSnapshotsHandler() core.GrpcHandler[
+	*myncer_pb.DiffPlaylistSnapshotsRequest,
+	*myncer_pb.DiffPlaylistSnapshotsResponse,
+] {
+	return &diffPlaylistSnapshotsImpl{}
+}
+
+type diffPlaylistSnapshotsImpl struct{}
+
+func (d *diffPlaylistSnapshotsImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.DiffPlaylistSnapshotsRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to diff playlist snapshots")
+	}
+	if _, err := getUserPlaylistSnapshot(ctx, userInfo, reqBody.GetSnapshotId()); err != nil {
+		return err
+	}
+	if reqBody.GetOtherSnapshotId() != "" {
+		if _, err := getUserPlaylistSnapshot(ctx, userInfo, reqBody.GetOtherSnapshotId()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *diffPlaylistSnapshotsImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.DiffPlaylistSnapshotsRequest, /*const*/
+) *core.GrpcHandlerResponse[*myncer_pb.DiffPlaylistSnapshotsResponse] {
+	snapshot, err := getUserPlaylistSnapshot(ctx, userInfo, reqBody.GetSnapshotId())
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.DiffPlaylistSnapshotsResponse](err)
+	}
+	otherSongs, err := d.getOtherSongs(ctx, userInfo, snapshot, reqBody.GetOtherSnapshotId())
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.DiffPlaylistSnapshotsResponse](err)
+	}
+
+	addedSongs, removedSongs := core.DiffSongSpecs(snapshot.GetSongs(), otherSongs)
+	return core.NewGrpcHandlerResponse_OK(
+		&myncer_pb.DiffPlaylistSnapshotsResponse{
+			AddedSongs:   addedSongs,
+			RemovedSongs: removedSongs,
+		},
+	)
+}
+
+// Returns the songs of the other snapshot, or of the snapshot's playlist as it is now.
+func (d *diffPlaylistSnapshotsImpl) getOtherSongs(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	snapshot *myncer_pb.PlaylistSnapshot, /*const*/
+	otherSnapshotId string,
+) ([]*myncer_pb.Song, error) {
+	if otherSnapshotId != "" {
+		otherSnapshot, err := getUserPlaylistSnapshot(ctx, userInfo, otherSnapshotId)
+		if err != nil {
+			return nil, err
+		}
+		return otherSnapshot.GetSongs(), nil
+	}
+	client, err := core.ToMyncerCtx(ctx).DatasourceClients.GetClient(snapshot.GetPlaylist().GetDatasource())
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to get datasource client")
+	}
+	songs, err := client.GetPlaylistSongs(ctx, userInfo, snapshot.GetPlaylist())
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to get songs of playlist %s", snapshot.GetPlaylist().GetPlaylistId())
+	}
+	return core.NewSongList(songs).GetSpecs(), nil
+}
@@ -0,0 +1,53 @@
+package rpc_handlers
+
+import (
+	"context"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+func NewGetLibraryTransferHandler() core.GrpcHandler[
+	*myncer_pb.GetLibraryTransferRequest,
+	*myncer_pb.GetLibraryTransferResponse,
+] {
+	return &getLibraryTransferImpl{}
+}
+
+type getLibraryTransferImpl struct{}
+
+func (glt *getLibraryTransferImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.GetLibraryTransferRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to get library transfer")
+	}
+	return nil
+}
+
+func (glt *getLibraryTransferImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.GetLibraryTransferRequest, /*const*/
+) *core.GrpcHandlerResponse[*myncer_pb.GetLibraryTransferResponse] {
+	transfer, err := core.ToMyncerCtx(ctx).DB.LibraryTransferStore.GetLibraryTransfer(ctx, reqBody.GetTransferId())
+	if err != nil {
+		return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.GetLibraryTransferResponse](
+			core.WrappedError(err, "failed to get library transfer %s", reqBody.GetTransferId()),
+		)
+	}
+	if transfer.GetUserId() != userInfo.GetId() {
+		return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.GetLibraryTransferResponse](
+			core.NewError("user does not have permission to access library transfer %s", reqBody.GetTransferId()),
+		)
+	}
+	if err := fillLibraryTransferProgress(ctx, transfer); err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.GetLibraryTransferResponse](
+			core.WrappedError(err, "failed to get progress of library transfer"),
+		)
+	}
+
+	return core.NewGrpcHandlerResponse_OK(&myncer_pb.GetLibraryTransferResponse{Transfer: transfer})
+}
@@ -0,0 +1,45 @@
+package rpc_handlers
+
+import (
+	"context"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+func NewGetSyncGraphHandler() core.GrpcHandler[
+	*myncer_pb.GetSyncGraphRequest,
+	*myncer_pb.GetSyncGraphResponse,
+] {
+	return &getSyncGraphImpl{}
+}
+
+type getSyncGraphImpl struct{}
+
+func (gsg *getSyncGraphImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.GetSyncGraphRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to get the sync graph")
+	}
+	return nil
+}
+
+func (gsg *getSyncGraphImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.GetSyncGraphRequest, /*const*/
+) *core.GrpcHandlerResponse[*myncer_pb.GetSyncGraphResponse] {
+	syncs, err := core.ToMyncerCtx(ctx).DB.SyncStore.GetSyncs(ctx, userInfo)
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.GetSyncGraphResponse](
+			core.WrappedError(err, "failed to get syncs for current user"),
+		)
+	}
+
+	return core.NewGrpcHandlerResponse_OK(
+		&myncer_pb.GetSyncGraphResponse{Graph: core.BuildSyncGraph(syncs.ToArray())},
+	)
+}
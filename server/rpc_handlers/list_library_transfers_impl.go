@@ -0,0 +1,50 @@
+package rpc_handlers
+
+import (
+	"context"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+func NewListLibraryTransfersHandler() core.GrpcHandler[
+	*myncer_pb.ListLibraryTransfersRequest,
+	*myncer_pb.ListLibraryTransfersResponse,
+] {
+	return &listLibraryTransfersImpl{}
+}
+
+type listLibraryTransfersImpl struct{}
+
+func (llt *listLibraryTransfersImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.ListLibraryTransfersRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to list library transfers")
+	}
+	return nil
+}
+
+func (llt *listLibraryTransfersImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.ListLibraryTransfersRequest, /*const*/
+) *core.GrpcHandlerResponse[*myncer_pb.ListLibraryTransfersResponse] {
+	transfers, err := core.ToMyncerCtx(ctx).DB.LibraryTransferStore.GetLibraryTransfers(ctx, userInfo.GetId())
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.ListLibraryTransfersResponse](
+			core.WrappedError(err, "failed to get library transfers for current user"),
+		)
+	}
+	for _, transfer := range transfers {
+		if err := fillLibraryTransferProgress(ctx, transfer); err != nil {
+			return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.ListLibraryTransfersResponse](
+				core.WrappedError(err, "failed to get progress of library transfer %s", transfer.GetId()),
+			)
+		}
+	}
+
+	return core.NewGrpcHandlerResponse_OK(&myncer_pb.ListLibraryTransfersResponse{Transfers: transfers})
+}
@@ -0,0 +1,58 @@
+package rpc_handlers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+func NewListPlaylistSnapshotsHandler() core.GrpcHandler[
+	*myncer_pb.ListPlaylistSnapshotsRequest,
+	*myncer_pb.ListPlaylistSnapshotsResponse,
+] {
+	return &listPlaylistSnapshotsImpl{}
+}
+
+type listPlaylistSnapshotsImpl struct{}
+
+func (l *listPlaylistSnapshotsImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.ListPlaylistSnapshotsRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to list playlist snapshots")
+	}
+	if reqBody.GetRunId() != "" {
+		if _, err := uuid.Parse(reqBody.GetRunId()); err != nil {
+			return core.NewError("invalid run id: %v", err)
+		}
+	}
+	return nil
+}
+
+func (l *listPlaylistSnapshotsImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.ListPlaylistSnapshotsRequest, /*const*/
+) *core.GrpcHandlerResponse[*myncer_pb.ListPlaylistSnapshotsResponse] {
+	// Snapshots are only listed for the current user.
+	snapshots, err := core.ToMyncerCtx(ctx).DB.PlaylistSnapshotStore.GetPlaylistSnapshots(
+		ctx,
+		userInfo.GetId(),
+		reqBody.GetPlaylist(),
+		reqBody.GetRunId(),
+	)
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.ListPlaylistSnapshotsResponse](
+			core.WrappedError(err, "failed to get playlist snapshots"),
+		)
+	}
+	return core.NewGrpcHandlerResponse_OK(
+		&myncer_pb.ListPlaylistSnapshotsResponse{
+			Snapshots: snapshots,
+		},
+	)
+}
@@ -0,0 +1,42 @@
+package rpc_handlers
+
+import (
+	"context"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+func NewListSongOverridesHandler() core.GrpcHandler[
+	*myncer_pb.ListSongOverridesRequest,
+	*myncer_pb.ListSongOverridesResponse,
+] {
+	return &listSongOverridesImpl{}
+}
+
+type listSongOverridesImpl struct{}
+
+func (lso *listSongOverridesImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.ListSongOverridesRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to list song overrides")
+	}
+	return nil
+}
+
+func (lso *listSongOverridesImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.ListSongOverridesRequest, /*const*/
+) *core.GrpcHandlerResponse[*myncer_pb.ListSongOverridesResponse] {
+	songOverrides, err := core.ToMyncerCtx(ctx).DB.SongOverrideStore.GetSongOverrides(ctx, userInfo.GetId())
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.ListSongOverridesResponse](
+			core.WrappedError(err, "failed to get song overrides for current user"),
+		)
+	}
+	return core.NewGrpcHandlerResponse_OK(&myncer_pb.ListSongOverridesResponse{SongOverrides: songOverrides})
+}
@@ -0,0 +1,72 @@
+package rpc_handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+func NewPauseSyncHandler() core.GrpcHandler[
+	*myncer_pb.PauseSyncRequest,
+	*myncer_pb.PauseSyncResponse,
+] {
+	return &pauseSyncImpl{}
+}
+
+type pauseSyncImpl struct{}
+
+func (ps *pauseSyncImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.PauseSyncRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to pause sync")
+	}
+	// Makes sure the sync belongs to the user.
+	sync, err := core.ToMyncerCtx(ctx).DB.SyncStore.GetSync(ctx, reqBody.GetSyncId())
+	if err != nil {
+		return core.WrappedError(err, "could not find sync with id: %s", reqBody.GetSyncId())
+	}
+	if userInfo.GetId() != sync.GetUserId() {
+		return core.NewError(
+			"user %s does not have permission to pause sync %s",
+			userInfo.GetId(),
+			reqBody.GetSyncId(),
+		)
+	}
+	return nil
+}
+
+func (ps *pauseSyncImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.PauseSyncRequest, /*const*/
+) *core.GrpcHandlerResponse[*myncer_pb.PauseSyncResponse] {
+	// Changed under the sync's lock so that outcomes recorded by a running sync aren't lost.
+	var badRequestErr error
+	sync, err := core.ToMyncerCtx(ctx).DB.SyncStore.ModifySync(
+		ctx,
+		reqBody.GetSyncId(),
+		func(sync *myncer_pb.Sync) error {
+			if core.IsSyncPaused(sync) {
+				badRequestErr = core.NewError("sync %s is already paused", sync.GetId())
+				return badRequestErr
+			}
+			core.PauseSync(sync, reqBody.GetReason(), false /*automatic*/, time.Now())
+			return nil
+		},
+	)
+	if badRequestErr != nil {
+		return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.PauseSyncResponse](badRequestErr)
+	}
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.PauseSyncResponse](
+			core.WrappedError(err, "failed to pause sync %s", reqBody.GetSyncId()),
+		)
+	}
+
+	return core.NewGrpcHandlerResponse_OK(&myncer_pb.PauseSyncResponse{Sync: sync})
+}
@@ -0,0 +1,112 @@
+package rpc_handlers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/hansbala/myncer/sync_engine"
+)
+
+func NewRestorePlaylistSnapshotHandler() core.GrpcHandler[
+	*myncer_pb.RestorePlaylistSnapshotRequest,
+	*myncer_pb.RestorePlaylistSnapshotResponse,
+] {
+	return &restorePlaylistSnapshotImpl{}
+}
+
+type restorePlaylistSnapshotImpl struct{}
+
+func (r *restorePlaylistSnapshotImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.RestorePlaylistSnapshotRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to restore a playlist snapshot")
+	}
+	if _, err := getUserPlaylistSnapshot(ctx, userInfo, reqBody.GetSnapshotId()); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *restorePlaylistSnapshotImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.RestorePlaylistSnapshotRequest, /*const*/
+) *core.GrpcHandlerResponse[*myncer_pb.RestorePlaylistSnapshotResponse] {
+	dbStores := core.ToMyncerCtx(ctx).DB
+	snapshot, err := getUserPlaylistSnapshot(ctx, userInfo, reqBody.GetSnapshotId())
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.RestorePlaylistSnapshotResponse](err)
+	}
+	playlist := snapshot.GetPlaylist()
+
+	// A sync run writing to the playlist at the same time would undo part of the restore.
+	lock, err := dbStores.LockStore.TryLock(ctx, core.GetPlaylistLockKey(playlist))
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.RestorePlaylistSnapshotResponse](
+			core.WrappedError(err, "failed to lock playlist %s", playlist.GetPlaylistId()),
+		)
+	}
+	if lock == nil {
+		return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.RestorePlaylistSnapshotResponse](
+			core.NewError("playlist %s is being synced, try again later", playlist.GetPlaylistId()),
+		)
+	}
+	defer func() {
+		if err := lock.Unlock(ctx); err != nil {
+			core.Errorf(core.WrappedError(err, "failed to unlock playlist %s", playlist.GetPlaylistId()))
+		}
+	}()
+
+	client, err := core.ToMyncerCtx(ctx).DatasourceClients.GetClient(playlist.GetDatasource())
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.RestorePlaylistSnapshotResponse](
+			core.WrappedError(err, "failed to get datasource client"),
+		)
+	}
+	currentSongs, err := client.GetPlaylistSongs(ctx, userInfo, playlist)
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.RestorePlaylistSnapshotResponse](
+			core.WrappedError(err, "failed to get songs of playlist %s", playlist.GetPlaylistId()),
+		)
+	}
+	// Restoring is as destructive as the run being undone, so it gets a snapshot of its own.
+	undoSnapshot := &myncer_pb.PlaylistSnapshot{
+		Id:       uuid.NewString(),
+		UserId:   userInfo.GetId(),
+		Playlist: playlist,
+		Songs:    core.NewSongList(currentSongs).GetSpecs(),
+	}
+	if err := dbStores.PlaylistSnapshotStore.AddPlaylistSnapshot(ctx, undoSnapshot); err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.RestorePlaylistSnapshotResponse](
+			core.WrappedError(err, "failed to snapshot playlist %s", playlist.GetPlaylistId()),
+		)
+	}
+
+	if err := client.ClearPlaylist(ctx, userInfo, playlist); err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.RestorePlaylistSnapshotResponse](
+			core.WrappedError(err, "failed to clear playlist %s", playlist.GetPlaylistId()),
+		)
+	}
+	songs := []core.Song{}
+	for _, song := range snapshot.GetSongs() {
+		songs = append(songs, sync_engine.NewSong(song))
+	}
+	if len(songs) > 0 {
+		if err := client.AddToPlaylist(ctx, userInfo, playlist, songs); err != nil {
+			return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.RestorePlaylistSnapshotResponse](
+				core.WrappedError(err, "failed to add songs to playlist %s", playlist.GetPlaylistId()),
+			)
+		}
+	}
+
+	return core.NewGrpcHandlerResponse_OK(
+		&myncer_pb.RestorePlaylistSnapshotResponse{
+			Snapshot: undoSnapshot,
+		},
+	)
+}
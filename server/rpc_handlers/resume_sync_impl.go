@@ -0,0 +1,72 @@
+package rpc_handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+func NewResumeSyncHandler() core.GrpcHandler[
+	*myncer_pb.ResumeSyncRequest,
+	*myncer_pb.ResumeSyncResponse,
+] {
+	return &resumeSyncImpl{}
+}
+
+type resumeSyncImpl struct{}
+
+func (rs *resumeSyncImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.ResumeSyncRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to resume sync")
+	}
+	// Makes sure the sync belongs to the user.
+	sync, err := core.ToMyncerCtx(ctx).DB.SyncStore.GetSync(ctx, reqBody.GetSyncId())
+	if err != nil {
+		return core.WrappedError(err, "could not find sync with id: %s", reqBody.GetSyncId())
+	}
+	if userInfo.GetId() != sync.GetUserId() {
+		return core.NewError(
+			"user %s does not have permission to resume sync %s",
+			userInfo.GetId(),
+			reqBody.GetSyncId(),
+		)
+	}
+	return nil
+}
+
+func (rs *resumeSyncImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.ResumeSyncRequest, /*const*/
+) *core.GrpcHandlerResponse[*myncer_pb.ResumeSyncResponse] {
+	// Changed under the sync's lock so that outcomes recorded by a running sync aren't lost.
+	var badRequestErr error
+	sync, err := core.ToMyncerCtx(ctx).DB.SyncStore.ModifySync(
+		ctx,
+		reqBody.GetSyncId(),
+		func(sync *myncer_pb.Sync) error {
+			if !core.IsSyncPaused(sync) {
+				badRequestErr = core.NewError("sync %s is not paused", sync.GetId())
+				return badRequestErr
+			}
+			core.ResumeSync(sync, time.Now())
+			return nil
+		},
+	)
+	if badRequestErr != nil {
+		return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.ResumeSyncResponse](badRequestErr)
+	}
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.ResumeSyncResponse](
+			core.WrappedError(err, "failed to resume sync %s", reqBody.GetSyncId()),
+		)
+	}
+
+	return core.NewGrpcHandlerResponse_OK(&myncer_pb.ResumeSyncResponse{Sync: sync})
+}
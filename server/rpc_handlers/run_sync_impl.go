@@ -2,24 +2,21 @@ package rpc_handlers
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/hansbala/myncer/core"
 	myncer_pb "github.com/hansbala/myncer/proto/myncer"
 )
 
-func NewRunSyncHandler(syncEngine core.SyncEngine) core.GrpcHandler[
+func NewRunSyncHandler() core.GrpcHandler[
 	*myncer_pb.RunSyncRequest,
 	*myncer_pb.RunSyncResponse,
 ] {
-	return &runSyncImpl{
-		syncEngine: syncEngine,
-	}
+	return &runSyncImpl{}
 }
 
-type runSyncImpl struct{
-	syncEngine core.SyncEngine
-}
+type runSyncImpl struct{}
 
 func (rs *runSyncImpl) CheckPerms(
 	ctx context.Context,
@@ -46,18 +43,31 @@ func (rs *runSyncImpl) ProcessRequest(
 			core.WrappedError(err, "could not get sync by id"),
 		)
 	}
-	// We want to be able to run syncs in the background.
-	ctx = context.WithoutCancel(ctx)
-	go func() {
-		if err := rs.syncEngine.RunSync(ctx, userInfo, sync); err != nil {
-			core.Errorf(core.WrappedError(err, "failed to run sync job"))
-		}
-	}()
+	kind := myncer_pb.SyncRunKind_SYNC_RUN_KIND_UNSPECIFIED
+	if reqBody.GetDryRun() {
+		kind = myncer_pb.SyncRunKind_SYNC_RUN_KIND_PREVIEW
+	} else if core.IsSyncPaused(sync) {
+		// Dry runs don't change any playlist, so they are still allowed.
+		return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.RunSyncResponse](
+			core.WrappedError(core.CSyncPausedError, "sync %s can only be run as a dry run", sync.GetId()),
+		)
+	}
+	// The sync is run in the background by the sync workers.
+	syncRun, err := core.ToMyncerCtx(ctx).DB.SyncJobStore.EnqueueSyncJob(ctx, sync, kind)
+	if errors.Is(err, core.CSyncRunInProgressError) {
+		return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.RunSyncResponse](err)
+	}
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.RunSyncResponse](
+			core.WrappedError(err, "failed to enqueue sync job"),
+		)
+	}
 
 	return core.NewGrpcHandlerResponse_OK(
 		&myncer_pb.RunSyncResponse{
-			SyncId:       sync.GetId(),
-			Status:       myncer_pb.SyncStatus_SYNC_STATUS_RUNNING,
+			SyncId: sync.GetId(),
+			Status: syncRun.GetSyncStatus(),
+			RunId:  syncRun.GetRunId(),
 		},
 	)
 }
@@ -86,4 +96,3 @@ func (rs *runSyncImpl) validateRequest(
 	}
 	return nil
 }
-
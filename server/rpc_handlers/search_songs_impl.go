@@ -0,0 +1,99 @@
+package rpc_handlers
+
+import (
+	"context"
+
+	"github.com/hansbala/myncer/core"
+	"github.com/hansbala/myncer/matching"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/hansbala/myncer/sync_engine"
+	"google.golang.org/protobuf/proto"
+)
+
+func NewSearchSongsHandler() core.GrpcHandler[
+	*myncer_pb.SearchSongsRequest,
+	*myncer_pb.SearchSongsResponse,
+] {
+	return &searchSongsImpl{}
+}
+
+type searchSongsImpl struct{}
+
+func (ss *searchSongsImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.SearchSongsRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to search songs")
+	}
+	return nil
+}
+
+func (ss *searchSongsImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.SearchSongsRequest, /*const*/
+) *core.GrpcHandlerResponse[*myncer_pb.SearchSongsResponse] {
+	if err := ss.validateRequest(ctx, userInfo, reqBody); err != nil {
+		return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.SearchSongsResponse](
+			core.WrappedError(err, "failed to validate search songs request"),
+		)
+	}
+	client, err := core.ToMyncerCtx(ctx).DatasourceClients.GetClient(reqBody.GetDatasource())
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.SearchSongsResponse](
+			core.WrappedError(err, "failed to get datasource client"),
+		)
+	}
+	song := &myncer_pb.Song{
+		Name:       reqBody.GetSong().GetName(),
+		ArtistName: reqBody.GetSong().GetArtistName(),
+		AlbumName:  reqBody.GetSong().GetAlbumName(),
+		Isrc:       reqBody.GetSong().GetIsrc(),
+	}
+	candidates, err := client.Search(ctx, userInfo, sync_engine.NewSong(song), reqBody.GetMatchingProfile())
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.SearchSongsResponse](
+			core.WrappedError(err, "failed to search %v for song %s", reqBody.GetDatasource(), song.GetName()),
+		)
+	}
+	r := &myncer_pb.SearchSongsResponse{Candidates: []*myncer_pb.SongMatchCandidate{}}
+	for _, candidate := range candidates {
+		candidateSpec := proto.Clone(candidate.Song.GetSpec()).(*myncer_pb.Song)
+		// Not every datasource client sets the datasource of the songs it finds.
+		candidateSpec.Datasource = reqBody.GetDatasource()
+		r.Candidates = append(
+			r.Candidates,
+			&myncer_pb.SongMatchCandidate{Song: candidateSpec, Score: candidate.Score},
+		)
+	}
+	return core.NewGrpcHandlerResponse_OK(r)
+}
+
+func (ss *searchSongsImpl) validateRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.SearchSongsRequest, /*const*/
+) error {
+	if reqBody.GetDatasource() == myncer_pb.Datasource_DATASOURCE_UNSPECIFIED {
+		return core.NewError("datasource must be specified")
+	}
+	if reqBody.GetSong().GetName() == "" && reqBody.GetSong().GetIsrc() == "" {
+		return core.NewError("song name or isrc must be specified")
+	}
+	if err := matching.ValidateMatchingProfile(reqBody.GetMatchingProfile()); err != nil {
+		return core.WrappedError(err, "invalid matching profile")
+	}
+	connectedDatasources, err := core.ToMyncerCtx(ctx).DB.DatasourceTokenStore.GetConnectedDatasources(
+		ctx,
+		userInfo.GetId(),
+	)
+	if err != nil {
+		return core.WrappedError(err, "failed to get connected datasources for user")
+	}
+	if !connectedDatasources.Contains(reqBody.GetDatasource()) {
+		return core.NewError("datasource is not connected")
+	}
+	return nil
+}
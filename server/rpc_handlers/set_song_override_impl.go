@@ -0,0 +1,116 @@
+package rpc_handlers
+
+import (
+	"context"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/proto"
+)
+
+func NewSetSongOverrideHandler() core.GrpcHandler[
+	*myncer_pb.SetSongOverrideRequest,
+	*myncer_pb.SetSongOverrideResponse,
+] {
+	return &setSongOverrideImpl{}
+}
+
+type setSongOverrideImpl struct{}
+
+func (sso *setSongOverrideImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.SetSongOverrideRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to set a song override")
+	}
+	return nil
+}
+
+func (sso *setSongOverrideImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.SetSongOverrideRequest, /*const*/
+) *core.GrpcHandlerResponse[*myncer_pb.SetSongOverrideResponse] {
+	if err := sso.validateRequest(reqBody); err != nil {
+		return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.SetSongOverrideResponse](
+			core.WrappedError(err, "failed to validate set song override request"),
+		)
+	}
+	dbStores := core.ToMyncerCtx(ctx).DB
+	songOverride := &myncer_pb.SongOverride{
+		UserId:                userInfo.GetId(),
+		SourceSong:            reqBody.GetSourceSong(),
+		DestinationDatasource: reqBody.GetDestinationDatasource(),
+		Kind:                  reqBody.GetKind(),
+	}
+	if reqBody.GetKind() == myncer_pb.SongOverrideKind_SONG_OVERRIDE_KIND_PIN {
+		songOverride.DestinationSong = proto.Clone(reqBody.GetDestinationSong()).(*myncer_pb.Song)
+		songOverride.DestinationSong.Datasource = reqBody.GetDestinationDatasource()
+	}
+	if err := dbStores.SongOverrideStore.SetSongOverride(ctx, songOverride); err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.SetSongOverrideResponse](
+			core.WrappedError(err, "failed to set song override"),
+		)
+	}
+	// The song is searched for again if the override is deleted, in case it was resolved wrongly.
+	if err := dbStores.SongResolutionStore.DeleteSongResolution(
+		ctx,
+		userInfo.GetId(),
+		reqBody.GetSourceSong(),
+		reqBody.GetDestinationDatasource(),
+	); err != nil {
+		core.Errorf(core.WrappedError(err, "failed to delete resolution of overridden song"))
+	}
+
+	storedOverride, err := dbStores.SongOverrideStore.GetSongOverride(
+		ctx,
+		userInfo.GetId(),
+		reqBody.GetSourceSong(),
+		reqBody.GetDestinationDatasource(),
+	)
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.SetSongOverrideResponse](
+			core.WrappedError(err, "failed to get song override after setting it"),
+		)
+	}
+	if storedOverride == nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.SetSongOverrideResponse](
+			core.NewError("song override not found after setting it"),
+		)
+	}
+	return core.NewGrpcHandlerResponse_OK(&myncer_pb.SetSongOverrideResponse{SongOverride: storedOverride})
+}
+
+func (sso *setSongOverrideImpl) validateRequest(reqBody *myncer_pb.SetSongOverrideRequest /*const*/) error {
+	sourceSong := reqBody.GetSourceSong()
+	if sourceSong.GetDatasource() == myncer_pb.Datasource_DATASOURCE_UNSPECIFIED ||
+		sourceSong.GetDatasourceSongId() == "" {
+		return core.NewError("source song must have a datasource and datasource song id")
+	}
+	if reqBody.GetDestinationDatasource() == myncer_pb.Datasource_DATASOURCE_UNSPECIFIED {
+		return core.NewError("destination datasource must be specified")
+	}
+	if reqBody.GetDestinationDatasource() == sourceSong.GetDatasource() {
+		return core.NewError("source song and destination datasource must be different")
+	}
+	switch reqBody.GetKind() {
+	case myncer_pb.SongOverrideKind_SONG_OVERRIDE_KIND_PIN:
+		destinationSong := reqBody.GetDestinationSong()
+		if destinationSong.GetDatasourceSongId() == "" {
+			return core.NewError("destination song must be specified for pins")
+		}
+		if destinationSong.GetDatasource() != myncer_pb.Datasource_DATASOURCE_UNSPECIFIED &&
+			destinationSong.GetDatasource() != reqBody.GetDestinationDatasource() {
+			return core.NewError("destination song must be from the destination datasource")
+		}
+	case myncer_pb.SongOverrideKind_SONG_OVERRIDE_KIND_NEVER_SYNC:
+		if reqBody.GetDestinationSong() != nil {
+			return core.NewError("destination song must not be specified for songs that are never synced")
+		}
+	default:
+		return core.NewError("song override kind must be specified")
+	}
+	return nil
+}
@@ -0,0 +1,276 @@
+package rpc_handlers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+func NewTransferLibraryHandler() core.GrpcHandler[
+	*myncer_pb.TransferLibraryRequest,
+	*myncer_pb.TransferLibraryResponse,
+] {
+	return &transferLibraryImpl{}
+}
+
+type transferLibraryImpl struct{}
+
+func (tl *transferLibraryImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.TransferLibraryRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to transfer library")
+	}
+	return nil
+}
+
+func (tl *transferLibraryImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.TransferLibraryRequest, /*const*/
+) *core.GrpcHandlerResponse[*myncer_pb.TransferLibraryResponse] {
+	if err := tl.validateRequest(ctx, userInfo, reqBody); err != nil {
+		return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.TransferLibraryResponse](
+			core.WrappedError(err, "failed to validate transfer library request"),
+		)
+	}
+	playlists, err := tl.getPlaylistsToTransfer(ctx, userInfo, reqBody)
+	if err != nil {
+		return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.TransferLibraryResponse](
+			core.WrappedError(err, "failed to get playlists to transfer"),
+		)
+	}
+	existingSyncs, err := core.ToMyncerCtx(ctx).DB.SyncStore.GetSyncs(ctx, userInfo)
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.TransferLibraryResponse](
+			core.WrappedError(err, "failed to get existing syncs"),
+		)
+	}
+
+	transfer := &myncer_pb.LibraryTransfer{
+		Id:                    uuid.NewString(),
+		UserId:                userInfo.GetId(),
+		SourceDatasource:      reqBody.GetSourceDatasource(),
+		DestinationDatasource: reqBody.GetDestinationDatasource(),
+	}
+	for _, playlist := range playlists {
+		transfer.Items = append(
+			transfer.Items,
+			&myncer_pb.LibraryTransferItem{
+				Source:     playlist.GetMusicSource(),
+				SourceName: playlist.GetName(),
+			},
+		)
+	}
+	// Stored before any playlist is created, and updated as each one is, so that the playlists
+	// created are recorded even if the request doesn't finish.
+	dbStores := core.ToMyncerCtx(ctx).DB
+	if err := dbStores.LibraryTransferStore.AddLibraryTransfer(ctx, transfer); err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.TransferLibraryResponse](
+			core.WrappedError(err, "failed to store library transfer"),
+		)
+	}
+
+	// Playlists that can't be set up are reported on the transfer rather than failing it, since the
+	// playlists before them have already been created.
+	syncs := existingSyncs.ToArray()
+	for i, playlist := range playlists {
+		item := transfer.GetItems()[i]
+		if err := ctx.Err(); err != nil {
+			// The remaining playlists are left alone rather than pending forever.
+			item.ErrorMessage = core.WrappedError(err, "transfer was interrupted").Error()
+		} else {
+			sync, err := tl.transferPlaylist(ctx, userInfo, reqBody, playlist, syncs, transfer, item)
+			if err != nil {
+				core.Errorf(core.WrappedError(err, "failed to transfer playlist %s", playlist.GetName()))
+				item.ErrorMessage = err.Error()
+			}
+			if sync != nil {
+				syncs = append(syncs, sync)
+			}
+		}
+		// Stored even if the request was cancelled so that the transfer records how far it got.
+		tl.updateTransfer(context.WithoutCancel(ctx), transfer)
+	}
+
+	if err := fillLibraryTransferProgress(ctx, transfer); err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.TransferLibraryResponse](
+			core.WrappedError(err, "failed to get progress of library transfer"),
+		)
+	}
+
+	return core.NewGrpcHandlerResponse_OK(&myncer_pb.TransferLibraryResponse{Transfer: transfer})
+}
+
+func (tl *transferLibraryImpl) validateRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.TransferLibraryRequest, /*const*/
+) error {
+	if reqBody.GetSourceDatasource() == myncer_pb.Datasource_DATASOURCE_UNSPECIFIED {
+		return core.NewError("source datasource must be specified")
+	}
+	if reqBody.GetDestinationDatasource() == myncer_pb.Datasource_DATASOURCE_UNSPECIFIED {
+		return core.NewError("destination datasource must be specified")
+	}
+	if reqBody.GetSourceDatasource() == reqBody.GetDestinationDatasource() {
+		return core.NewError("source and destination datasources must be different")
+	}
+	connectedDatasources, err := core.ToMyncerCtx(ctx).DB.DatasourceTokenStore.GetConnectedDatasources(
+		ctx,
+		userInfo.GetId(),
+	)
+	if err != nil {
+		return core.WrappedError(err, "failed to get connected datasources for user")
+	}
+	if !connectedDatasources.Contains(reqBody.GetSourceDatasource()) {
+		return core.NewError("source datasource is not connected")
+	}
+	if !connectedDatasources.Contains(reqBody.GetDestinationDatasource()) {
+		return core.NewError("destination datasource is not connected")
+	}
+	return nil
+}
+
+// Returns the source playlists picked by the request, in the order of the source datasource.
+func (tl *transferLibraryImpl) getPlaylistsToTransfer(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.TransferLibraryRequest, /*const*/
+) ([]*myncer_pb.Playlist, error) {
+	client, err := core.ToMyncerCtx(ctx).DatasourceClients.GetClient(reqBody.GetSourceDatasource())
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to get source datasource client")
+	}
+	playlists, err := client.GetPlaylists(ctx, userInfo)
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to list source playlists")
+	}
+	if len(reqBody.GetPlaylistIds()) == 0 {
+		if len(playlists) == 0 {
+			return nil, core.NewError("there are no playlists to transfer")
+		}
+		return playlists, nil
+	}
+
+	pickedIds := core.ToSet(reqBody.GetPlaylistIds())
+	r := []*myncer_pb.Playlist{}
+	for _, playlist := range playlists {
+		if pickedIds.Contains(playlist.GetMusicSource().GetPlaylistId()) {
+			r = append(r, playlist)
+			pickedIds.Delete(playlist.GetMusicSource().GetPlaylistId())
+		}
+	}
+	if !pickedIds.IsEmpty() {
+		return nil, core.NewError("playlists not found on source datasource: %v", pickedIds.ToArray())
+	}
+	return r, nil
+}
+
+// Creates the destination playlist and a one-way sync into it, and queues a run of the sync.
+// The outcome is recorded on the item as it goes, and the transfer is stored once the playlist is
+// created.
+// Returns the sync if it was created.
+func (tl *transferLibraryImpl) transferPlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.TransferLibraryRequest, /*const*/
+	playlist *myncer_pb.Playlist, /*const*/
+	existingSyncs []*myncer_pb.Sync, /*const*/
+	transfer *myncer_pb.LibraryTransfer, /*const*/
+	item *myncer_pb.LibraryTransferItem,
+) (*myncer_pb.Sync /*@nullable*/, error) {
+	// Transferring the library again must not create another copy of the playlists already copied.
+	for _, existingSync := range existingSyncs {
+		ows := existingSync.GetOneWaySync()
+		if ows == nil {
+			continue
+		}
+		if core.GetPlaylistLockKey(ows.GetSource()) == core.GetPlaylistLockKey(playlist.GetMusicSource()) &&
+			ows.GetDestination().GetDatasource() == reqBody.GetDestinationDatasource() {
+			return nil, core.NewError(
+				"playlist is already synced to %v by sync %s",
+				reqBody.GetDestinationDatasource(),
+				existingSync.GetId(),
+			)
+		}
+	}
+
+	dbStores := core.ToMyncerCtx(ctx).DB
+	client, err := core.ToMyncerCtx(ctx).DatasourceClients.GetClient(reqBody.GetDestinationDatasource())
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to get destination datasource client")
+	}
+	destinationPlaylist, err := client.CreatePlaylist(
+		ctx,
+		userInfo,
+		playlist.GetName(),
+		playlist.GetDescription(),
+		reqBody.GetPublic(),
+	)
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to create destination playlist")
+	}
+	item.Destination = &myncer_pb.MusicSource{
+		Datasource: reqBody.GetDestinationDatasource(),
+		PlaylistId: destinationPlaylist.GetMusicSource().GetPlaylistId(),
+	}
+	tl.updateTransfer(context.WithoutCancel(ctx), transfer)
+
+	sync := NewSync_OneWaySync(
+		userInfo.GetId(),
+		&myncer_pb.OneWaySync{
+			Source:      playlist.GetMusicSource(),
+			Destination: item.GetDestination(),
+		},
+	)
+	if err := core.ValidateSyncGraph(existingSyncs, sync); err != nil {
+		return nil, core.WrappedError(err, "sync conflicts with existing syncs")
+	}
+	if err := dbStores.SyncStore.CreateSync(ctx, sync); err != nil {
+		return nil, core.WrappedError(err, "failed to create sync")
+	}
+	item.SyncId = sync.GetId()
+
+	syncRun, err := dbStores.SyncJobStore.EnqueueSyncJob(ctx, sync, myncer_pb.SyncRunKind_SYNC_RUN_KIND_UNSPECIFIED)
+	if err != nil {
+		return sync, core.WrappedError(err, "failed to enqueue sync job")
+	}
+	item.RunId = syncRun.GetRunId()
+	return sync, nil
+}
+
+// Stores the progress of the transfer.
+// Failures are logged rather than failing the transfer, since its playlists are being created
+// either way.
+func (tl *transferLibraryImpl) updateTransfer(ctx context.Context, transfer *myncer_pb.LibraryTransfer /*const*/) {
+	if err := core.ToMyncerCtx(ctx).DB.LibraryTransferStore.UpdateLibraryTransfer(ctx, transfer); err != nil {
+		core.Errorf(core.WrappedError(err, "failed to update library transfer %s", transfer.GetId()))
+	}
+}
+
+// Fills in the status, progress and unmatched songs of the transfer from the runs of its items.
+func fillLibraryTransferProgress(ctx context.Context, transfer *myncer_pb.LibraryTransfer) error {
+	runIds := core.NewSet[string]()
+	for _, item := range transfer.GetItems() {
+		if item.GetRunId() != "" {
+			runIds.Add(item.GetRunId())
+		}
+	}
+	syncRunsById := map[string]*myncer_pb.SyncRun{}
+	if !runIds.IsEmpty() {
+		syncRuns, err := core.ToMyncerCtx(ctx).DB.SyncRunStore.GetSyncs(ctx, runIds, nil /*syncIds*/)
+		if err != nil {
+			return core.WrappedError(err, "failed to get sync runs of library transfer")
+		}
+		for _, syncRun := range syncRuns.ToArray() {
+			syncRunsById[syncRun.GetRunId()] = syncRun
+		}
+	}
+	core.FillLibraryTransferProgress(transfer, syncRunsById)
+	return nil
+}
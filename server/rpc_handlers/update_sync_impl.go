@@ -0,0 +1,160 @@
+package rpc_handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+func NewUpdateSyncHandler() core.GrpcHandler[
+	*myncer_pb.UpdateSyncRequest,
+	*myncer_pb.UpdateSyncResponse,
+] {
+	return &updateSyncImpl{}
+}
+
+type updateSyncImpl struct{}
+
+func (us *updateSyncImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.UpdateSyncRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to update sync")
+	}
+	// Makes sure the sync belongs to the user.
+	sync, err := core.ToMyncerCtx(ctx).DB.SyncStore.GetSync(ctx, reqBody.GetSyncId())
+	if err != nil {
+		return core.WrappedError(err, "could not find sync with id: %s", reqBody.GetSyncId())
+	}
+	if userInfo.GetId() != sync.GetUserId() {
+		return core.NewError(
+			"user %s does not have permission to update sync %s",
+			userInfo.GetId(),
+			reqBody.GetSyncId(),
+		)
+	}
+	return nil
+}
+
+func (us *updateSyncImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.UpdateSyncRequest, /*const*/
+) *core.GrpcHandlerResponse[*myncer_pb.UpdateSyncResponse] {
+	syncStore := core.ToMyncerCtx(ctx).DB.SyncStore
+	// The sync is checked against the user's other syncs only, or it would be a duplicate of itself.
+	syncs, err := syncStore.GetSyncs(ctx, userInfo)
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.UpdateSyncResponse](
+			core.WrappedError(err, "failed to get existing syncs"),
+		)
+	}
+	otherSyncs := core.NewSet[*myncer_pb.Sync]()
+	for _, sync := range syncs.ToArray() {
+		if sync.GetId() != reqBody.GetSyncId() {
+			otherSyncs.Add(sync)
+		}
+	}
+
+	if err := us.validateRequest(ctx, reqBody, userInfo, otherSyncs); err != nil {
+		return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.UpdateSyncResponse](
+			core.WrappedError(err, "failed to validate update sync request"),
+		)
+	}
+
+	// Runs reference the sync by id, so updating it in place keeps its run history.
+	// The settings are applied under the sync's lock so that the pause, failures and schedule kept
+	// by the server at the same time aren't overwritten.
+	var badRequestErr error
+	if _, err := syncStore.ModifySync(
+		ctx,
+		reqBody.GetSyncId(),
+		func(sync *myncer_pb.Sync) error {
+			us.applyRequest(sync, reqBody)
+			if err := core.ValidateSyncGraph(otherSyncs.ToArray(), sync); err != nil {
+				badRequestErr = core.WrappedError(err, "sync conflicts with existing syncs")
+				return badRequestErr
+			}
+			return nil
+		},
+	); err != nil {
+		if badRequestErr != nil {
+			return core.NewGrpcHandlerResponse_BadRequest[*myncer_pb.UpdateSyncResponse](badRequestErr)
+		}
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.UpdateSyncResponse](
+			core.WrappedError(err, "failed to update sync in database"),
+		)
+	}
+	// Fetched again for the timestamps kept by the database.
+	updatedSync, err := syncStore.GetSync(ctx, reqBody.GetSyncId())
+	if err != nil {
+		return core.NewGrpcHandlerResponse_InternalServerError[*myncer_pb.UpdateSyncResponse](
+			core.WrappedError(err, "failed to get updated sync"),
+		)
+	}
+
+	return core.NewGrpcHandlerResponse_OK(&myncer_pb.UpdateSyncResponse{Sync: updatedSync})
+}
+
+func (us *updateSyncImpl) validateRequest(
+	ctx context.Context,
+	req *myncer_pb.UpdateSyncRequest, /*const*/
+	userInfo *myncer_pb.User, /*const*/
+	otherSyncs core.Set[*myncer_pb.Sync], /*const*/
+) error {
+	if err := validateSyncSettings(
+		req.GetScheduleInterval(),
+		req.GetRetryPolicy(),
+		req.GetFilterRules(),
+		req.GetMatchingProfile(),
+	); err != nil {
+		return err
+	}
+
+	syncVariant := req.GetSyncVariant()
+	switch syncVariant.(type) {
+	case *myncer_pb.UpdateSyncRequest_OneWaySync:
+		return validateOneWaySync(ctx, userInfo, req.GetOneWaySync(), otherSyncs, false /*createsDestination*/)
+	case *myncer_pb.UpdateSyncRequest_PlaylistMergeSync:
+		return validatePlaylistMergeSync(
+			ctx,
+			userInfo,
+			req.GetPlaylistMergeSync(),
+			otherSyncs,
+			false, /*createsDestination*/
+		)
+	case *myncer_pb.UpdateSyncRequest_FanOutSync:
+		return validateFanOutSync(ctx, userInfo, req.GetFanOutSync(), otherSyncs)
+	default:
+		return core.NewError("unknown sync type in validate request: %T", syncVariant)
+	}
+}
+
+// Changes the sync to the settings of the request.
+func (us *updateSyncImpl) applyRequest(
+	sync *myncer_pb.Sync,
+	req *myncer_pb.UpdateSyncRequest, /*const*/
+) {
+	switch v := req.GetSyncVariant().(type) {
+	case *myncer_pb.UpdateSyncRequest_OneWaySync:
+		sync.SyncVariant = &myncer_pb.Sync_OneWaySync{OneWaySync: v.OneWaySync}
+	case *myncer_pb.UpdateSyncRequest_PlaylistMergeSync:
+		sync.SyncVariant = &myncer_pb.Sync_PlaylistMergeSync{PlaylistMergeSync: v.PlaylistMergeSync}
+	case *myncer_pb.UpdateSyncRequest_FanOutSync:
+		sync.SyncVariant = &myncer_pb.Sync_FanOutSync{FanOutSync: v.FanOutSync}
+	}
+	if req.GetScheduleInterval() != sync.GetSchedule().GetInterval() {
+		lastRunAt := sync.GetSchedule().GetLastRunAt()
+		sync.Schedule = core.NewSyncSchedule(req.GetScheduleInterval(), time.Now())
+		if sync.GetSchedule() != nil {
+			sync.Schedule.LastRunAt = lastRunAt
+		}
+	}
+	sync.RetryPolicy = req.GetRetryPolicy()
+	sync.FilterRules = req.GetFilterRules()
+	sync.MatchingProfile = req.GetMatchingProfile()
+}
@@ -1,6 +1,7 @@
 package rpc_handlers
 
 import (
+	"context"
 	"slices"
 	"time"
 	"unicode"
@@ -106,3 +107,19 @@ func BuildOAuthToken(
 		Datasource:   datasource,
 	}
 }
+
+// Returns the snapshot if it belongs to the user.
+func getUserPlaylistSnapshot(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	snapshotId string,
+) (*myncer_pb.PlaylistSnapshot, error) {
+	snapshot, err := core.ToMyncerCtx(ctx).DB.PlaylistSnapshotStore.GetPlaylistSnapshot(ctx, snapshotId)
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to get playlist snapshot %s", snapshotId)
+	}
+	if snapshot.GetUserId() != userInfo.GetId() {
+		return nil, core.NewError("user does not have permission to access playlist snapshot %s", snapshotId)
+	}
+	return snapshot, nil
+}
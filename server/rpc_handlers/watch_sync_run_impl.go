@@ -0,0 +1,120 @@
+package rpc_handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+const (
+	// How often new events of the watched run are looked for.
+	// Runs are executed by sync workers which may live on another replica, so events are read back
+	// from the database.
+	cWatchSyncRunPollInterval = time.Second
+)
+
+func NewWatchSyncRunHandler() core.GrpcStreamHandler[
+	*myncer_pb.WatchSyncRunRequest,
+	*myncer_pb.WatchSyncRunResponse,
+] {
+	return &watchSyncRunImpl{}
+}
+
+type watchSyncRunImpl struct{}
+
+func (w *watchSyncRunImpl) CheckPerms(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const,@nullable*/
+	reqBody *myncer_pb.WatchSyncRunRequest, /*const*/
+) error {
+	if userInfo == nil {
+		return core.NewError("user is required to watch a sync run")
+	}
+	if _, err := uuid.Parse(reqBody.GetRunId()); err != nil {
+		return core.NewError("invalid run id: %v", err)
+	}
+	syncRun, err := core.ToMyncerCtx(ctx).DB.SyncRunStore.GetSyncRun(ctx, reqBody.GetRunId())
+	if err != nil {
+		return core.WrappedError(err, "failed to get sync run")
+	}
+	sync, err := core.ToMyncerCtx(ctx).DB.SyncStore.GetSync(ctx, syncRun.GetSyncId())
+	if err != nil {
+		return core.WrappedError(err, "failed to get sync for sync run")
+	}
+	if sync.GetUserId() != userInfo.GetId() {
+		return core.NewError("user does not have permission to watch this sync run")
+	}
+	return nil
+}
+
+func (w *watchSyncRunImpl) ProcessRequest(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	reqBody *myncer_pb.WatchSyncRunRequest, /*const*/
+	send func(*myncer_pb.WatchSyncRunResponse) error,
+) error {
+	dbStores := core.ToMyncerCtx(ctx).DB
+	runId := reqBody.GetRunId()
+
+	// Events are read before the run so that nothing that happens in between is missed.
+	// An event may be reflected in both the run and the first events sent, which is harmless as each
+	// event carries the full progress.
+	lastEventId, err := dbStores.SyncRunEventStore.GetLatestSyncRunEventId(ctx, runId)
+	if err != nil {
+		return core.WrappedError(err, "failed to get latest sync run event")
+	}
+	syncRun, err := dbStores.SyncRunStore.GetSyncRun(ctx, runId)
+	if err != nil {
+		return core.WrappedError(err, "failed to get sync run")
+	}
+	if err := send(&myncer_pb.WatchSyncRunResponse{
+		Update: &myncer_pb.WatchSyncRunResponse_SyncRun{SyncRun: syncRun},
+	}); err != nil {
+		return core.WrappedError(err, "failed to send sync run")
+	}
+	if core.IsTerminalSyncStatus(syncRun.GetSyncStatus()) {
+		return nil
+	}
+
+	ticker := time.NewTicker(cWatchSyncRunPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// The client went away.
+			return nil
+		case <-ticker.C:
+		}
+
+		// The run is read before its events so that every event of a finished run is sent before
+		// the stream ends.
+		syncRun, err := dbStores.SyncRunStore.GetSyncRun(ctx, runId)
+		if err != nil {
+			return core.WrappedError(err, "failed to get sync run")
+		}
+		var events []*myncer_pb.SyncRunEvent
+		events, lastEventId, err = dbStores.SyncRunEventStore.GetSyncRunEvents(ctx, runId, lastEventId)
+		if err != nil {
+			return core.WrappedError(err, "failed to get sync run events")
+		}
+		for _, event := range events {
+			if err := send(&myncer_pb.WatchSyncRunResponse{
+				Update: &myncer_pb.WatchSyncRunResponse_Event{Event: event},
+			}); err != nil {
+				return core.WrappedError(err, "failed to send sync run event")
+			}
+		}
+
+		if core.IsTerminalSyncStatus(syncRun.GetSyncStatus()) {
+			if err := send(&myncer_pb.WatchSyncRunResponse{
+				Update: &myncer_pb.WatchSyncRunResponse_SyncRun{SyncRun: syncRun},
+			}); err != nil {
+				return core.WrappedError(err, "failed to send sync run")
+			}
+			return nil
+		}
+	}
+}
@@ -8,23 +8,42 @@ import (
 	myncer_pb "github.com/hansbala/myncer/proto/myncer"
 	myncer_pb_connect "github.com/hansbala/myncer/proto/myncer/myncer_pbconnect"
 	"github.com/hansbala/myncer/rpc_handlers"
-	"github.com/hansbala/myncer/sync_engine"
 )
 
 func NewSyncService() *SyncService {
 	return &SyncService{
-		createSyncHandler:   rpc_handlers.NewCreateSyncHandler(),
-		deleteSyncHandler:   rpc_handlers.NewDeleteSyncHandler(),
-		listSyncsHandler:    rpc_handlers.NewListSyncsHandler(),
-		getSyncHandler:      rpc_handlers.NewGetSyncHandler(),
-		runSyncHandler:      rpc_handlers.NewRunSyncHandler(sync_engine.NewSyncEngine()),
-		listSyncRunsHandler: rpc_handlers.NewListSyncRunsHandler(),
+		createSyncHandler:              rpc_handlers.NewCreateSyncHandler(),
+		deleteSyncHandler:              rpc_handlers.NewDeleteSyncHandler(),
+		updateSyncHandler:              rpc_handlers.NewUpdateSyncHandler(),
+		pauseSyncHandler:               rpc_handlers.NewPauseSyncHandler(),
+		resumeSyncHandler:              rpc_handlers.NewResumeSyncHandler(),
+		listSyncsHandler:               rpc_handlers.NewListSyncsHandler(),
+		getSyncHandler:                 rpc_handlers.NewGetSyncHandler(),
+		runSyncHandler:                 rpc_handlers.NewRunSyncHandler(),
+		listSyncRunsHandler:            rpc_handlers.NewListSyncRunsHandler(),
+		cancelSyncRunHandler:           rpc_handlers.NewCancelSyncRunHandler(),
+		watchSyncRunHandler:            rpc_handlers.NewWatchSyncRunHandler(),
+		listPlaylistSnapshotsHandler:   rpc_handlers.NewListPlaylistSnapshotsHandler(),
+		diffPlaylistSnapshotsHandler:   rpc_handlers.NewDiffPlaylistSnapshotsHandler(),
+		restorePlaylistSnapshotHandler: rpc_handlers.NewRestorePlaylistSnapshotHandler(),
+		getSyncGraphHandler:            rpc_handlers.NewGetSyncGraphHandler(),
+		transferLibraryHandler:         rpc_handlers.NewTransferLibraryHandler(),
+		getLibraryTransferHandler:      rpc_handlers.NewGetLibraryTransferHandler(),
+		listLibraryTransfersHandler:    rpc_handlers.NewListLibraryTransfersHandler(),
+		clearSongResolutionsHandler:    rpc_handlers.NewClearSongResolutionsHandler(),
+		searchSongsHandler:             rpc_handlers.NewSearchSongsHandler(),
+		setSongOverrideHandler:         rpc_handlers.NewSetSongOverrideHandler(),
+		deleteSongOverrideHandler:      rpc_handlers.NewDeleteSongOverrideHandler(),
+		listSongOverridesHandler:       rpc_handlers.NewListSongOverridesHandler(),
 	}
 }
 
 type SyncService struct {
 	createSyncHandler   core.GrpcHandler[*myncer_pb.CreateSyncRequest, *myncer_pb.CreateSyncResponse]
 	deleteSyncHandler   core.GrpcHandler[*myncer_pb.DeleteSyncRequest, *myncer_pb.DeleteSyncResponse]
+	updateSyncHandler   core.GrpcHandler[*myncer_pb.UpdateSyncRequest, *myncer_pb.UpdateSyncResponse]
+	pauseSyncHandler    core.GrpcHandler[*myncer_pb.PauseSyncRequest, *myncer_pb.PauseSyncResponse]
+	resumeSyncHandler   core.GrpcHandler[*myncer_pb.ResumeSyncRequest, *myncer_pb.ResumeSyncResponse]
 	listSyncsHandler    core.GrpcHandler[*myncer_pb.ListSyncsRequest, *myncer_pb.ListSyncsResponse]
 	getSyncHandler      core.GrpcHandler[*myncer_pb.GetSyncRequest, *myncer_pb.GetSyncResponse]
 	runSyncHandler      core.GrpcHandler[*myncer_pb.RunSyncRequest, *myncer_pb.RunSyncResponse]
@@ -32,6 +51,62 @@ type SyncService struct {
 		*myncer_pb.ListSyncRunsRequest,
 		*myncer_pb.ListSyncRunsResponse,
 	]
+	cancelSyncRunHandler core.GrpcHandler[
+		*myncer_pb.CancelSyncRunRequest,
+		*myncer_pb.CancelSyncRunResponse,
+	]
+	watchSyncRunHandler core.GrpcStreamHandler[
+		*myncer_pb.WatchSyncRunRequest,
+		*myncer_pb.WatchSyncRunResponse,
+	]
+	listPlaylistSnapshotsHandler core.GrpcHandler[
+		*myncer_pb.ListPlaylistSnapshotsRequest,
+		*myncer_pb.ListPlaylistSnapshotsResponse,
+	]
+	diffPlaylistSnapshotsHandler core.GrpcHandler[
+		*myncer_pb.DiffPlaylistSnapshotsRequest,
+		*myncer_pb.DiffPlaylistSnapshotsResponse,
+	]
+	restorePlaylistSnapshotHandler core.GrpcHandler[
+		*myncer_pb.RestorePlaylistSnapshotRequest,
+		*myncer_pb.RestorePlaylistSnapshotResponse,
+	]
+	getSyncGraphHandler core.GrpcHandler[
+		*myncer_pb.GetSyncGraphRequest,
+		*myncer_pb.GetSyncGraphResponse,
+	]
+	transferLibraryHandler core.GrpcHandler[
+		*myncer_pb.TransferLibraryRequest,
+		*myncer_pb.TransferLibraryResponse,
+	]
+	getLibraryTransferHandler core.GrpcHandler[
+		*myncer_pb.GetLibraryTransferRequest,
+		*myncer_pb.GetLibraryTransferResponse,
+	]
+	listLibraryTransfersHandler core.GrpcHandler[
+		*myncer_pb.ListLibraryTransfersRequest,
+		*myncer_pb.ListLibraryTransfersResponse,
+	]
+	clearSongResolutionsHandler core.GrpcHandler[
+		*myncer_pb.ClearSongResolutionsRequest,
+		*myncer_pb.ClearSongResolutionsResponse,
+	]
+	searchSongsHandler core.GrpcHandler[
+		*myncer_pb.SearchSongsRequest,
+		*myncer_pb.SearchSongsResponse,
+	]
+	setSongOverrideHandler core.GrpcHandler[
+		*myncer_pb.SetSongOverrideRequest,
+		*myncer_pb.SetSongOverrideResponse,
+	]
+	deleteSongOverrideHandler core.GrpcHandler[
+		*myncer_pb.DeleteSongOverrideRequest,
+		*myncer_pb.DeleteSongOverrideResponse,
+	]
+	listSongOverridesHandler core.GrpcHandler[
+		*myncer_pb.ListSongOverridesRequest,
+		*myncer_pb.ListSongOverridesResponse,
+	]
 }
 
 var _ myncer_pb_connect.SyncServiceHandler = (*SyncService)(nil)
@@ -50,6 +125,27 @@ func (d *SyncService) DeleteSync(
 	return OrchestrateHandler(ctx, d.deleteSyncHandler, req.Msg)
 }
 
+func (d *SyncService) UpdateSync(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.UpdateSyncRequest], /*const*/
+) (*connect.Response[myncer_pb.UpdateSyncResponse], error) {
+	return OrchestrateHandler(ctx, d.updateSyncHandler, req.Msg)
+}
+
+func (d *SyncService) PauseSync(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.PauseSyncRequest], /*const*/
+) (*connect.Response[myncer_pb.PauseSyncResponse], error) {
+	return OrchestrateHandler(ctx, d.pauseSyncHandler, req.Msg)
+}
+
+func (d *SyncService) ResumeSync(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.ResumeSyncRequest], /*const*/
+) (*connect.Response[myncer_pb.ResumeSyncResponse], error) {
+	return OrchestrateHandler(ctx, d.resumeSyncHandler, req.Msg)
+}
+
 func (d *SyncService) ListSyncs(
 	ctx context.Context,
 	req *connect.Request[myncer_pb.ListSyncsRequest], /*const*/
@@ -77,3 +173,102 @@ func (d *SyncService) ListSyncRuns(
 ) (*connect.Response[myncer_pb.ListSyncRunsResponse], error) {
 	return OrchestrateHandler(ctx, d.listSyncRunsHandler, req.Msg)
 }
+
+func (d *SyncService) CancelSyncRun(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.CancelSyncRunRequest], /*const*/
+) (*connect.Response[myncer_pb.CancelSyncRunResponse], error) {
+	return OrchestrateHandler(ctx, d.cancelSyncRunHandler, req.Msg)
+}
+
+func (d *SyncService) WatchSyncRun(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.WatchSyncRunRequest], /*const*/
+	stream *connect.ServerStream[myncer_pb.WatchSyncRunResponse],
+) error {
+	return OrchestrateStreamHandler(ctx, d.watchSyncRunHandler, req.Msg, stream)
+}
+
+func (d *SyncService) ListPlaylistSnapshots(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.ListPlaylistSnapshotsRequest], /*const*/
+) (*connect.Response[myncer_pb.ListPlaylistSnapshotsResponse], error) {
+	return OrchestrateHandler(ctx, d.listPlaylistSnapshotsHandler, req.Msg)
+}
+
+func (d *SyncService) DiffPlaylistSnapshots(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.DiffPlaylistSnapshotsRequest], /*const*/
+) (*connect.Response[myncer_pb.DiffPlaylistSnapshotsResponse], error) {
+	return OrchestrateHandler(ctx, d.diffPlaylistSnapshotsHandler, req.Msg)
+}
+
+func (d *SyncService) RestorePlaylistSnapshot(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.RestorePlaylistSnapshotRequest], /*const*/
+) (*connect.Response[myncer_pb.RestorePlaylistSnapshotResponse], error) {
+	return OrchestrateHandler(ctx, d.restorePlaylistSnapshotHandler, req.Msg)
+}
+
+func (d *SyncService) GetSyncGraph(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.GetSyncGraphRequest], /*const*/
+) (*connect.Response[myncer_pb.GetSyncGraphResponse], error) {
+	return OrchestrateHandler(ctx, d.getSyncGraphHandler, req.Msg)
+}
+
+func (d *SyncService) TransferLibrary(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.TransferLibraryRequest], /*const*/
+) (*connect.Response[myncer_pb.TransferLibraryResponse], error) {
+	return OrchestrateHandler(ctx, d.transferLibraryHandler, req.Msg)
+}
+
+func (d *SyncService) GetLibraryTransfer(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.GetLibraryTransferRequest], /*const*/
+) (*connect.Response[myncer_pb.GetLibraryTransferResponse], error) {
+	return OrchestrateHandler(ctx, d.getLibraryTransferHandler, req.Msg)
+}
+
+func (d *SyncService) ListLibraryTransfers(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.ListLibraryTransfersRequest], /*const*/
+) (*connect.Response[myncer_pb.ListLibraryTransfersResponse], error) {
+	return OrchestrateHandler(ctx, d.listLibraryTransfersHandler, req.Msg)
+}
+
+func (d *SyncService) ClearSongResolutions(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.ClearSongResolutionsRequest], /*const*/
+) (*connect.Response[myncer_pb.ClearSongResolutionsResponse], error) {
+	return OrchestrateHandler(ctx, d.clearSongResolutionsHandler, req.Msg)
+}
+
+func (d *SyncService) SearchSongs(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.SearchSongsRequest], /*const*/
+) (*connect.Response[myncer_pb.SearchSongsResponse], error) {
+	return OrchestrateHandler(ctx, d.searchSongsHandler, req.Msg)
+}
+
+func (d *SyncService) SetSongOverride(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.SetSongOverrideRequest], /*const*/
+) (*connect.Response[myncer_pb.SetSongOverrideResponse], error) {
+	return OrchestrateHandler(ctx, d.setSongOverrideHandler, req.Msg)
+}
+
+func (d *SyncService) DeleteSongOverride(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.DeleteSongOverrideRequest], /*const*/
+) (*connect.Response[myncer_pb.DeleteSongOverrideResponse], error) {
+	return OrchestrateHandler(ctx, d.deleteSongOverrideHandler, req.Msg)
+}
+
+func (d *SyncService) ListSongOverrides(
+	ctx context.Context,
+	req *connect.Request[myncer_pb.ListSongOverridesRequest], /*const*/
+) (*connect.Response[myncer_pb.ListSongOverridesResponse], error) {
+	return OrchestrateHandler(ctx, d.listSongOverridesHandler, req.Msg)
+}
@@ -43,3 +43,26 @@ func OrchestrateHandler[RequestT any, ResponseT any](
 	}
 	return connectResp, nil
 }
+
+// OrchestrateStreamHandler is the server-streaming counterpart of `OrchestrateHandler`.
+func OrchestrateStreamHandler[RequestT any, ResponseT any](
+	ctx context.Context,
+	handler core.GrpcStreamHandler[*RequestT, *ResponseT],
+	reqBody *RequestT,
+	stream *connect.ServerStream[ResponseT],
+) error {
+	userInfo := auth.UserFromContext(ctx)
+	if err := handler.CheckPerms(ctx, userInfo, reqBody); err != nil {
+		core.Printf("failed to check user permissions: %v", err)
+		return connect.NewError(
+			connect.CodePermissionDenied,
+			core.WrappedError(err, "failed to check user permissions"),
+		)
+	}
+	if err := handler.ProcessRequest(ctx, userInfo, reqBody, stream.Send); err != nil {
+		err := core.WrappedError(err, "failed to process request")
+		core.Errorf(err)
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package sync_engine
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hansbala/myncer/core"
+	"github.com/hansbala/myncer/filtering"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+// Writes the source to every destination as if each had a one-way sync of its own, fetching and
+// normalizing the source only once.
+// Every destination is attempted even if an earlier one fails so that one broken playlist doesn't
+// hold back the rest.
+// Returns the songs that could not be found for any one of the destinations.
+func (s *syncEngineImpl) runFanOutSync(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	sync *myncer_pb.FanOutSync, /*const*/
+	syncRun *myncer_pb.SyncRun,
+	filter filtering.RuleEvaluator, /*const*/
+) ([]*myncer_pb.Song, error) {
+	normalizedSongs, err := s.getNormalizedSourceSongs(ctx, userInfo, sync.GetSource(), syncRun, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	unmatchedSongs := []*myncer_pb.Song{}
+	var errs []error
+	for _, destination := range sync.GetDestinations() {
+		targetResult := &myncer_pb.SyncRunTargetResult{Target: destination}
+		syncRun.TargetResults = append(syncRun.TargetResults, targetResult)
+
+		// Progress covers every destination so the destination's share is what it adds to it.
+		addedBefore := syncRun.GetProgress().GetAddedSongs()
+		removedBefore := syncRun.GetProgress().GetRemovedSongs()
+		unmatched, err := s.writeOneWaySync(
+			ctx,
+			userInfo,
+			core.GetFanOutOneWaySync(sync, destination),
+			syncRun,
+			normalizedSongs,
+		)
+		targetResult.UnmatchedSongs = unmatched
+		targetResult.AddedSongs = syncRun.GetProgress().GetAddedSongs() - addedBefore
+		targetResult.RemovedSongs = syncRun.GetProgress().GetRemovedSongs() - removedBefore
+		unmatchedSongs = append(unmatchedSongs, unmatched...)
+		if errors.Is(err, core.CSyncRunCancelledError) {
+			return unmatchedSongs, err
+		}
+		if err != nil {
+			errs = append(
+				errs,
+				core.WrappedError(err, "failed to write to playlist %s", destination.GetPlaylistId()),
+			)
+		}
+	}
+	return unmatchedSongs, errors.Join(errs...)
+}
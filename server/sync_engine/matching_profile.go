@@ -0,0 +1,23 @@
+package sync_engine
+
+import (
+	"context"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+type matchingProfileCtxType struct{}
+
+// Makes the sync run executing under the context match songs with the profile.
+func withMatchingProfile(ctx context.Context, profile *myncer_pb.MatchingProfile /*const,@nullable*/) context.Context {
+	return context.WithValue(ctx, matchingProfileCtxType{}, profile)
+}
+
+// Returns nil if the sync run executing under the context uses the default profile.
+func getMatchingProfile(ctx context.Context) *myncer_pb.MatchingProfile /*const,@nullable*/ {
+	profile, ok := ctx.Value(matchingProfileCtxType{}).(*myncer_pb.MatchingProfile)
+	if !ok {
+		return nil
+	}
+	return profile
+}
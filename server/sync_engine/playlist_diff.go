@@ -0,0 +1,102 @@
+package sync_engine
+
+import (
+	"github.com/hansbala/myncer/core"
+	"github.com/hansbala/myncer/matching"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+// Tracks which songs of a destination playlist are accounted for by the source.
+// Each destination song accounts for at most one source song so that duplicates in the source are
+// only kept as duplicates in the destination.
+type playlistDiff struct {
+	destinationSongs []core.Song /*const*/
+	claimed          []bool
+	profile          *myncer_pb.MatchingProfile /*const,@nullable*/
+}
+
+func newPlaylistDiff(
+	destinationSongs []core.Song, /*const*/
+	profile *myncer_pb.MatchingProfile, /*const,@nullable*/
+) *playlistDiff {
+	return &playlistDiff{
+		destinationSongs: destinationSongs,
+		claimed:          make([]bool, len(destinationSongs)),
+		profile:          profile,
+	}
+}
+
+// Returns true if the songs are the same song.
+// Songs from the same datasource are compared by id, others by similarity against the dedupe
+// threshold of the matching profile.
+func isSameSong(
+	songA core.Song, /*const*/
+	songB core.Song, /*const*/
+	profile *myncer_pb.MatchingProfile, /*const,@nullable*/
+) bool {
+	if songA.GetSpec().GetDatasource() == songB.GetSpec().GetDatasource() {
+		return songA.GetId() == songB.GetId()
+	}
+	return matching.AreDuplicates(songA, songB, profile)
+}
+
+// Claims an unclaimed destination song that is the same as the source song.
+// Songs from the destination datasource are compared by id, others by similarity.
+// Returns nil if there is no such song.
+func (p *playlistDiff) claimSimilar(sourceSong core.Song /*const*/) core.Song /*@nullable*/ {
+	bestIdx := -1
+	bestScore := 0.0
+	for i, destinationSong := range p.destinationSongs {
+		if p.claimed[i] {
+			continue
+		}
+		if sourceSong.GetSpec().GetDatasource() == destinationSong.GetSpec().GetDatasource() {
+			if sourceSong.GetId() == destinationSong.GetId() {
+				bestIdx = i
+				break
+			}
+			continue
+		}
+		score := matching.CalculateSimilarity(sourceSong, destinationSong, p.profile)
+		if score >= matching.GetDedupeThreshold(p.profile) && score > bestScore {
+			bestIdx = i
+			bestScore = score
+		}
+	}
+	if bestIdx < 0 {
+		return nil
+	}
+	p.claimed[bestIdx] = true
+	return p.destinationSongs[bestIdx]
+}
+
+// Claims an unclaimed destination song with the id.
+// Returns false if there is no such song.
+func (p *playlistDiff) claimById(id string) bool {
+	for i, destinationSong := range p.destinationSongs {
+		if !p.claimed[i] && destinationSong.GetId() == id {
+			p.claimed[i] = true
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the destination songs that no source song accounts for.
+// Extra copies of a song that is accounted for are not returned since datasources remove every copy
+// of a song at once.
+func (p *playlistDiff) getExtraSongs() []core.Song {
+	claimedIds := core.NewSet[string]()
+	for i, destinationSong := range p.destinationSongs {
+		if p.claimed[i] {
+			claimedIds.Add(destinationSong.GetId())
+		}
+	}
+	r := []core.Song{}
+	for i, destinationSong := range p.destinationSongs {
+		if !p.claimed[i] && !claimedIds.Contains(destinationSong.GetId()) {
+			r = append(r, destinationSong)
+		}
+	}
+	return r
+}
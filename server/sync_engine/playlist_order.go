@@ -0,0 +1,93 @@
+package sync_engine
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+// Returns the songs of the playlist in the requested order.
+// For source order, `orderedSongs` holds the destination songs in the order of the source; songs
+// that aren't part of it keep their relative order at the end of the playlist.
+// Songs that compare equal keep their current relative order.
+func getOrderedPlaylistSongs(
+	playlistSongs []core.Song, /*const*/
+	orderedSongs []core.Song, /*const*/
+	order myncer_pb.PlaylistOrder,
+) []core.Song {
+	r := slices.Clone(playlistSongs)
+	switch order {
+	case myncer_pb.PlaylistOrder_PLAYLIST_ORDER_SOURCE:
+		ranks := map[string]int{}
+		for i, song := range orderedSongs {
+			if _, ok := ranks[song.GetId()]; !ok {
+				ranks[song.GetId()] = i
+			}
+		}
+		getRank := func(song core.Song) int {
+			if rank, ok := ranks[song.GetId()]; ok {
+				return rank
+			}
+			return len(orderedSongs)
+		}
+		slices.SortStableFunc(r, func(a, b core.Song) int { return getRank(a) - getRank(b) })
+	case myncer_pb.PlaylistOrder_PLAYLIST_ORDER_NAME:
+		sortSongsByKey(r, core.Song.GetName)
+	case myncer_pb.PlaylistOrder_PLAYLIST_ORDER_ARTIST:
+		sortSongsByKey(r, func(song core.Song) string {
+			if len(song.GetArtistNames()) == 0 {
+				return ""
+			}
+			return song.GetArtistNames()[0]
+		})
+	case myncer_pb.PlaylistOrder_PLAYLIST_ORDER_ALBUM:
+		sortSongsByKey(r, core.Song.GetAlbum)
+	}
+	return r
+}
+
+func sortSongsByKey(songs []core.Song, getKey func(core.Song) string) {
+	slices.SortStableFunc(songs, func(a, b core.Song) int {
+		return strings.Compare(strings.ToLower(getKey(a)), strings.ToLower(getKey(b)))
+	})
+}
+
+// Puts the songs of the playlist in the requested order.
+// `orderedSongs` holds the playlist's songs that match the source songs, in the order of the source.
+// The playlist is left alone if it is already in order.
+func (s *syncEngineImpl) reorderPlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	syncRun *myncer_pb.SyncRun,
+	playlist *myncer_pb.MusicSource, /*const*/
+	client core.DatasourceClient,
+	orderedSongs []core.Song, /*const*/
+	order myncer_pb.PlaylistOrder,
+) error {
+	if order == myncer_pb.PlaylistOrder_PLAYLIST_ORDER_UNSPECIFIED {
+		return nil
+	}
+	if err := core.CheckSyncRunCancelled(ctx); err != nil {
+		return err
+	}
+	// The playlist is fetched again since adding songs may not put them where expected.
+	playlistSongs, err := client.GetPlaylistSongs(ctx, userInfo, playlist)
+	if err != nil {
+		return core.WrappedError(err, "failed to fetch playlist %s for reordering", playlist.GetPlaylistId())
+	}
+	songs := getOrderedPlaylistSongs(playlistSongs, orderedSongs, order)
+	if slices.EqualFunc(playlistSongs, songs, func(a, b core.Song) bool { return a.GetId() == b.GetId() }) {
+		return nil
+	}
+
+	if err := s.enterPhase(ctx, syncRun, myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_REORDER_DESTINATION); err != nil {
+		return err
+	}
+	if err := client.ReorderPlaylist(ctx, userInfo, playlist, songs); err != nil {
+		return core.WrappedError(err, "failed to reorder playlist %s", playlist.GetPlaylistId())
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package sync_engine
+
+import (
+	"testing"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOrderedPlaylistSongs(t *testing.T) {
+	newSong := func(id string, name string, artist string) core.Song {
+		return NewSong(
+			&myncer_pb.Song{
+				Name:             name,
+				ArtistName:       []string{artist},
+				Datasource:       myncer_pb.Datasource_DATASOURCE_SPOTIFY,
+				DatasourceSongId: id,
+			},
+		)
+	}
+	getIds := func(songs []core.Song) []string {
+		r := []string{}
+		for _, song := range songs {
+			r = append(r, song.GetId())
+		}
+		return r
+	}
+	playlistSongs := []core.Song{
+		newSong("1", "banana", "Zed"),
+		newSong("2", "Apple", "Yann"),
+		newSong("3", "cherry", "xavier"),
+		newSong("4", "apple", "Walt"),
+	}
+
+	testCases := []struct {
+		name         string
+		orderedSongs []core.Song
+		order        myncer_pb.PlaylistOrder
+		expectedIds  []string
+	}{
+		{
+			name:        "unspecified keeps the playlist order",
+			order:       myncer_pb.PlaylistOrder_PLAYLIST_ORDER_UNSPECIFIED,
+			expectedIds: []string{"1", "2", "3", "4"},
+		},
+		{
+			name:         "source order with unknown songs last",
+			orderedSongs: []core.Song{playlistSongs[2], playlistSongs[0]},
+			order:        myncer_pb.PlaylistOrder_PLAYLIST_ORDER_SOURCE,
+			expectedIds:  []string{"3", "1", "2", "4"},
+		},
+		{
+			name:        "name ignores case and keeps ties stable",
+			order:       myncer_pb.PlaylistOrder_PLAYLIST_ORDER_NAME,
+			expectedIds: []string{"2", "4", "1", "3"},
+		},
+		{
+			name:        "artist",
+			order:       myncer_pb.PlaylistOrder_PLAYLIST_ORDER_ARTIST,
+			expectedIds: []string{"4", "3", "2", "1"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			songs := getOrderedPlaylistSongs(playlistSongs, tc.orderedSongs, tc.order)
+			assert.Equal(t, tc.expectedIds, getIds(songs))
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package sync_engine
+
+import (
+	"context"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+type syncPreviewCtxType struct{}
+
+// The changes planned by a preview run so far.
+type syncPreview struct {
+	spec *myncer_pb.SyncPreview
+	// The songs each planned playlist would hold, keyed by playlist lock key.
+	plannedSongs map[string][]core.Song
+}
+
+func newSyncPreview(spec *myncer_pb.SyncPreview) *syncPreview {
+	return &syncPreview{
+		spec:         spec,
+		plannedSongs: map[string][]core.Song{},
+	}
+}
+
+// Makes the sync run executing under the context a preview run.
+func withSyncPreview(ctx context.Context, preview *syncPreview) context.Context {
+	return context.WithValue(ctx, syncPreviewCtxType{}, preview)
+}
+
+// Returns nil if the sync run executing under the context is not a preview run.
+func getSyncPreview(ctx context.Context) *syncPreview /*@nullable*/ {
+	preview, ok := ctx.Value(syncPreviewCtxType{}).(*syncPreview)
+	if !ok {
+		return nil
+	}
+	return preview
+}
+
+// Records the changes to playlists in the preview instead of making them.
+// Playlists that have planned changes are read back with those changes so that later stages of the
+// run plan against them.
+func newPreviewClient(client core.DatasourceClient, preview *syncPreview) core.DatasourceClient {
+	return &previewClientImpl{
+		DatasourceClient: client,
+		preview:          preview,
+	}
+}
+
+// Everything but the playlist reads and writes is passed through to the wrapped client.
+type previewClientImpl struct {
+	core.DatasourceClient
+	preview *syncPreview
+}
+
+var _ core.DatasourceClient = (*previewClientImpl)(nil)
+
+func (p *previewClientImpl) GetPlaylistSongs(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
+) ([]core.Song, error) {
+	if songs, ok := p.preview.plannedSongs[core.GetPlaylistLockKey(playlist)]; ok {
+		return songs, nil
+	}
+	return p.DatasourceClient.GetPlaylistSongs(ctx, userInfo, playlist)
+}
+
+func (p *previewClientImpl) AddToPlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
+	songs []core.Song, /*const*/
+) error {
+	playlistSongs, err := p.GetPlaylistSongs(ctx, userInfo, playlist)
+	if err != nil {
+		return err
+	}
+	target := p.getTarget(playlist)
+	target.SongsToAdd = append(target.SongsToAdd, core.NewSongList(songs).GetSpecs()...)
+	p.preview.plannedSongs[core.GetPlaylistLockKey(playlist)] = append(
+		append([]core.Song{}, playlistSongs...),
+		songs...,
+	)
+	return nil
+}
+
+func (p *previewClientImpl) ClearPlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
+) error {
+	playlistSongs, err := p.GetPlaylistSongs(ctx, userInfo, playlist)
+	if err != nil {
+		return err
+	}
+	target := p.getTarget(playlist)
+	target.ClearsPlaylist = true
+	target.SongsToRemove = append(target.SongsToRemove, core.NewSongList(playlistSongs).GetSpecs()...)
+	p.preview.plannedSongs[core.GetPlaylistLockKey(playlist)] = []core.Song{}
+	return nil
+}
+
+func (p *previewClientImpl) RemoveFromPlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
+	songs []core.Song, /*const*/
+) error {
+	playlistSongs, err := p.GetPlaylistSongs(ctx, userInfo, playlist)
+	if err != nil {
+		return err
+	}
+	idsToRemove := core.NewSet[string]()
+	for _, song := range songs {
+		idsToRemove.Add(song.GetId())
+	}
+	target := p.getTarget(playlist)
+	remainingSongs := []core.Song{}
+	for _, song := range playlistSongs {
+		if idsToRemove.Contains(song.GetId()) {
+			target.SongsToRemove = append(target.SongsToRemove, song.GetSpec())
+			continue
+		}
+		remainingSongs = append(remainingSongs, song)
+	}
+	p.preview.plannedSongs[core.GetPlaylistLockKey(playlist)] = remainingSongs
+	return nil
+}
+
+func (p *previewClientImpl) ReorderPlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
+	songs []core.Song, /*const*/
+) error {
+	p.getTarget(playlist).ReordersPlaylist = true
+	p.preview.plannedSongs[core.GetPlaylistLockKey(playlist)] = songs
+	return nil
+}
+
+// Returns the planned changes to the playlist, adding them to the preview if needed.
+func (p *previewClientImpl) getTarget(playlist *myncer_pb.MusicSource /*const*/) *myncer_pb.SyncPreviewTarget {
+	key := core.GetPlaylistLockKey(playlist)
+	for _, target := range p.preview.spec.GetTargets() {
+		if core.GetPlaylistLockKey(target.GetTarget()) == key {
+			return target
+		}
+	}
+	target := &myncer_pb.SyncPreviewTarget{Target: playlist}
+	p.preview.spec.Targets = append(p.preview.spec.Targets, target)
+	return target
+}
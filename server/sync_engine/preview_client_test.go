@@ -0,0 +1,91 @@
+package sync_engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/stretchr/testify/assert"
+)
+
+// Serves a fixed playlist and fails the test on any write.
+type fakePlaylistClient struct {
+	core.DatasourceClient
+	t     *testing.T
+	songs []core.Song
+}
+
+func (f *fakePlaylistClient) GetPlaylistSongs(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
+) ([]core.Song, error) {
+	return f.songs, nil
+}
+
+func (f *fakePlaylistClient) AddToPlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
+	songs []core.Song, /*const*/
+) error {
+	f.t.Fatal("preview added songs to the playlist")
+	return nil
+}
+
+func (f *fakePlaylistClient) ClearPlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
+) error {
+	f.t.Fatal("preview cleared the playlist")
+	return nil
+}
+
+func TestPreviewClient(t *testing.T) {
+	newSpotifySongs := func(ids ...string) []core.Song {
+		r := []core.Song{}
+		for _, id := range ids {
+			r = append(
+				r,
+				NewSong(&myncer_pb.Song{Datasource: myncer_pb.Datasource_DATASOURCE_SPOTIFY, DatasourceSongId: id}),
+			)
+		}
+		return r
+	}
+	getIds := func(songs []*myncer_pb.Song) []string {
+		r := []string{}
+		for _, song := range songs {
+			r = append(r, song.GetDatasourceSongId())
+		}
+		return r
+	}
+
+	ctx := context.Background()
+	playlist := &myncer_pb.MusicSource{
+		Datasource: myncer_pb.Datasource_DATASOURCE_SPOTIFY,
+		PlaylistId: "playlist",
+	}
+	spec := &myncer_pb.SyncPreview{}
+	client := newPreviewClient(
+		&fakePlaylistClient{t: t, songs: newSpotifySongs("1", "2", "3")},
+		newSyncPreview(spec),
+	)
+
+	assert.NoError(t, client.RemoveFromPlaylist(ctx, nil /*userInfo*/, playlist, newSpotifySongs("2")))
+	assert.NoError(t, client.AddToPlaylist(ctx, nil /*userInfo*/, playlist, newSpotifySongs("4")))
+	songs, err := client.GetPlaylistSongs(ctx, nil /*userInfo*/, playlist)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "3", "4"}, getIds(core.NewSongList(songs).GetSpecs()))
+
+	assert.NoError(t, client.ClearPlaylist(ctx, nil /*userInfo*/, playlist))
+	if !assert.Len(t, spec.GetTargets(), 1) {
+		return
+	}
+	target := spec.GetTargets()[0]
+	assert.Equal(t, "playlist", target.GetTarget().GetPlaylistId())
+	assert.True(t, target.GetClearsPlaylist())
+	assert.Equal(t, []string{"4"}, getIds(target.GetSongsToAdd()))
+	assert.Equal(t, []string{"2", "1", "3", "4"}, getIds(target.GetSongsToRemove()))
+}
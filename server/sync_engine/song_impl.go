@@ -1,8 +1,6 @@
 package sync_engine
 
 import (
-	"context"
-
 	"github.com/hansbala/myncer/core"
 	myncer_pb "github.com/hansbala/myncer/proto/myncer"
 )
@@ -37,80 +35,6 @@ func (s *songImpl) GetId() string {
 	return s.spec.GetDatasourceSongId()
 }
 
-func (s *songImpl) GetIdByDatasource(
-	ctx context.Context,
-	userInfo *myncer_pb.User, /*const*/
-	datasource myncer_pb.Datasource,
-) (string, error) {
-	switch datasource {
-	case myncer_pb.Datasource_DATASOURCE_SPOTIFY:
-		return s.getSpotifyId(ctx, userInfo)
-	case myncer_pb.Datasource_DATASOURCE_YOUTUBE:
-		return s.getYoutubeId(ctx, userInfo)
-	case myncer_pb.Datasource_DATASOURCE_TIDAL:
-		return s.getTidalId(ctx, userInfo)
-	default:
-		return "", core.NewError("Unknown datasource: %v", datasource)
-	}
-}
-
 func (s *songImpl) GetSpec() *myncer_pb.Song {
 	return s.spec
 }
-
-func (s *songImpl) getSpotifyId(
-	ctx context.Context,
-	userInfo *myncer_pb.User, /*const*/
-) (string, error) {
-	if s.spec.GetDatasource() == myncer_pb.Datasource_DATASOURCE_SPOTIFY {
-		return s.spec.GetDatasourceSongId(), nil
-	}
-	// Otherwise, try searching Spotify
-	result, err := core.ToMyncerCtx(ctx).DatasourceClients.SpotifyClient.Search(
-		ctx,
-		userInfo,
-		s, // Pass the complete song object instead of individual fields
-	)
-	if err != nil {
-		return "", core.WrappedError(err, "spotify search failed for song: %s", s.GetName())
-	}
-	return result.GetId(), nil
-}
-
-func (s *songImpl) getYoutubeId(
-	ctx context.Context,
-	userInfo *myncer_pb.User, /*const*/
-) (string, error) {
-	if s.spec.GetDatasource() == myncer_pb.Datasource_DATASOURCE_YOUTUBE {
-		return s.spec.GetDatasourceSongId(), nil
-	}
-	// Otherwise, try searching Youtube.
-	result, err := core.ToMyncerCtx(ctx).DatasourceClients.YoutubeClient.Search(
-		ctx,
-		userInfo,
-		s, // Pass the complete song object instead of individual fields
-	)
-	if err != nil {
-		return "", core.WrappedError(err, "youtube search failed for song: %s", s.GetName())
-	}
-	return result.GetId(), nil
-}
-
-func (s *songImpl) getTidalId(
-	ctx context.Context,
-	userInfo *myncer_pb.User, /*const*/
-) (string, error) {
-	if s.spec.GetDatasource() == myncer_pb.Datasource_DATASOURCE_TIDAL {
-		return s.spec.GetDatasourceSongId(), nil
-	}
-	// Otherwise, try searching Tidal.
-	result, err := core.ToMyncerCtx(ctx).DatasourceClients.TidalClient.Search(
-		ctx,
-		userInfo,
-		s, // Pass the complete song object instead of individual fields
-	)
-	if err != nil {
-		return "", core.WrappedError(err, "tidal search failed for song: %s", s.GetName())
-	}
-	return result.GetId(), nil
-}
@@ -0,0 +1,60 @@
+package sync_engine
+
+import (
+	"github.com/hansbala/myncer/core"
+	"github.com/hansbala/myncer/matching"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/proto"
+)
+
+// What a source song was matched to on the destination datasource.
+type songMatch struct {
+	// Nil if the song is unmatched.
+	song core.Song /*@nullable*/
+	// How similar the song is to the source song, from 0 to 100.
+	score float64
+	// Other songs the source song could have been matched to, best first.
+	runnerUps []*myncer_pb.SongMatchCandidate
+	// Whether the user pinned the source song to the song.
+	pinned bool
+}
+
+// Matches the song to the best of the searched candidates, if the profile accepts it.
+// The other candidates are kept as runner-ups, which is all of them if the song is unmatched.
+func newSongMatch(
+	candidates []*core.SearchCandidate, /*const*/ // best first
+	datasource myncer_pb.Datasource,
+	profile *myncer_pb.MatchingProfile, /*const,@nullable*/
+) *songMatch {
+	r := &songMatch{runnerUps: []*myncer_pb.SongMatchCandidate{}}
+	if len(candidates) > 0 && matching.IsAcceptableMatch(candidates[0].Score, profile, datasource) {
+		r.song = newDatasourceSong(candidates[0].Song, datasource)
+		r.score = candidates[0].Score
+		candidates = candidates[1:]
+	}
+	for _, candidate := range candidates {
+		r.runnerUps = append(
+			r.runnerUps,
+			&myncer_pb.SongMatchCandidate{
+				Song:  newDatasourceSong(candidate.Song, datasource).GetSpec(),
+				Score: candidate.Score,
+			},
+		)
+	}
+	return r
+}
+
+// Returns whether the match is probably wrong.
+func (m *songMatch) isLowConfidence(profile *myncer_pb.MatchingProfile /*const,@nullable*/) bool {
+	return m.song != nil && !m.pinned && !matching.IsConfidentMatch(m.score, profile, m.song.GetSpec().GetDatasource())
+}
+
+// Returns the song with its datasource set, as not every datasource client sets it.
+func newDatasourceSong(song core.Song /*const*/, datasource myncer_pb.Datasource) core.Song {
+	if song.GetSpec().GetDatasource() == datasource {
+		return song
+	}
+	spec := proto.Clone(song.GetSpec()).(*myncer_pb.Song)
+	spec.Datasource = datasource
+	return NewSong(spec)
+}
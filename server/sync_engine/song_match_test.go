@@ -0,0 +1,109 @@
+package sync_engine
+
+import (
+	"testing"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSongMatch(t *testing.T) {
+	newCandidate := func(id string, score float64) *core.SearchCandidate {
+		return &core.SearchCandidate{Song: NewSong(&myncer_pb.Song{DatasourceSongId: id}), Score: score}
+	}
+	getRunnerUpIds := func(match *songMatch) []string {
+		r := []string{}
+		for _, runnerUp := range match.runnerUps {
+			r = append(r, runnerUp.GetSong().GetDatasourceSongId())
+		}
+		return r
+	}
+
+	testCases := []struct {
+		name                  string
+		candidates            []*core.SearchCandidate
+		datasource            myncer_pb.Datasource
+		profile               *myncer_pb.MatchingProfile
+		expectedId            string
+		expectedRunnerUpIds   []string
+		expectedLowConfidence bool
+	}{
+		{
+			name:                "confident match",
+			candidates:          []*core.SearchCandidate{newCandidate("1", 97), newCandidate("2", 70)},
+			datasource:          myncer_pb.Datasource_DATASOURCE_TIDAL,
+			expectedId:          "1",
+			expectedRunnerUpIds: []string{"2"},
+		},
+		{
+			name:                  "acceptable match is low confidence",
+			candidates:            []*core.SearchCandidate{newCandidate("1", 70), newCandidate("2", 60)},
+			datasource:            myncer_pb.Datasource_DATASOURCE_TIDAL,
+			expectedId:            "1",
+			expectedRunnerUpIds:   []string{"2"},
+			expectedLowConfidence: true,
+		},
+		{
+			name:                "unacceptable candidates are runner-ups",
+			candidates:          []*core.SearchCandidate{newCandidate("1", 70), newCandidate("2", 60)},
+			datasource:          myncer_pb.Datasource_DATASOURCE_TIDAL,
+			profile:             &myncer_pb.MatchingProfile{MinAcceptanceScore: 80},
+			expectedRunnerUpIds: []string{"1", "2"},
+		},
+		{
+			name:                "tidal rejects borderline candidates by default",
+			candidates:          []*core.SearchCandidate{newCandidate("1", 60)},
+			datasource:          myncer_pb.Datasource_DATASOURCE_TIDAL,
+			expectedRunnerUpIds: []string{"1"},
+		},
+		{
+			name:                  "spotify accepts borderline candidates by default",
+			candidates:            []*core.SearchCandidate{newCandidate("1", 60)},
+			datasource:            myncer_pb.Datasource_DATASOURCE_SPOTIFY,
+			expectedId:            "1",
+			expectedRunnerUpIds:   []string{},
+			expectedLowConfidence: true,
+		},
+		{
+			name:                  "youtube accepts borderline candidates by default",
+			candidates:            []*core.SearchCandidate{newCandidate("1", 60)},
+			datasource:            myncer_pb.Datasource_DATASOURCE_YOUTUBE,
+			expectedId:            "1",
+			expectedRunnerUpIds:   []string{},
+			expectedLowConfidence: true,
+		},
+		{
+			name:                "profile minimum applies to every datasource",
+			candidates:          []*core.SearchCandidate{newCandidate("1", 70)},
+			datasource:          myncer_pb.Datasource_DATASOURCE_SPOTIFY,
+			profile:             &myncer_pb.MatchingProfile{MinAcceptanceScore: 80},
+			expectedRunnerUpIds: []string{"1"},
+		},
+		{
+			name:                "candidates sharing nothing are never accepted",
+			candidates:          []*core.SearchCandidate{newCandidate("1", 0)},
+			datasource:          myncer_pb.Datasource_DATASOURCE_SPOTIFY,
+			expectedRunnerUpIds: []string{"1"},
+		},
+		{
+			name:                "no candidates",
+			candidates:          []*core.SearchCandidate{},
+			datasource:          myncer_pb.Datasource_DATASOURCE_TIDAL,
+			expectedRunnerUpIds: []string{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			match := newSongMatch(tc.candidates, tc.datasource, tc.profile)
+			if tc.expectedId == "" {
+				assert.Nil(t, match.song)
+			} else if assert.NotNil(t, match.song) {
+				assert.Equal(t, tc.expectedId, match.song.GetId())
+				assert.Equal(t, tc.datasource, match.song.GetSpec().GetDatasource())
+			}
+			assert.Equal(t, tc.expectedRunnerUpIds, getRunnerUpIds(match))
+			assert.Equal(t, tc.expectedLowConfidence, match.isLowConfidence(tc.profile))
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package sync_engine
+
+import (
+	"context"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+type songOverridesCtxType struct{}
+
+// The overrides of the user running the sync, keyed by getSongOverrideKey.
+type songOverrides map[string]*myncer_pb.SongOverride
+
+func newSongOverrides(overrides []*myncer_pb.SongOverride /*const*/) songOverrides {
+	r := songOverrides{}
+	for _, songOverride := range overrides {
+		r[getSongOverrideKey(songOverride.GetSourceSong(), songOverride.GetDestinationDatasource())] = songOverride
+	}
+	return r
+}
+
+func getSongOverrideKey(sourceSong *myncer_pb.Song /*const*/, destinationDatasource myncer_pb.Datasource) string {
+	return sourceSong.GetDatasource().String() + ":" + sourceSong.GetDatasourceSongId() + ">" +
+		destinationDatasource.String()
+}
+
+// Makes the sync run executing under the context apply the overrides when searching for songs.
+func withSongOverrides(ctx context.Context, overrides songOverrides) context.Context {
+	return context.WithValue(ctx, songOverridesCtxType{}, overrides)
+}
+
+// Returns nil if the song has no override for the datasource.
+func getSongOverride(
+	ctx context.Context,
+	song core.Song, /*const*/
+	datasource myncer_pb.Datasource,
+) *myncer_pb.SongOverride /*const,@nullable*/ {
+	overrides, ok := ctx.Value(songOverridesCtxType{}).(songOverrides)
+	if !ok || song.GetSpec().GetDatasourceSongId() == "" {
+		return nil
+	}
+	return overrides[getSongOverrideKey(song.GetSpec(), datasource)]
+}
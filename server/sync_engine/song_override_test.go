@@ -0,0 +1,73 @@
+package sync_engine
+
+import (
+	"context"
+	"testing"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSongOverride(t *testing.T) {
+	newSpotifySong := func(id string) *myncer_pb.Song {
+		return &myncer_pb.Song{Datasource: myncer_pb.Datasource_DATASOURCE_SPOTIFY, DatasourceSongId: id}
+	}
+	pin := &myncer_pb.SongOverride{
+		SourceSong:            newSpotifySong("1"),
+		DestinationDatasource: myncer_pb.Datasource_DATASOURCE_TIDAL,
+		Kind:                  myncer_pb.SongOverrideKind_SONG_OVERRIDE_KIND_PIN,
+		DestinationSong:       &myncer_pb.Song{DatasourceSongId: "t1"},
+	}
+	neverSync := &myncer_pb.SongOverride{
+		SourceSong:            newSpotifySong("2"),
+		DestinationDatasource: myncer_pb.Datasource_DATASOURCE_YOUTUBE,
+		Kind:                  myncer_pb.SongOverrideKind_SONG_OVERRIDE_KIND_NEVER_SYNC,
+	}
+	ctx := withSongOverrides(context.Background(), newSongOverrides([]*myncer_pb.SongOverride{pin, neverSync}))
+
+	testCases := []struct {
+		name       string
+		ctx        context.Context
+		song       *myncer_pb.Song
+		datasource myncer_pb.Datasource
+		expected   *myncer_pb.SongOverride
+	}{
+		{
+			name:       "pin",
+			ctx:        ctx,
+			song:       newSpotifySong("1"),
+			datasource: myncer_pb.Datasource_DATASOURCE_TIDAL,
+			expected:   pin,
+		},
+		{
+			name:       "never sync",
+			ctx:        ctx,
+			song:       newSpotifySong("2"),
+			datasource: myncer_pb.Datasource_DATASOURCE_YOUTUBE,
+			expected:   neverSync,
+		},
+		{
+			name:       "other datasource",
+			ctx:        ctx,
+			song:       newSpotifySong("1"),
+			datasource: myncer_pb.Datasource_DATASOURCE_YOUTUBE,
+		},
+		{
+			name:       "song without datasource song id",
+			ctx:        ctx,
+			song:       &myncer_pb.Song{Datasource: myncer_pb.Datasource_DATASOURCE_SPOTIFY},
+			datasource: myncer_pb.Datasource_DATASOURCE_TIDAL,
+		},
+		{
+			name:       "no overrides",
+			ctx:        context.Background(),
+			song:       newSpotifySong("1"),
+			datasource: myncer_pb.Datasource_DATASOURCE_TIDAL,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, getSongOverride(tc.ctx, NewSong(tc.song), tc.datasource))
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package sync_engine
+
+import (
+	"context"
+	"time"
+
+	"github.com/hansbala/myncer/core"
+	"github.com/hansbala/myncer/matching"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// How long a song stays resolved before it is searched for again.
+// Datasources rarely change their catalogs, but songs do get taken down or replaced.
+const cSongResolutionTtl = 30 * 24 * time.Hour
+
+// Returns whether the song can be taken as resolved instead of searching for it again.
+// Resolutions that are too old, or that the profile would no longer accept, are searched for again.
+func isSongResolutionUsable(
+	resolution *myncer_pb.SongResolution, /*const*/
+	profile *myncer_pb.MatchingProfile, /*const,@nullable*/
+	now time.Time,
+) bool {
+	if resolution.GetDestinationSong().GetDatasourceSongId() == "" {
+		return false
+	}
+	if now.Sub(resolution.GetResolvedAt().AsTime()) > cSongResolutionTtl {
+		return false
+	}
+	return matching.IsAcceptableMatch(
+		resolution.GetScore(),
+		profile,
+		resolution.GetDestinationSong().GetDatasource(),
+	)
+}
+
+// Returns what the song was last resolved to on the datasource.
+// Returns nil if the song has to be searched for.
+func (s *syncEngineImpl) getResolvedSongMatch(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	song core.Song, /*const*/
+	datasource myncer_pb.Datasource,
+) *songMatch /*@nullable*/ {
+	if song.GetSpec().GetDatasourceSongId() == "" {
+		return nil
+	}
+	resolution, err := core.ToMyncerCtx(ctx).DB.SongResolutionStore.GetSongResolution(
+		ctx,
+		userInfo.GetId(),
+		song.GetSpec(),
+		datasource,
+	)
+	if err != nil {
+		// The song is searched for instead.
+		core.Errorf(core.WrappedError(err, "failed to get resolution of song %s", song.GetName()))
+		return nil
+	}
+	if resolution == nil || !isSongResolutionUsable(resolution, getMatchingProfile(ctx), time.Now()) {
+		return nil
+	}
+	return &songMatch{
+		song:      NewSong(resolution.GetDestinationSong()),
+		score:     resolution.GetScore(),
+		runnerUps: resolution.GetRunnerUps(),
+	}
+}
+
+// Remembers what the song was resolved to so that later runs don't search for it again.
+func (s *syncEngineImpl) storeSongResolution(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	song core.Song, /*const*/
+	match *songMatch, /*const*/
+) {
+	if song.GetSpec().GetDatasourceSongId() == "" {
+		return
+	}
+	resolution := &myncer_pb.SongResolution{
+		UserId: userInfo.GetId(),
+		SourceSong: &myncer_pb.Song{
+			Datasource:       song.GetSpec().GetDatasource(),
+			DatasourceSongId: song.GetSpec().GetDatasourceSongId(),
+		},
+		DestinationSong: match.song.GetSpec(),
+		Score:           match.score,
+		ResolvedAt:      timestamppb.Now(),
+		RunnerUps:       match.runnerUps,
+	}
+	if err := core.ToMyncerCtx(ctx).DB.SongResolutionStore.SetSongResolution(ctx, resolution); err != nil {
+		core.Errorf(core.WrappedError(err, "failed to store resolution of song %s", song.GetName()))
+	}
+}
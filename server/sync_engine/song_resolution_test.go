@@ -0,0 +1,55 @@
+package sync_engine
+
+import (
+	"testing"
+	"time"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestIsSongResolutionUsable(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	newResolution := func(songId string, score float64, age time.Duration) *myncer_pb.SongResolution {
+		return &myncer_pb.SongResolution{
+			DestinationSong: &myncer_pb.Song{DatasourceSongId: songId},
+			Score:           score,
+			ResolvedAt:      timestamppb.New(now.Add(-age)),
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		resolution *myncer_pb.SongResolution
+		profile    *myncer_pb.MatchingProfile
+		expected   bool
+	}{
+		{
+			name:       "fresh and accepted",
+			resolution: newResolution("1", 90, time.Hour),
+			expected:   true,
+		},
+		{
+			name:       "stale",
+			resolution: newResolution("1", 90, cSongResolutionTtl+time.Hour),
+			expected:   false,
+		},
+		{
+			name:       "no longer accepted by the profile",
+			resolution: newResolution("1", 90, time.Hour),
+			profile:    &myncer_pb.MatchingProfile{MinAcceptanceScore: 95},
+			expected:   false,
+		},
+		{
+			name:       "missing destination song",
+			resolution: newResolution("", 90, time.Hour),
+			expected:   false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isSongResolutionUsable(tc.resolution, tc.profile, now))
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hansbala/myncer/core"
 	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/proto"
 )
 
 //go:embed normalizer_system.prompt
@@ -54,8 +55,33 @@ func (lsn *llmSongsNormalizerImpl) NormalizeSongs(
 		core.Errorf(fmt.Sprintf("failed to parse llm response: [%s]", llmResponse))
 		return nil, core.WrappedError(err, "failed to parse normalizer llm response")
 	}
+	return lsn.mergeNormalizedSongs(songs, normalizedSongs)
+}
 
-	return normalizedSongs, nil
+// Returns the songs with the normalized details in place of their own.
+// Only the details the LLM normalizes are taken from its response. Ids, durations and every other
+// field are kept from the original songs, since the LLM's JSON round trip may drop or alter them.
+func (lsn *llmSongsNormalizerImpl) mergeNormalizedSongs(
+	songs *core.SongList, /*const*/
+	normalizedSongs []*myncer_pb.Song, /*const*/
+) (*core.SongList, error) {
+	specs := songs.GetSpecs()
+	if len(normalizedSongs) != len(specs) {
+		return nil, core.NewError(
+			"normalizer returned %d songs for %d songs",
+			len(normalizedSongs),
+			len(specs),
+		)
+	}
+	r := []core.Song{}
+	for i, spec := range specs {
+		merged := proto.Clone(spec).(*myncer_pb.Song)
+		merged.Name = normalizedSongs[i].GetName()
+		merged.ArtistName = normalizedSongs[i].GetArtistName()
+		merged.AlbumName = normalizedSongs[i].GetAlbumName()
+		r = append(r, NewSong(merged))
+	}
+	return core.NewSongList(r), nil
 }
 
 func (lsn *llmSongsNormalizerImpl) getSystemPrompt() (string, error) {
@@ -76,17 +102,13 @@ func (lsn *llmSongsNormalizerImpl) getUserPrompt(
 	return string(bytes), nil
 }
 
-func (lsn *llmSongsNormalizerImpl) parseLlmResponse(llmResponse string) (*core.SongList, error) {
+func (lsn *llmSongsNormalizerImpl) parseLlmResponse(llmResponse string) ([]*myncer_pb.Song, error) {
 	llmResponse = cleanseJsonBeginAndEndTags(llmResponse)
 	songs := []*myncer_pb.Song{}
 	if err := json.Unmarshal([]byte(llmResponse), &songs); err != nil {
 		return nil, core.WrappedError(err, "failed to unmarshal json from llm")
 	}
-	parsed := []core.Song{}
-	for _, song := range songs {
-		parsed = append(parsed, NewSong(song))
-	}
-	return core.NewSongList(parsed), nil
+	return songs, nil
 }
 
 func cleanseJsonBeginAndEndTags(i string) string {
@@ -0,0 +1,77 @@
+package sync_engine
+
+import (
+	"testing"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMergeNormalizedSongs(t *testing.T) {
+	songs := core.NewSongList([]core.Song{
+		NewSong(&myncer_pb.Song{
+			Name:             "Billie Jean - 2008 Remaster",
+			ArtistName:       []string{"Michael Jackson"},
+			Datasource:       myncer_pb.Datasource_DATASOURCE_SPOTIFY,
+			DatasourceSongId: "spotify-id",
+			Isrc:             "USSM19902991",
+			DurationMs:       294000,
+		}),
+	})
+	testCases := []struct {
+		name            string
+		normalizedSongs []*myncer_pb.Song
+		expected        []*myncer_pb.Song
+		expectedError   string
+	}{
+		{
+			name: "identity is kept",
+			normalizedSongs: []*myncer_pb.Song{
+				{
+					Name:             "Billie Jean",
+					ArtistName:       []string{"Michael Jackson"},
+					AlbumName:        "Thriller",
+					DatasourceSongId: "made-up-id",
+				},
+			},
+			expected: []*myncer_pb.Song{
+				{
+					Name:             "Billie Jean",
+					ArtistName:       []string{"Michael Jackson"},
+					AlbumName:        "Thriller",
+					Datasource:       myncer_pb.Datasource_DATASOURCE_SPOTIFY,
+					DatasourceSongId: "spotify-id",
+					Isrc:             "USSM19902991",
+					DurationMs:       294000,
+				},
+			},
+		},
+		{
+			name:            "missing songs",
+			normalizedSongs: []*myncer_pb.Song{},
+			expectedError:   "normalizer returned 0 songs for 1 songs",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			merged, err := (&llmSongsNormalizerImpl{}).mergeNormalizedSongs(songs, tc.normalizedSongs)
+			if tc.expectedError != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tc.expectedError)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			specs := merged.GetSpecs()
+			if assert.Len(t, specs, len(tc.expected)) {
+				for i := range specs {
+					assert.True(t, proto.Equal(tc.expected[i], specs[i]), "got %v", specs[i])
+				}
+			}
+			// The original songs are left alone.
+			assert.Equal(t, "Billie Jean - 2008 Remaster", songs.GetSpecs()[0].GetName())
+		})
+	}
+}
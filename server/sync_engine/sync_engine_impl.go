@@ -2,12 +2,17 @@ package sync_engine
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hansbala/myncer/core"
+	"github.com/hansbala/myncer/filtering"
 	"github.com/hansbala/myncer/matching"
 	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func NewSyncEngine() core.SyncEngine {
@@ -22,86 +27,296 @@ func (s *syncEngineImpl) RunSync(
 	ctx context.Context,
 	userInfo *myncer_pb.User, /*const*/
 	sync *myncer_pb.Sync, /*const*/
+	syncRun *myncer_pb.SyncRun,
 ) error {
 	// Validates the sync is valid and implemented.
 	if err := s.validateSync(sync); err != nil {
 		return core.WrappedError(err, "failed to validate sync")
 	}
+	filter, err := filtering.NewRuleEvaluator(sync.GetFilterRules(), time.Now())
+	if err != nil {
+		return core.WrappedError(err, "failed to validate sync filter rules")
+	}
+	if err := matching.ValidateMatchingProfile(sync.GetMatchingProfile()); err != nil {
+		return core.WrappedError(err, "failed to validate sync matching profile")
+	}
+	songOverrides, err := core.ToMyncerCtx(ctx).DB.SongOverrideStore.GetSongOverrides(ctx, userInfo.GetId())
+	if err != nil {
+		return core.WrappedError(err, "failed to get song overrides")
+	}
+
+	attempt := &myncer_pb.SyncRunAttempt{
+		AttemptNumber: int32(len(syncRun.GetAttempts()) + 1),
+		StartedAt:     timestamppb.Now(),
+	}
 
 	// Store the sync run run state in the database.
-	syncRun := s.getSyncRun(sync)
-	if err := s.storeSyncRun(ctx, syncRun, true /*create*/); err != nil {
+	syncRun.SyncStatus = myncer_pb.SyncStatus_SYNC_STATUS_RUNNING
+	// Progress is tracked per attempt.
+	syncRun.Progress = &myncer_pb.SyncRunProgress{}
+	syncRun.TargetResults = nil
+	syncRun.ExcludedSongs = nil
+	syncRun.LowConfidenceMatches = nil
+	if syncRun.GetKind() == myncer_pb.SyncRunKind_SYNC_RUN_KIND_PREVIEW {
+		syncRun.Preview = &myncer_pb.SyncPreview{}
+		ctx = withSyncPreview(ctx, newSyncPreview(syncRun.GetPreview()))
+	}
+	ctx = withMatchingProfile(ctx, sync.GetMatchingProfile())
+	ctx = withSongOverrides(ctx, newSongOverrides(songOverrides))
+	ctx = withSyncRunProgress(ctx, time.Now())
+	if err := s.storeSyncRun(ctx, syncRun); err != nil {
 		return core.WrappedError(err, "failed to store sync run")
 	}
 
 	// Run the sync and capture unmatched songs.
-	var err error = nil
 	var unmatchedSongs []*myncer_pb.Song
 
 	switch v := sync.GetSyncVariant().(type) {
 	case *myncer_pb.Sync_OneWaySync:
-		unmatchedSongs, err = s.runOneWaySync(ctx, userInfo, v.OneWaySync)
+		unmatchedSongs, err = s.runOneWaySync(ctx, userInfo, v.OneWaySync, syncRun, filter)
 		if err != nil {
 			err = core.WrappedError(err, "failed to run one-way sync")
 		}
 	case *myncer_pb.Sync_PlaylistMergeSync:
-		unmatchedSongs, err = s.runPlaylistMergeSync(ctx, userInfo, v.PlaylistMergeSync)
+		unmatchedSongs, err = s.runPlaylistMergeSync(ctx, userInfo, v.PlaylistMergeSync, syncRun, filter)
 		if err != nil {
 			err = core.WrappedError(err, "failed to run playlist merge sync")
 		}
+	case *myncer_pb.Sync_FanOutSync:
+		unmatchedSongs, err = s.runFanOutSync(ctx, userInfo, v.FanOutSync, syncRun, filter)
+		if err != nil {
+			err = core.WrappedError(err, "failed to run fan-out sync")
+		}
 	default:
 		// We should never reach here if the sync was validated correctly.
 		err = core.NewError(fmt.Sprintf("unreachable: unknown sync variant: %T", sync.GetSyncVariant()))
 	}
 
 	// Update the status of the sync run in the database.
-	if err != nil {
+	if errors.Is(err, core.CSyncRunCancelledError) {
+		syncRun.SyncStatus = myncer_pb.SyncStatus_SYNC_STATUS_CANCELLED
+		syncRun.ErrorMessage = s.getCancellationMessage(syncRun)
+	} else if err != nil {
 		syncRun.SyncStatus = myncer_pb.SyncStatus_SYNC_STATUS_FAILED
 		syncRun.ErrorMessage = err.Error()
+		attempt.ErrorMessage = err.Error()
+		attempt.Retryable = core.IsRetryableError(err)
 	} else {
 		syncRun.SyncStatus = myncer_pb.SyncStatus_SYNC_STATUS_COMPLETED
+		// Clear any error left over from a previous attempt.
+		syncRun.ErrorMessage = ""
 	}
 	syncRun.UnmatchedSongs = unmatchedSongs
+	attempt.FinishedAt = timestamppb.Now()
+	syncRun.Attempts = append(syncRun.Attempts, attempt)
 
-	if err := s.storeSyncRun(ctx, syncRun, false /*create*/); err != nil {
+	s.addPendingSyncRunEvents(ctx)
+	if err := s.storeSyncRun(ctx, syncRun); err != nil {
 		return core.WrappedError(err, "failed to update sync run in database")
 	}
 
 	return nil
 }
 
-func (s *syncEngineImpl) getSyncRun(sync *myncer_pb.Sync /*const*/) *myncer_pb.SyncRun {
-	return &myncer_pb.SyncRun{
-		SyncId:     sync.GetId(),
-		RunId:      uuid.NewString(),
-		SyncStatus: myncer_pb.SyncStatus_SYNC_STATUS_RUNNING,
+func (s *syncEngineImpl) storeSyncRun(
+	ctx context.Context,
+	syncRun *myncer_pb.SyncRun, /*const*/
+) error {
+	if err := core.ToMyncerCtx(ctx).DB.SyncRunStore.UpdateSyncRun(ctx, syncRun); err != nil {
+		return core.WrappedError(err, "failed to update sync run in database")
 	}
+	getSyncRunProgress(ctx).lastStoredAt = time.Now()
+	return nil
 }
 
-func (s *syncEngineImpl) storeSyncRun(
+// Stores the run along with its pending events.
+// Progress is best effort so failures are logged rather than failing the run.
+func (s *syncEngineImpl) storeSyncRunProgress(ctx context.Context, syncRun *myncer_pb.SyncRun /*const*/) {
+	s.addPendingSyncRunEvents(ctx)
+	if err := s.storeSyncRun(ctx, syncRun); err != nil {
+		core.Errorf(core.WrappedError(err, "failed to store progress of sync run %s", syncRun.GetRunId()))
+	}
+}
+
+// Records that the run has reached the phase.
+// Returns CSyncRunCancelledError if the run was asked to stop before starting the phase.
+func (s *syncEngineImpl) enterPhase(
 	ctx context.Context,
-	syncRun *myncer_pb.SyncRun, /*const*/
-	create bool, // if true, create a new sync run, otherwise update an existing one.
+	syncRun *myncer_pb.SyncRun,
+	phase myncer_pb.SyncRunPhase,
 ) error {
-	syncRunStore := core.ToMyncerCtx(ctx).DB.SyncRunStore
-	if create {
-		if err := syncRunStore.CreateSyncRun(ctx, syncRun); err != nil {
-			return core.WrappedError(err, "failed to create sync run in database")
-		}
+	if err := core.CheckSyncRunCancelled(ctx); err != nil {
+		return err
+	}
+	syncRun.Phase = phase
+	switch phase {
+	case myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_CLEAR_DESTINATION,
+		myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_ADD_TO_DESTINATION,
+		myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_REMOVE_FROM_DESTINATION,
+		myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_REORDER_DESTINATION:
+		// Recorded before the destination is touched so that a run interrupted halfway through is
+		// never mistaken for one that left the destination alone.
+		// Preview runs only plan these phases.
+		syncRun.DestinationModified = syncRun.GetKind() != myncer_pb.SyncRunKind_SYNC_RUN_KIND_PREVIEW
+	}
+	s.addPendingSyncRunEvents(ctx)
+	if err := s.storeSyncRun(ctx, syncRun); err != nil {
+		return err
+	}
+	s.queueSyncRunEvent(ctx, syncRun, &myncer_pb.SyncRunEvent{Event: &myncer_pb.SyncRunEvent_Phase{Phase: phase}})
+	s.addPendingSyncRunEvents(ctx)
+	return nil
+}
+
+// Returns the songs the filter keeps and records the excluded ones on the sync run.
+func (s *syncEngineImpl) filterSourceSongs(
+	syncRun *myncer_pb.SyncRun,
+	filter filtering.RuleEvaluator, /*const*/
+	songs []core.Song, /*const*/
+) []core.Song {
+	kept, excluded := filter.FilterSongs(songs)
+	syncRun.ExcludedSongs = append(syncRun.ExcludedSongs, core.NewSongList(excluded).GetSpecs()...)
+	return kept
+}
+
+// Records the outcome of searching for a song on the destination datasource.
+// The outcome is written with the next batch of progress, see syncRunProgress.
+func (s *syncEngineImpl) recordSongMatchResult(
+	ctx context.Context,
+	syncRun *myncer_pb.SyncRun,
+	song core.Song, /*const*/
+	match *songMatch, /*const*/
+) {
+	result := &myncer_pb.SongMatchResult{SourceSong: song.GetSpec(), RunnerUps: match.runnerUps}
+	if match.song != nil {
+		result.Matched = true
+		result.DestinationSongId = match.song.GetId()
+		result.DestinationSong = match.song.GetSpec()
+		result.Score = match.score
+		result.LowConfidence = match.isLowConfidence(getMatchingProfile(ctx))
+		syncRun.GetProgress().MatchedSongs++
 	} else {
-		if err := syncRunStore.UpdateSyncRun(ctx, syncRun); err != nil {
-			return core.WrappedError(err, "failed to update sync run in database")
-		}
+		syncRun.GetProgress().UnmatchedSongs++
+	}
+	if result.GetLowConfidence() {
+		syncRun.GetProgress().LowConfidenceSongs++
+		syncRun.LowConfidenceMatches = append(syncRun.LowConfidenceMatches, result)
+	}
+	if syncRun.GetKind() == myncer_pb.SyncRunKind_SYNC_RUN_KIND_PREVIEW {
+		syncRun.GetPreview().Matches = append(syncRun.GetPreview().Matches, result)
+	}
+	s.queueSyncRunEvent(
+		ctx,
+		syncRun,
+		&myncer_pb.SyncRunEvent{
+			Event: &myncer_pb.SyncRunEvent_SongMatchResult{SongMatchResult: result},
+		},
+	)
+	if getSyncRunProgress(ctx).isDue(time.Now()) {
+		s.storeSyncRunProgress(ctx, syncRun)
+	}
+}
+
+// Records that songs were added to the destination playlist.
+func (s *syncEngineImpl) recordAddedSongs(ctx context.Context, syncRun *myncer_pb.SyncRun, numSongs int) {
+	syncRun.GetProgress().AddedSongs += int32(numSongs)
+	s.storeSyncRunProgress(ctx, syncRun)
+}
+
+// Records that songs were removed from the destination playlist.
+func (s *syncEngineImpl) recordRemovedSongs(ctx context.Context, syncRun *myncer_pb.SyncRun, numSongs int) {
+	syncRun.GetProgress().RemovedSongs += int32(numSongs)
+	s.storeSyncRunProgress(ctx, syncRun)
+}
+
+// Stores the songs the playlist currently holds before the run removes any of them.
+func (s *syncEngineImpl) snapshotPlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	syncRun *myncer_pb.SyncRun, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
+	client core.DatasourceClient,
+) error {
+	songs, err := client.GetPlaylistSongs(ctx, userInfo, playlist)
+	if err != nil {
+		return core.WrappedError(err, "failed to fetch playlist %s for snapshot", playlist.GetPlaylistId())
+	}
+	return s.storePlaylistSnapshot(ctx, userInfo, syncRun, playlist, songs)
+}
+
+// Stores the songs of the playlist so that it can be restored if the run removes the wrong songs.
+// The run must not touch the playlist if the snapshot could not be stored.
+func (s *syncEngineImpl) storePlaylistSnapshot(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	syncRun *myncer_pb.SyncRun, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
+	songs []core.Song, /*const*/
+) error {
+	if syncRun.GetKind() == myncer_pb.SyncRunKind_SYNC_RUN_KIND_PREVIEW {
+		// Nothing is removed.
+		return nil
+	}
+	snapshot := &myncer_pb.PlaylistSnapshot{
+		Id:       uuid.NewString(),
+		UserId:   userInfo.GetId(),
+		SyncId:   syncRun.GetSyncId(),
+		RunId:    syncRun.GetRunId(),
+		Playlist: playlist,
+		Songs:    core.NewSongList(songs).GetSpecs(),
+	}
+	if err := core.ToMyncerCtx(ctx).DB.PlaylistSnapshotStore.AddPlaylistSnapshot(ctx, snapshot); err != nil {
+		return core.WrappedError(err, "failed to snapshot playlist %s", playlist.GetPlaylistId())
 	}
 	return nil
 }
 
+// Publishes the event to watchers of the run.
+// Queues the event, along with the current progress of the run, to be added with the next batch of
+// events.
+func (s *syncEngineImpl) queueSyncRunEvent(
+	ctx context.Context,
+	syncRun *myncer_pb.SyncRun, /*const*/
+	event *myncer_pb.SyncRunEvent,
+) {
+	event.RunId = syncRun.GetRunId()
+	event.Progress = proto.Clone(syncRun.GetProgress()).(*myncer_pb.SyncRunProgress)
+	progress := getSyncRunProgress(ctx)
+	progress.pendingEvents = append(progress.pendingEvents, event)
+}
+
+// Adds the queued events of the run.
+// Events are best effort so failures are logged rather than failing the run.
+func (s *syncEngineImpl) addPendingSyncRunEvents(ctx context.Context) {
+	progress := getSyncRunProgress(ctx)
+	if len(progress.pendingEvents) == 0 {
+		return
+	}
+	dbStores := core.ToMyncerCtx(ctx).DB
+	if err := dbStores.SyncRunEventStore.AddSyncRunEvents(ctx, progress.pendingEvents); err != nil {
+		core.Errorf(
+			core.WrappedError(err, "failed to add events for sync run %s", progress.pendingEvents[0].GetRunId()),
+		)
+	}
+	progress.pendingEvents = nil
+}
+
+func (s *syncEngineImpl) getCancellationMessage(syncRun *myncer_pb.SyncRun /*const*/) string {
+	msg := fmt.Sprintf("sync run was cancelled during phase %s", syncRun.GetPhase())
+	if syncRun.GetDestinationModified() {
+		return msg + "; the destination playlist may have been partially modified"
+	}
+	return msg + "; the destination playlist was not modified"
+}
+
 func (s *syncEngineImpl) validateSync(sync *myncer_pb.Sync /*const*/) error {
 	switch sync.GetSyncVariant().(type) {
 	case *myncer_pb.Sync_OneWaySync:
 		return nil
 	case *myncer_pb.Sync_PlaylistMergeSync:
 		return nil
+	case *myncer_pb.Sync_FanOutSync:
+		return nil
 	default:
 		return core.NewError(fmt.Sprintf("unknown sync variant: %T", sync.GetSyncVariant()))
 	}
@@ -111,91 +326,272 @@ func (s *syncEngineImpl) runOneWaySync(
 	ctx context.Context,
 	userInfo *myncer_pb.User, /*const*/
 	sync *myncer_pb.OneWaySync, /*const*/
+	syncRun *myncer_pb.SyncRun,
+	filter filtering.RuleEvaluator, /*const*/
 ) ([]*myncer_pb.Song, error) {
-	sourceClient, err := s.getClient(ctx, sync.GetSource().GetDatasource())
+	normalizedSongs, err := s.getNormalizedSourceSongs(ctx, userInfo, sync.GetSource(), syncRun, filter)
 	if err != nil {
 		return nil, err
 	}
-	destClient, err := s.getClient(ctx, sync.GetDestination().GetDatasource())
+	return s.writeOneWaySync(ctx, userInfo, sync, syncRun, normalizedSongs)
+}
+
+// Fetches the songs of the source playlist that pass the filter and normalizes them if supported.
+func (s *syncEngineImpl) getNormalizedSourceSongs(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	source *myncer_pb.MusicSource, /*const*/
+	syncRun *myncer_pb.SyncRun,
+	filter filtering.RuleEvaluator, /*const*/
+) (*core.SongList, error) {
+	sourceClient, err := s.getClient(ctx, source.GetDatasource())
 	if err != nil {
 		return nil, err
 	}
 
 	// Fetch songs from source playlist
-	sourceSongs, err := sourceClient.GetPlaylistSongs(ctx, userInfo, sync.GetSource().GetPlaylistId())
+	if err := s.enterPhase(ctx, syncRun, myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_FETCH_SOURCE); err != nil {
+		return nil, err
+	}
+	sourceSongs, err := sourceClient.GetPlaylistSongs(ctx, userInfo, source)
 	if err != nil {
 		return nil, core.WrappedError(err, "failed to fetch source playlist")
 	}
+	// Filtered before normalizing so the rules see the songs as they are on the source.
+	sourceSongs = s.filterSourceSongs(syncRun, filter, sourceSongs)
 
 	// Normalize songs if supported.
-	var normalizedSongs *core.SongList
-	if s.shouldNormalize(ctx) {
-		normalizedSongs, err = NewLlmSongsNormalizer().NormalizeSongs(
-			ctx,
-			core.NewSongList(sourceSongs),
-		)
-		if err != nil {
-			return nil, core.WrappedError(err, "failed to normalize songs")
-		}
-	} else {
-		normalizedSongs = core.NewSongList(sourceSongs)
+	if !s.shouldNormalize(ctx) {
+		return core.NewSongList(sourceSongs), nil
+	}
+	if err := s.enterPhase(ctx, syncRun, myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_NORMALIZE); err != nil {
+		return nil, err
+	}
+	normalizedSongs, err := NewLlmSongsNormalizer().NormalizeSongs(
+		ctx,
+		core.NewSongList(sourceSongs),
+	)
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to normalize songs")
 	}
+	return normalizedSongs, nil
+}
 
+// Writes the normalized songs of the one-way sync's source to its destination.
+func (s *syncEngineImpl) writeOneWaySync(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	sync *myncer_pb.OneWaySync, /*const*/
+	syncRun *myncer_pb.SyncRun,
+	normalizedSongs *core.SongList, /*const*/
+) ([]*myncer_pb.Song, error) {
+	destClient, err := s.getClient(ctx, sync.GetDestination().GetDatasource())
+	if err != nil {
+		return nil, err
+	}
+
+	if sync.GetMode() == myncer_pb.OneWaySyncMode_ONE_WAY_SYNC_MODE_DIFF {
+		return s.runOneWayDiffSync(ctx, userInfo, sync, syncRun, destClient, normalizedSongs.GetSongs())
+	}
+
+	if err := s.enterPhase(ctx, syncRun, myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_SEARCH); err != nil {
+		return nil, err
+	}
 	searchedSongs, unmatchedSongs, err := s.getSearchedSongsWithUnmatched(
 		ctx,
 		userInfo,
 		normalizedSongs.GetSongs(),
 		sync.GetDestination().GetDatasource(),
+		syncRun,
 	)
 	if err != nil {
 		return nil, core.WrappedError(err, "failed to get searched songs for destination datasource")
 	}
 
 	// Optionally clear destination playlist
-	destPlaylistId := sync.GetDestination().GetPlaylistId()
+	destination := sync.GetDestination()
 	if sync.OverwriteExisting {
+		if err := s.snapshotPlaylist(ctx, userInfo, syncRun, sync.GetDestination(), destClient); err != nil {
+			return unmatchedSongs, err
+		}
+		if err := s.enterPhase(ctx, syncRun, myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_CLEAR_DESTINATION); err != nil {
+			return unmatchedSongs, err
+		}
 		core.Printf("Clearing destination playlist")
-		if err := destClient.ClearPlaylist(ctx, userInfo, destPlaylistId); err != nil {
+		if err := destClient.ClearPlaylist(ctx, userInfo, destination); err != nil {
 			return unmatchedSongs, core.WrappedError(err, "failed to clear destination playlist")
 		}
 	}
 
 	// Add source songs to destination
-	if err := destClient.AddToPlaylist(ctx, userInfo, destPlaylistId, searchedSongs); err != nil {
+	if err := s.enterPhase(ctx, syncRun, myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_ADD_TO_DESTINATION); err != nil {
+		return unmatchedSongs, err
+	}
+	if err := destClient.AddToPlaylist(ctx, userInfo, destination, searchedSongs); err != nil {
 		return unmatchedSongs, core.WrappedError(err, "failed to add songs to destination playlist")
 	}
-	
+	s.recordAddedSongs(ctx, syncRun, len(searchedSongs))
+
+	if err := s.reorderPlaylist(
+		ctx,
+		userInfo,
+		syncRun,
+		sync.GetDestination(),
+		destClient,
+		searchedSongs,
+		sync.GetOrder(),
+	); err != nil {
+		return unmatchedSongs, err
+	}
 	return unmatchedSongs, nil
 }
 
-func (s *syncEngineImpl) getSearchedSongs(
+// Brings the destination in line with the source by only adding the songs it is missing, and
+// optionally removing the songs the source doesn't have.
+func (s *syncEngineImpl) runOneWayDiffSync(
 	ctx context.Context,
 	userInfo *myncer_pb.User, /*const*/
-	songs []core.Song, /*const*/
-	datasource myncer_pb.Datasource, /*const*/
-) ([]core.Song, error) {
-	r := []core.Song{}
-	for _, song := range songs {
-		newDatasourceSongId, err := song.GetIdByDatasource(ctx, userInfo, datasource)
-		if err != nil {
-			// Just log the error and continue with the next song.
-			core.Errorf(
-				core.NewError("failed to get datasource ID for song %s: %s", song.GetName(), err.Error()),
+	sync *myncer_pb.OneWaySync, /*const*/
+	syncRun *myncer_pb.SyncRun,
+	destClient core.DatasourceClient,
+	sourceSongs []core.Song, /*const*/
+) ([]*myncer_pb.Song, error) {
+	destination := sync.GetDestination()
+	if err := s.enterPhase(ctx, syncRun, myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_FETCH_DESTINATION); err != nil {
+		return nil, err
+	}
+	destSongs, err := destClient.GetPlaylistSongs(ctx, userInfo, destination)
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to fetch destination playlist")
+	}
+
+	r, err := s.addMissingSongs(
+		ctx,
+		userInfo,
+		syncRun,
+		sync.GetDestination(),
+		destClient,
+		destSongs,
+		sourceSongs,
+	)
+	if err != nil {
+		return r.unmatchedSongs, err
+	}
+
+	if extraSongs := r.diff.getExtraSongs(); sync.GetRemoveExtraSongs() && len(extraSongs) > 0 {
+		// Taken from the songs fetched before any were added so a restore undoes the whole run.
+		if err := s.storePlaylistSnapshot(ctx, userInfo, syncRun, sync.GetDestination(), destSongs); err != nil {
+			return r.unmatchedSongs, err
+		}
+		if err := s.enterPhase(
+			ctx,
+			syncRun,
+			myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_REMOVE_FROM_DESTINATION,
+		); err != nil {
+			return r.unmatchedSongs, err
+		}
+		if err := destClient.RemoveFromPlaylist(ctx, userInfo, destination, extraSongs); err != nil {
+			return r.unmatchedSongs, core.WrappedError(err, "failed to remove songs from destination playlist")
+		}
+		s.recordRemovedSongs(ctx, syncRun, len(extraSongs))
+	}
+
+	if err := s.reorderPlaylist(
+		ctx,
+		userInfo,
+		syncRun,
+		sync.GetDestination(),
+		destClient,
+		r.orderedSongs,
+		sync.GetOrder(),
+	); err != nil {
+		return r.unmatchedSongs, err
+	}
+	return r.unmatchedSongs, nil
+}
+
+// The outcome of adding the songs missing from a playlist.
+type addMissingSongsResult struct {
+	// The destination playlist compared to the source songs.
+	diff *playlistDiff
+	// Source songs that could not be found on the destination datasource.
+	unmatchedSongs []*myncer_pb.Song
+	addedSongs     []core.Song
+	// The destination songs that match the source songs, in source order.
+	orderedSongs []core.Song
+}
+
+// Adds the source songs that are missing from the destination playlist, which currently holds
+// `destSongs`.
+func (s *syncEngineImpl) addMissingSongs(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	syncRun *myncer_pb.SyncRun,
+	destination *myncer_pb.MusicSource, /*const*/
+	destClient core.DatasourceClient,
+	destSongs []core.Song, /*const*/
+	sourceSongs []core.Song, /*const*/
+) (*addMissingSongsResult, error) {
+	r := &addMissingSongsResult{diff: newPlaylistDiff(destSongs, getMatchingProfile(ctx))}
+	syncRun.GetProgress().TotalSongs += int32(len(sourceSongs))
+
+	// Songs already in the destination don't need to be searched for.
+	resolvedSongs := make([]core.Song, len(sourceSongs))
+	for i, song := range sourceSongs {
+		// Overridden songs are synced as the user asked even if the destination has a similar song.
+		if getSongOverride(ctx, song, destination.GetDatasource()) != nil {
+			continue
+		}
+		if destSong := r.diff.claimSimilar(song); destSong != nil {
+			resolvedSongs[i] = destSong
+			s.recordSongMatchResult(
+				ctx,
+				syncRun,
+				song,
+				&songMatch{song: destSong, score: getMatchScore(song, destSong, getMatchingProfile(ctx))},
 			)
+		}
+	}
+
+	if err := s.enterPhase(ctx, syncRun, myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_SEARCH); err != nil {
+		return r, err
+	}
+	for i, song := range sourceSongs {
+		if resolvedSongs[i] != nil {
 			continue
 		}
-		r = append(
-			r,
-			NewSong(
-				&myncer_pb.Song{
-					Name:             song.GetName(),
-					ArtistName:       song.GetArtistNames(),
-					AlbumName:        song.GetAlbum(),
-					DatasourceSongId: newDatasourceSongId,
-				},
-			),
-		)
+		foundSong, unmatchedSong, err := s.searchSong(ctx, userInfo, song, destination.GetDatasource(), syncRun)
+		if err != nil {
+			return r, core.WrappedError(err, "failed to search for song on destination datasource")
+		}
+		if foundSong == nil {
+			if unmatchedSong != nil {
+				r.unmatchedSongs = append(r.unmatchedSongs, unmatchedSong)
+			}
+			continue
+		}
+		resolvedSongs[i] = foundSong
+		// The search may resolve a song to one the destination already has under different metadata.
+		if !r.diff.claimById(foundSong.GetId()) {
+			r.addedSongs = append(r.addedSongs, foundSong)
+		}
 	}
+	for _, song := range resolvedSongs {
+		if song != nil {
+			r.orderedSongs = append(r.orderedSongs, song)
+		}
+	}
+	if len(r.addedSongs) == 0 {
+		return r, nil
+	}
+
+	if err := s.enterPhase(ctx, syncRun, myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_ADD_TO_DESTINATION); err != nil {
+		return r, err
+	}
+	if err := destClient.AddToPlaylist(ctx, userInfo, destination, r.addedSongs); err != nil {
+		return r, core.WrappedError(err, "failed to add songs to playlist %s", destination.GetPlaylistId())
+	}
+	s.recordAddedSongs(ctx, syncRun, len(r.addedSongs))
 	return r, nil
 }
 
@@ -204,39 +600,132 @@ func (s *syncEngineImpl) getSearchedSongsWithUnmatched(
 	userInfo *myncer_pb.User, /*const*/
 	songs []core.Song, /*const*/
 	datasource myncer_pb.Datasource, /*const*/
+	syncRun *myncer_pb.SyncRun,
 ) ([]core.Song, []*myncer_pb.Song, error) {
 	foundSongs := []core.Song{}
 	unmatchedSongs := []*myncer_pb.Song{}
-	
+	syncRun.GetProgress().TotalSongs += int32(len(songs))
+
 	for _, song := range songs {
-		newDatasourceSongId, err := song.GetIdByDatasource(ctx, userInfo, datasource)
+		foundSong, unmatchedSong, err := s.searchSong(ctx, userInfo, song, datasource, syncRun)
+		if err != nil {
+			return nil, nil, err
+		}
+		if foundSong == nil {
+			if unmatchedSong != nil {
+				unmatchedSongs = append(unmatchedSongs, unmatchedSong)
+			}
+			continue
+		}
+		foundSongs = append(foundSongs, foundSong)
+	}
+	return foundSongs, unmatchedSongs, nil
+}
+
+// Searches for the song on the datasource.
+// If the song can't be found, returns nil and the song to report as unmatched.
+// The user's override of the song takes precedence over searching for it; songs that are never
+// synced to the datasource are excluded from the run and nil is returned for both.
+func (s *syncEngineImpl) searchSong(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	song core.Song, /*const*/
+	datasource myncer_pb.Datasource, /*const*/
+	syncRun *myncer_pb.SyncRun,
+) (core.Song /*@nullable*/, *myncer_pb.Song /*@nullable*/, error) {
+	if err := core.CheckSyncRunCancelled(ctx); err != nil {
+		return nil, nil, err
+	}
+	var match *songMatch
+	switch songOverride := getSongOverride(ctx, song, datasource); songOverride.GetKind() {
+	case myncer_pb.SongOverrideKind_SONG_OVERRIDE_KIND_NEVER_SYNC:
+		syncRun.GetProgress().TotalSongs--
+		syncRun.ExcludedSongs = append(syncRun.ExcludedSongs, song.GetSpec())
+		return nil, nil, nil
+	case myncer_pb.SongOverrideKind_SONG_OVERRIDE_KIND_PIN:
+		pinnedSong := NewSong(songOverride.GetDestinationSong())
+		match = &songMatch{
+			song:   pinnedSong,
+			score:  getMatchScore(song, pinnedSong, getMatchingProfile(ctx)),
+			pinned: true,
+		}
+	default:
+		foundMatch, err := s.findSong(ctx, userInfo, song, datasource)
+		if core.IsRetryableError(err) {
+			// Every other song would likely fail the same way, so the run fails and is retried instead.
+			return nil, nil, core.WrappedError(err, "failed to search for song %s", song.GetName())
+		}
 		if err != nil {
-			// Song not found in destination datasource - add to unmatched list
+			// Other search failures leave the song unmatched rather than failing the run.
 			core.Errorf(
 				core.NewError("failed to get datasource ID for song %s: %s", song.GetName(), err.Error()),
 			)
-			unmatchedSongs = append(unmatchedSongs, &myncer_pb.Song{
-				Name:             song.GetName(),
-				ArtistName:       song.GetArtistNames(),
-				AlbumName:        song.GetAlbum(),
-				Datasource:       song.GetSpec().GetDatasource(),
-				DatasourceSongId: song.GetSpec().GetDatasourceSongId(),
-			})
-			continue
+			foundMatch = &songMatch{}
 		}
-		foundSongs = append(
-			foundSongs,
-			NewSong(
-				&myncer_pb.Song{
-					Name:             song.GetName(),
-					ArtistName:       song.GetArtistNames(),
-					AlbumName:        song.GetAlbum(),
-					DatasourceSongId: newDatasourceSongId,
-				},
-			),
-		)
+		match = foundMatch
 	}
-	return foundSongs, unmatchedSongs, nil
+	s.recordSongMatchResult(ctx, syncRun, song, match)
+	if match.song == nil {
+		return nil, &myncer_pb.Song{
+			Name:             song.GetName(),
+			ArtistName:       song.GetArtistNames(),
+			AlbumName:        song.GetAlbum(),
+			Datasource:       song.GetSpec().GetDatasource(),
+			DatasourceSongId: song.GetSpec().GetDatasourceSongId(),
+		}, nil
+	}
+	return NewSong(
+		&myncer_pb.Song{
+			Name:             song.GetName(),
+			ArtistName:       song.GetArtistNames(),
+			AlbumName:        song.GetAlbum(),
+			Datasource:       datasource,
+			DatasourceSongId: match.song.GetId(),
+		},
+	), nil, nil
+}
+
+// Finds the song on the datasource. The match has no song if nothing acceptable was found.
+// Songs that are already from the datasource are matched to themselves, and songs that were found
+// before are only searched for again once their resolution is stale.
+func (s *syncEngineImpl) findSong(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	song core.Song, /*const*/
+	datasource myncer_pb.Datasource,
+) (*songMatch, error) {
+	if song.GetSpec().GetDatasource() == datasource {
+		return &songMatch{song: song, score: 100.0}, nil
+	}
+	if resolvedMatch := s.getResolvedSongMatch(ctx, userInfo, song, datasource); resolvedMatch != nil {
+		return resolvedMatch, nil
+	}
+	client, err := s.getClient(ctx, datasource)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := client.Search(ctx, userInfo, song, getMatchingProfile(ctx))
+	if err != nil {
+		return nil, core.WrappedError(err, "%v search failed for song: %s", datasource, song.GetName())
+	}
+	r := newSongMatch(candidates, datasource, getMatchingProfile(ctx))
+	if r.song != nil {
+		s.storeSongResolution(ctx, userInfo, song, r)
+	}
+	return r, nil
+}
+
+// Returns how similar the matched song is to the source song, from 0 to 100.
+func getMatchScore(
+	song core.Song, /*const*/
+	matchedSong core.Song, /*const*/
+	profile *myncer_pb.MatchingProfile, /*const,@nullable*/
+) float64 {
+	if song.GetSpec().GetDatasource() == matchedSong.GetSpec().GetDatasource() &&
+		song.GetId() == matchedSong.GetId() {
+		return 100.0
+	}
+	return matching.CalculateSimilarity(song, matchedSong, profile)
 }
 
 func (s *syncEngineImpl) shouldNormalize(ctx context.Context) bool {
@@ -247,71 +736,210 @@ func (s *syncEngineImpl) getClient(
 	ctx context.Context,
 	datasource myncer_pb.Datasource,
 ) (core.DatasourceClient, error) {
-	dsClients := core.ToMyncerCtx(ctx).DatasourceClients
-	switch datasource {
-	case myncer_pb.Datasource_DATASOURCE_SPOTIFY:
-		return dsClients.SpotifyClient, nil
-	case myncer_pb.Datasource_DATASOURCE_YOUTUBE:
-		return dsClients.YoutubeClient, nil
-	case myncer_pb.Datasource_DATASOURCE_TIDAL:
-		return dsClients.TidalClient, nil
-	default:
-		return nil, core.NewError("unsupported datasource: %v", datasource)
+	client, err := core.ToMyncerCtx(ctx).DatasourceClients.GetClient(datasource)
+	if err != nil {
+		return nil, err
+	}
+	if preview := getSyncPreview(ctx); preview != nil {
+		// Preview runs plan their writes instead of making them.
+		return newPreviewClient(client, preview), nil
 	}
+	return client, nil
 }
 
 func (s *syncEngineImpl) runPlaylistMergeSync(
 	ctx context.Context,
 	userInfo *myncer_pb.User, /*const*/
 	sync *myncer_pb.PlaylistMergeSync, /*const*/
+	syncRun *myncer_pb.SyncRun,
+	filter filtering.RuleEvaluator, /*const*/
 ) ([]*myncer_pb.Song, error) {
 	allSongs := []core.Song{}
+	// Keyed by playlist lock key. Only needed in bidirectional mode.
+	songsBySource := map[string][]core.Song{}
 
 	// 1. Collect songs from all sources
+	if err := s.enterPhase(ctx, syncRun, myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_FETCH_SOURCE); err != nil {
+		return nil, err
+	}
 	for _, source := range sync.GetSources() {
+		if err := core.CheckSyncRunCancelled(ctx); err != nil {
+			return nil, err
+		}
 		sourceClient, err := s.getClient(ctx, source.GetDatasource())
 		if err != nil {
 			return nil, core.WrappedError(err, "failed to get source client for datasource %v", source.GetDatasource())
 		}
-		songs, err := sourceClient.GetPlaylistSongs(ctx, userInfo, source.GetPlaylistId())
+		songs, err := sourceClient.GetPlaylistSongs(ctx, userInfo, source)
 		if err != nil {
+			if s.writesToSources(sync) {
+				// The source is also written to, so it can't be skipped.
+				return nil, core.WrappedError(err, "failed to fetch source playlist %s", source.GetPlaylistId())
+			}
 			core.Warningf("Could not fetch songs from playlist %s, skipping.", source.GetPlaylistId())
 			continue
 		}
 		allSongs = append(allSongs, songs...)
+		songsBySource[core.GetPlaylistLockKey(source)] = songs
+	}
+
+	if sync.GetMode() == myncer_pb.PlaylistMergeSyncMode_PLAYLIST_MERGE_SYNC_MODE_THREE_WAY {
+		// Songs removed since the last run have to be dropped before the songs are merged.
+		return s.runThreeWayMergeSync(ctx, userInfo, sync, syncRun, songsBySource, filter)
 	}
 
-	// 2. Remove duplicates (decoupled logic)
-	uniqueSongs, err := matching.DeduplicateSongs(allSongs, 90.0) // 90.0 is the similarity threshold
+	// 2. Drop the songs excluded by the filter rules and remove duplicates (decoupled logic).
+	// Sources keep their excluded songs; they just aren't carried over to other playlists.
+	allSongs = s.filterSourceSongs(syncRun, filter, allSongs)
+	uniqueSongs, err := matching.DeduplicateSongs(allSongs, getMatchingProfile(ctx))
 	if err != nil {
 		return nil, core.WrappedError(err, "failed to deduplicate songs")
 	}
 
+	if sync.GetMode() == myncer_pb.PlaylistMergeSyncMode_PLAYLIST_MERGE_SYNC_MODE_BIDIRECTIONAL {
+		return s.writeMergedSongsToTargets(ctx, userInfo, sync, syncRun, uniqueSongs, songsBySource)
+	}
+
 	// 3. Get destination client
 	destClient, err := s.getClient(ctx, sync.GetDestination().GetDatasource())
 	if err != nil {
 		return nil, core.WrappedError(err, "failed to get destination client")
 	}
 
-	destPlaylistId := sync.GetDestination().GetPlaylistId()
+	destination := sync.GetDestination()
 
-	// 4. (Optional) Clear destination playlist
-	if sync.GetOverwriteExisting() {
-		if err := destClient.ClearPlaylist(ctx, userInfo, destPlaylistId); err != nil {
-			return nil, core.WrappedError(err, "failed to clear destination playlist")
-		}
+	// 4. Search for each song on the destination platform.
+	// Done before touching the destination so a cancelled or failed search leaves it intact.
+	if err := s.enterPhase(ctx, syncRun, myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_SEARCH); err != nil {
+		return nil, err
 	}
-	
-	// 5. Add songs to destination list
-	// You may need to search for each song on the destination platform first.
-	searchedSongs, unmatchedSongs, err := s.getSearchedSongsWithUnmatched(ctx, userInfo, uniqueSongs, sync.GetDestination().GetDatasource())
+	searchedSongs, unmatchedSongs, err := s.getSearchedSongsWithUnmatched(ctx, userInfo, uniqueSongs, sync.GetDestination().GetDatasource(), syncRun)
 	if err != nil {
 		return nil, core.WrappedError(err, "failed to search for songs on destination platform")
 	}
 
-	if err := destClient.AddToPlaylist(ctx, userInfo, destPlaylistId, searchedSongs); err != nil {
+	// 5. (Optional) Clear destination playlist
+	if sync.GetOverwriteExisting() {
+		if err := s.snapshotPlaylist(ctx, userInfo, syncRun, sync.GetDestination(), destClient); err != nil {
+			return unmatchedSongs, err
+		}
+		if err := s.enterPhase(ctx, syncRun, myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_CLEAR_DESTINATION); err != nil {
+			return unmatchedSongs, err
+		}
+		if err := destClient.ClearPlaylist(ctx, userInfo, destination); err != nil {
+			return unmatchedSongs, core.WrappedError(err, "failed to clear destination playlist")
+		}
+	}
+
+	// 6. Add songs to destination list
+	if err := s.enterPhase(ctx, syncRun, myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_ADD_TO_DESTINATION); err != nil {
+		return unmatchedSongs, err
+	}
+	if err := destClient.AddToPlaylist(ctx, userInfo, destination, searchedSongs); err != nil {
 		return unmatchedSongs, core.WrappedError(err, "failed to add songs to destination playlist")
 	}
+	s.recordAddedSongs(ctx, syncRun, len(searchedSongs))
 
+	// 7. (Optional) Put the destination in order
+	if err := s.reorderPlaylist(
+		ctx,
+		userInfo,
+		syncRun,
+		sync.GetDestination(),
+		destClient,
+		searchedSongs,
+		sync.GetOrder(),
+	); err != nil {
+		return unmatchedSongs, err
+	}
 	return unmatchedSongs, nil
 }
+
+func (s *syncEngineImpl) writesToSources(sync *myncer_pb.PlaylistMergeSync /*const*/) bool {
+	return sync.GetMode() != myncer_pb.PlaylistMergeSyncMode_PLAYLIST_MERGE_SYNC_MODE_UNSPECIFIED
+}
+
+// Adds the merged songs each target playlist is missing.
+// Every target is attempted even if an earlier one fails so that one broken playlist doesn't hold
+// back the rest.
+// Returns the songs that could not be found on any one of the targets.
+func (s *syncEngineImpl) writeMergedSongsToTargets(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	sync *myncer_pb.PlaylistMergeSync, /*const*/
+	syncRun *myncer_pb.SyncRun,
+	mergedSongs []core.Song, /*const*/
+	songsBySource map[string][]core.Song, /*const*/
+) ([]*myncer_pb.Song, error) {
+	unmatchedSongs := []*myncer_pb.Song{}
+	var errs []error
+	for _, target := range core.GetPlaylistMergeSyncTargets(sync) {
+		targetResult := &myncer_pb.SyncRunTargetResult{Target: target}
+		syncRun.TargetResults = append(syncRun.TargetResults, targetResult)
+
+		unmatched, addedSongs, err := s.writeMergedSongsToTarget(
+			ctx,
+			userInfo,
+			syncRun,
+			target,
+			mergedSongs,
+			songsBySource,
+			sync.GetOrder(),
+		)
+		targetResult.UnmatchedSongs = unmatched
+		targetResult.AddedSongs = int32(len(addedSongs))
+		unmatchedSongs = append(unmatchedSongs, unmatched...)
+		if errors.Is(err, core.CSyncRunCancelledError) {
+			return unmatchedSongs, err
+		}
+		if err != nil {
+			errs = append(
+				errs,
+				core.WrappedError(err, "failed to write merged songs to playlist %s", target.GetPlaylistId()),
+			)
+		}
+	}
+	return unmatchedSongs, errors.Join(errs...)
+}
+
+func (s *syncEngineImpl) writeMergedSongsToTarget(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	syncRun *myncer_pb.SyncRun,
+	target *myncer_pb.MusicSource, /*const*/
+	mergedSongs []core.Song, /*const*/
+	songsBySource map[string][]core.Song, /*const*/
+	order myncer_pb.PlaylistOrder,
+) ([]*myncer_pb.Song, []core.Song, error) {
+	targetClient, err := s.getClient(ctx, target.GetDatasource())
+	if err != nil {
+		return nil, nil, err
+	}
+	targetSongs, ok := songsBySource[core.GetPlaylistLockKey(target)]
+	if !ok {
+		// The destination is only written to.
+		if err := s.enterPhase(ctx, syncRun, myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_FETCH_DESTINATION); err != nil {
+			return nil, nil, err
+		}
+		targetSongs, err = targetClient.GetPlaylistSongs(ctx, userInfo, target)
+		if err != nil {
+			return nil, nil, core.WrappedError(err, "failed to fetch destination playlist")
+		}
+	}
+	r, err := s.addMissingSongs(
+		ctx,
+		userInfo,
+		syncRun,
+		target,
+		targetClient,
+		targetSongs,
+		mergedSongs,
+	)
+	if err != nil {
+		return r.unmatchedSongs, r.addedSongs, err
+	}
+	if err := s.reorderPlaylist(ctx, userInfo, syncRun, target, targetClient, r.orderedSongs, order); err != nil {
+		return r.unmatchedSongs, r.addedSongs, err
+	}
+	return r.unmatchedSongs, r.addedSongs, nil
+}
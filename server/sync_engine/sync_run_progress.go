@@ -0,0 +1,42 @@
+package sync_engine
+
+import (
+	"context"
+	"time"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+const (
+	// Per-song progress is written at least this often while songs are being matched.
+	cSongProgressWriteInterval = 2 * time.Second
+	// Per-song progress is written once this many songs have been recorded since the last write.
+	cSongProgressWriteBatchSize = 50
+)
+
+type syncRunProgressCtxType struct{}
+
+// The progress of a sync run that hasn't been written to the database yet.
+// Every write stores the whole run, which grows with the songs matched so far, so per-song progress
+// is written in batches rather than after every song.
+type syncRunProgress struct {
+	// Events waiting to be added, oldest first.
+	pendingEvents []*myncer_pb.SyncRunEvent
+	lastStoredAt  time.Time
+}
+
+func withSyncRunProgress(ctx context.Context, now time.Time) context.Context {
+	return context.WithValue(ctx, syncRunProgressCtxType{}, &syncRunProgress{lastStoredAt: now})
+}
+
+// Returns the progress of the run. It is set by RunSync for the whole run.
+func getSyncRunProgress(ctx context.Context) *syncRunProgress {
+	return ctx.Value(syncRunProgressCtxType{}).(*syncRunProgress)
+}
+
+// Returns true if enough songs were recorded, or enough time has passed, to write the pending
+// progress.
+func (p *syncRunProgress) isDue(now time.Time) bool {
+	return len(p.pendingEvents) >= cSongProgressWriteBatchSize ||
+		now.Sub(p.lastStoredAt) >= cSongProgressWriteInterval
+}
@@ -0,0 +1,47 @@
+package sync_engine
+
+import (
+	"testing"
+	"time"
+
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncRunProgressIsDue(t *testing.T) {
+	lastStoredAt := time.Date(2025, 1, 1, 0 /*hour*/, 0 /*min*/, 0 /*sec*/, 0 /*nsec*/, time.UTC)
+	testCases := []struct {
+		name       string
+		numEvents  int
+		sinceStore time.Duration
+		expected   bool
+	}{
+		{
+			name:       "few songs recently stored",
+			numEvents:  cSongProgressWriteBatchSize - 1,
+			sinceStore: cSongProgressWriteInterval - time.Millisecond,
+			expected:   false,
+		},
+		{
+			name:       "full batch",
+			numEvents:  cSongProgressWriteBatchSize,
+			sinceStore: 0,
+			expected:   true,
+		},
+		{
+			name:       "interval passed",
+			numEvents:  1,
+			sinceStore: cSongProgressWriteInterval,
+			expected:   true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			progress := &syncRunProgress{lastStoredAt: lastStoredAt}
+			for range tc.numEvents {
+				progress.pendingEvents = append(progress.pendingEvents, &myncer_pb.SyncRunEvent{})
+			}
+			assert.Equal(t, tc.expected, progress.isDue(lastStoredAt.Add(tc.sinceStore)))
+		})
+	}
+}
@@ -0,0 +1,314 @@
+package sync_engine
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hansbala/myncer/core"
+	"github.com/hansbala/myncer/filtering"
+	"github.com/hansbala/myncer/matching"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+// A playlist taking part in a three-way merge.
+type mergePlaylist struct {
+	playlist *myncer_pb.MusicSource /*const*/
+	client   core.DatasourceClient
+	// The songs currently in the playlist.
+	currentSongs []core.Song /*const*/
+	// Songs in the baseline that are no longer in the playlist.
+	removedSongs []core.Song /*const*/
+	// Songs in the playlist that were not in the baseline.
+	addedSongs []core.Song /*const*/
+}
+
+// Playlists without a baseline, such as ones added to the sync since its last run, have neither
+// removed nor added songs.
+func newMergePlaylist(
+	playlist *myncer_pb.MusicSource, /*const*/
+	client core.DatasourceClient,
+	currentSongs []core.Song, /*const*/
+	baselineSongs []core.Song, /*const,@nullable*/
+) *mergePlaylist {
+	p := &mergePlaylist{
+		playlist:     playlist,
+		client:       client,
+		currentSongs: currentSongs,
+	}
+	if baselineSongs == nil {
+		return p
+	}
+	// Both lists come from the same playlist so ids are stable between them.
+	baselineIds := core.NewSet[string]()
+	for _, song := range baselineSongs {
+		baselineIds.Add(song.GetId())
+	}
+	currentIds := core.NewSet[string]()
+	for _, song := range currentSongs {
+		currentIds.Add(song.GetId())
+		if !baselineIds.Contains(song.GetId()) {
+			p.addedSongs = append(p.addedSongs, song)
+		}
+	}
+	for _, song := range baselineSongs {
+		if !currentIds.Contains(song.GetId()) {
+			p.removedSongs = append(p.removedSongs, song)
+		}
+	}
+	return p
+}
+
+// Merges the playlists of the sync against the baseline left by its last successful run.
+// Songs removed from any playlist since then are removed from every playlist, and the remaining
+// songs of every playlist are added to the others.
+func (s *syncEngineImpl) runThreeWayMergeSync(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	sync *myncer_pb.PlaylistMergeSync, /*const*/
+	syncRun *myncer_pb.SyncRun,
+	songsBySource map[string][]core.Song, /*const*/
+	filter filtering.RuleEvaluator, /*const*/
+) ([]*myncer_pb.Song, error) {
+	dbStores := core.ToMyncerCtx(ctx).DB
+	baseline, err := dbStores.SyncBaselineStore.GetSyncBaseline(ctx, syncRun.GetSyncId())
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to get sync baseline")
+	}
+	playlists, err := s.getMergePlaylists(ctx, userInfo, sync, syncRun, baseline, songsBySource)
+	if err != nil {
+		return nil, err
+	}
+
+	songsToRemove, conflicts := getThreeWayMergeRemovals(
+		playlists,
+		sync.GetConflictPolicy(),
+		getMatchingProfile(ctx),
+	)
+	syncRun.Conflicts = conflicts
+
+	remainingSongsByPlaylist := make([][]core.Song, len(playlists))
+	removedSongsByPlaylist := make([][]core.Song, len(playlists))
+	allSongs := []core.Song{}
+	for i, p := range playlists {
+		for _, song := range p.currentSongs {
+			if containsSameSong(songsToRemove, song, getMatchingProfile(ctx)) {
+				removedSongsByPlaylist[i] = append(removedSongsByPlaylist[i], song)
+			} else {
+				remainingSongsByPlaylist[i] = append(remainingSongsByPlaylist[i], song)
+			}
+		}
+		allSongs = append(allSongs, remainingSongsByPlaylist[i]...)
+	}
+	// Excluded songs stay in their playlists, so they aren't removals, but aren't merged into the
+	// other playlists either.
+	allSongs = s.filterSourceSongs(syncRun, filter, allSongs)
+	mergedSongs, err := matching.DeduplicateSongs(allSongs, getMatchingProfile(ctx))
+	if err != nil {
+		return nil, core.WrappedError(err, "failed to deduplicate songs")
+	}
+
+	newBaseline := &myncer_pb.SyncBaseline{
+		SyncId: syncRun.GetSyncId(),
+		RunId:  syncRun.GetRunId(),
+	}
+	unmatchedSongs := []*myncer_pb.Song{}
+	var errs []error
+	for i, p := range playlists {
+		targetResult := &myncer_pb.SyncRunTargetResult{Target: p.playlist}
+		syncRun.TargetResults = append(syncRun.TargetResults, targetResult)
+
+		finalSongs, err := s.writeThreeWayMergeToPlaylist(
+			ctx,
+			userInfo,
+			syncRun,
+			p,
+			remainingSongsByPlaylist[i],
+			removedSongsByPlaylist[i],
+			mergedSongs,
+			sync.GetOrder(),
+			targetResult,
+		)
+		unmatchedSongs = append(unmatchedSongs, targetResult.GetUnmatchedSongs()...)
+		if errors.Is(err, core.CSyncRunCancelledError) {
+			return unmatchedSongs, err
+		}
+		if err != nil {
+			errs = append(
+				errs,
+				core.WrappedError(err, "failed to write merged songs to playlist %s", p.playlist.GetPlaylistId()),
+			)
+			continue
+		}
+		newBaseline.Playlists = append(
+			newBaseline.Playlists,
+			&myncer_pb.SyncBaselinePlaylist{
+				Playlist: p.playlist,
+				Songs:    core.NewSongList(finalSongs).GetSpecs(),
+			},
+		)
+	}
+	if len(errs) > 0 {
+		// The baseline is left alone so the next run sees the same removals again.
+		return unmatchedSongs, errors.Join(errs...)
+	}
+	if syncRun.GetKind() == myncer_pb.SyncRunKind_SYNC_RUN_KIND_PREVIEW {
+		// The playlists weren't changed so the old baseline still applies.
+		return unmatchedSongs, nil
+	}
+
+	if err := dbStores.SyncBaselineStore.SetSyncBaseline(ctx, newBaseline); err != nil {
+		return unmatchedSongs, core.WrappedError(err, "failed to store sync baseline")
+	}
+	return unmatchedSongs, nil
+}
+
+func (s *syncEngineImpl) getMergePlaylists(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	sync *myncer_pb.PlaylistMergeSync, /*const*/
+	syncRun *myncer_pb.SyncRun,
+	baseline *myncer_pb.SyncBaseline, /*const,@nullable*/
+	songsBySource map[string][]core.Song, /*const*/
+) ([]*mergePlaylist, error) {
+	baselineSongsByPlaylist := map[string][]core.Song{}
+	for _, baselinePlaylist := range baseline.GetPlaylists() {
+		songs := []core.Song{}
+		for _, song := range baselinePlaylist.GetSongs() {
+			songs = append(songs, NewSong(song))
+		}
+		baselineSongsByPlaylist[core.GetPlaylistLockKey(baselinePlaylist.GetPlaylist())] = songs
+	}
+
+	playlists := []*mergePlaylist{}
+	for _, target := range core.GetPlaylistMergeSyncTargets(sync) {
+		client, err := s.getClient(ctx, target.GetDatasource())
+		if err != nil {
+			return nil, err
+		}
+		currentSongs, ok := songsBySource[core.GetPlaylistLockKey(target)]
+		if !ok {
+			// The destination is only written to.
+			if err := s.enterPhase(ctx, syncRun, myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_FETCH_DESTINATION); err != nil {
+				return nil, err
+			}
+			currentSongs, err = client.GetPlaylistSongs(ctx, userInfo, target)
+			if err != nil {
+				return nil, core.WrappedError(err, "failed to fetch destination playlist")
+			}
+		}
+		playlists = append(
+			playlists,
+			newMergePlaylist(target, client, currentSongs, baselineSongsByPlaylist[core.GetPlaylistLockKey(target)]),
+		)
+	}
+	return playlists, nil
+}
+
+// Returns the songs to remove from every playlist and the conflicts found along the way.
+// A conflict is a song removed from one playlist that was added to another.
+func getThreeWayMergeRemovals(
+	playlists []*mergePlaylist, /*const*/
+	policy myncer_pb.MergeConflictPolicy,
+	profile *myncer_pb.MatchingProfile, /*const,@nullable*/
+) ([]core.Song, []*myncer_pb.MergeConflict) {
+	if policy == myncer_pb.MergeConflictPolicy_MERGE_CONFLICT_POLICY_UNSPECIFIED {
+		policy = myncer_pb.MergeConflictPolicy_MERGE_CONFLICT_POLICY_KEEP
+	}
+	removals := []core.Song{}
+	conflicts := []*myncer_pb.MergeConflict{}
+	for _, p := range playlists {
+		for _, removedSong := range p.removedSongs {
+			keep := false
+			for _, other := range playlists {
+				if other == p {
+					continue
+				}
+				for _, addedSong := range other.addedSongs {
+					if !isSameSong(removedSong, addedSong, profile) {
+						continue
+					}
+					conflicts = append(
+						conflicts,
+						&myncer_pb.MergeConflict{
+							RemovedSong: removedSong.GetSpec(),
+							RemovedFrom: p.playlist,
+							AddedSong:   addedSong.GetSpec(),
+							AddedTo:     other.playlist,
+							Resolution:  policy,
+						},
+					)
+					if policy == myncer_pb.MergeConflictPolicy_MERGE_CONFLICT_POLICY_REMOVE {
+						removals = append(removals, addedSong)
+					} else {
+						keep = true
+					}
+				}
+			}
+			if !keep {
+				removals = append(removals, removedSong)
+			}
+		}
+	}
+	return removals, conflicts
+}
+
+// Removes the songs that were removed elsewhere from the playlist and adds the merged songs it is
+// missing.
+// Returns the songs in the playlist afterwards.
+func (s *syncEngineImpl) writeThreeWayMergeToPlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	syncRun *myncer_pb.SyncRun,
+	p *mergePlaylist, /*const*/
+	remainingSongs []core.Song, /*const*/
+	songsToRemove []core.Song, /*const*/
+	mergedSongs []core.Song, /*const*/
+	order myncer_pb.PlaylistOrder,
+	targetResult *myncer_pb.SyncRunTargetResult,
+) ([]core.Song, error) {
+	if len(songsToRemove) > 0 {
+		if err := s.storePlaylistSnapshot(ctx, userInfo, syncRun, p.playlist, p.currentSongs); err != nil {
+			return nil, err
+		}
+		if err := s.enterPhase(ctx, syncRun, myncer_pb.SyncRunPhase_SYNC_RUN_PHASE_REMOVE_FROM_DESTINATION); err != nil {
+			return nil, err
+		}
+		if err := p.client.RemoveFromPlaylist(ctx, userInfo, p.playlist, songsToRemove); err != nil {
+			return nil, core.WrappedError(err, "failed to remove songs")
+		}
+		s.recordRemovedSongs(ctx, syncRun, len(songsToRemove))
+		targetResult.RemovedSongs = int32(len(songsToRemove))
+	}
+
+	r, err := s.addMissingSongs(
+		ctx,
+		userInfo,
+		syncRun,
+		p.playlist,
+		p.client,
+		remainingSongs,
+		mergedSongs,
+	)
+	targetResult.UnmatchedSongs = r.unmatchedSongs
+	targetResult.AddedSongs = int32(len(r.addedSongs))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.reorderPlaylist(ctx, userInfo, syncRun, p.playlist, p.client, r.orderedSongs, order); err != nil {
+		return nil, err
+	}
+	return append(append([]core.Song{}, remainingSongs...), r.addedSongs...), nil
+}
+
+func containsSameSong(
+	songs []core.Song, /*const*/
+	song core.Song, /*const*/
+	profile *myncer_pb.MatchingProfile, /*const,@nullable*/
+) bool {
+	for _, s := range songs {
+		if isSameSong(s, song, profile) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package sync_engine
+
+import (
+	"testing"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetThreeWayMergeRemovals(t *testing.T) {
+	newSpotifySongs := func(ids ...string) []core.Song {
+		r := []core.Song{}
+		for _, id := range ids {
+			r = append(
+				r,
+				NewSong(&myncer_pb.Song{Datasource: myncer_pb.Datasource_DATASOURCE_SPOTIFY, DatasourceSongId: id}),
+			)
+		}
+		return r
+	}
+	getIds := func(songs []core.Song) []string {
+		r := []string{}
+		for _, song := range songs {
+			r = append(r, song.GetId())
+		}
+		return r
+	}
+
+	testCases := []struct {
+		name              string
+		playlistA         *mergePlaylist
+		playlistB         *mergePlaylist
+		policy            myncer_pb.MergeConflictPolicy
+		expectedRemovals  []string
+		expectedConflicts int
+	}{
+		{
+			name:             "no baseline",
+			playlistA:        newMergePlaylist(nil, nil, newSpotifySongs("1", "2"), nil),
+			playlistB:        newMergePlaylist(nil, nil, newSpotifySongs("3"), nil),
+			expectedRemovals: []string{},
+		},
+		{
+			name:             "removed from one playlist",
+			playlistA:        newMergePlaylist(nil, nil, newSpotifySongs("1"), newSpotifySongs("1", "2")),
+			playlistB:        newMergePlaylist(nil, nil, newSpotifySongs("1", "2"), newSpotifySongs("1", "2")),
+			expectedRemovals: []string{"2"},
+		},
+		{
+			name:              "conflict kept by default",
+			playlistA:         newMergePlaylist(nil, nil, newSpotifySongs("1"), newSpotifySongs("1", "2")),
+			playlistB:         newMergePlaylist(nil, nil, newSpotifySongs("1", "2"), newSpotifySongs("1")),
+			expectedRemovals:  []string{},
+			expectedConflicts: 1,
+		},
+		{
+			name:              "conflict removed",
+			playlistA:         newMergePlaylist(nil, nil, newSpotifySongs("1"), newSpotifySongs("1", "2")),
+			playlistB:         newMergePlaylist(nil, nil, newSpotifySongs("1", "2"), newSpotifySongs("1")),
+			policy:            myncer_pb.MergeConflictPolicy_MERGE_CONFLICT_POLICY_REMOVE,
+			expectedRemovals:  []string{"2", "2"},
+			expectedConflicts: 1,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(
+			tt.name,
+			func(t *testing.T) {
+				removals, conflicts := getThreeWayMergeRemovals(
+					[]*mergePlaylist{tt.playlistA, tt.playlistB},
+					tt.policy,
+					nil, /*profile*/
+				)
+				assert.Equal(t, tt.expectedRemovals, getIds(removals))
+				assert.Len(t, conflicts, tt.expectedConflicts)
+			},
+		)
+	}
+}
@@ -0,0 +1,91 @@
+package sync_scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// How often the scheduler looks for due syncs.
+	cPollInterval = time.Minute
+)
+
+func NewSyncScheduler() core.SyncScheduler {
+	return &syncSchedulerImpl{}
+}
+
+type syncSchedulerImpl struct{}
+
+var _ core.SyncScheduler = (*syncSchedulerImpl)(nil)
+
+func (s *syncSchedulerImpl) Start(ctx context.Context) {
+	ticker := time.NewTicker(cPollInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.runDueSyncs(ctx, time.Now()); err != nil {
+			core.Errorf(core.WrappedError(err, "failed to run due syncs"))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *syncSchedulerImpl) runDueSyncs(ctx context.Context, now time.Time) error {
+	dbStores := core.ToMyncerCtx(ctx).DB
+	// Claiming advances the schedule so that neither this server nor any other picks the syncs up
+	// again on their next poll.
+	dueSyncs, err := dbStores.SyncStore.ClaimDueSyncs(
+		ctx,
+		now,
+		func(sync *myncer_pb.Sync) { s.advanceSchedule(sync, now) },
+	)
+	if err != nil {
+		return core.WrappedError(err, "failed to claim due syncs")
+	}
+	for _, sync := range dueSyncs {
+		syncRun, err := dbStores.SyncJobStore.EnqueueSyncJob(ctx, sync, myncer_pb.SyncRunKind_SYNC_RUN_KIND_UNSPECIFIED)
+		if err != nil {
+			core.Errorf(core.WrappedError(err, "failed to enqueue scheduled sync %s", sync.GetId()))
+			continue
+		}
+		if _, err := dbStores.SyncStore.ModifySync(
+			ctx,
+			sync.GetId(),
+			func(sync *myncer_pb.Sync) error {
+				s.recordScheduledRun(sync, now)
+				return nil
+			},
+		); err != nil {
+			core.Errorf(core.WrappedError(err, "failed to record scheduled run of sync %s", sync.GetId()))
+		}
+		core.Printf("Queued scheduled sync %s as run %s", sync.GetId(), syncRun.GetRunId())
+	}
+	return nil
+}
+
+func (s *syncSchedulerImpl) advanceSchedule(sync *myncer_pb.Sync, now time.Time) {
+	schedule := sync.GetSchedule()
+	// Computed from now rather than the previous next run so that downtime doesn't cause a burst of
+	// catch-up runs.
+	if nextRunAt, ok := core.GetNextScheduledRunTime(schedule.GetInterval(), now); ok {
+		schedule.NextRunAt = timestamppb.New(nextRunAt)
+	} else {
+		schedule.NextRunAt = nil
+	}
+}
+
+// Only runs that were actually queued count, so that a run skipped because the sync was already
+// running doesn't show up as the last run.
+func (s *syncSchedulerImpl) recordScheduledRun(sync *myncer_pb.Sync, now time.Time) {
+	// The schedule may have been removed since the sync was claimed.
+	if sync.GetSchedule() != nil {
+		sync.Schedule.LastRunAt = timestamppb.New(now)
+	}
+}
@@ -0,0 +1,80 @@
+package sync_scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/hansbala/myncer/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAdvanceSchedule(t *testing.T) {
+	now := time.Date(2025, time.January, 31, 10, 30, 0, 0, time.UTC)
+	lastRunAt := timestamppb.New(now.Add(-time.Hour))
+	testCases := []struct {
+		name              string
+		interval          myncer_pb.SyncScheduleInterval
+		expectedNextRunAt *timestamppb.Timestamp
+	}{
+		{
+			name:              "next run is computed from now",
+			interval:          myncer_pb.SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_HOURLY,
+			expectedNextRunAt: timestamppb.New(now.Add(time.Hour)),
+		},
+		{
+			name:     "interval without runs clears the next run",
+			interval: myncer_pb.SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_UNSPECIFIED,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sync := &myncer_pb.Sync{
+				Schedule: &myncer_pb.SyncSchedule{
+					Interval:  tc.interval,
+					NextRunAt: timestamppb.New(now.Add(-time.Minute)),
+					LastRunAt: lastRunAt,
+				},
+			}
+			(&syncSchedulerImpl{}).advanceSchedule(sync, now)
+			assert.Equal(t, tc.expectedNextRunAt.AsTime(), sync.GetSchedule().GetNextRunAt().AsTime())
+			// Only recorded once the run is queued.
+			assert.Equal(t, lastRunAt, sync.GetSchedule().GetLastRunAt())
+		})
+	}
+}
+
+func TestRunDueSyncs(t *testing.T) {
+	db := testutil.GetTestDatabase(t)
+	ctx := core.WithMyncerCtx(context.Background(), &core.MyncerCtx{DB: db})
+	now := time.Now().Truncate(time.Microsecond)
+	sync := testutil.CreateTestSync(t, db, testutil.CreateTestUser(t, db))
+	sync.Schedule = &myncer_pb.SyncSchedule{
+		Interval:  myncer_pb.SyncScheduleInterval_SYNC_SCHEDULE_INTERVAL_HOURLY,
+		NextRunAt: timestamppb.New(now.Add(-time.Minute)),
+	}
+	require.NoError(t, db.SyncStore.UpdateSync(ctx, sync))
+
+	// Polls of two servers at the same time run the sync once.
+	scheduler := &syncSchedulerImpl{}
+	errs := make(chan error, 2)
+	for range 2 {
+		go func() { errs <- scheduler.runDueSyncs(ctx, now) }()
+	}
+	for range 2 {
+		require.NoError(t, <-errs)
+	}
+
+	syncRuns, err := db.SyncRunStore.GetSyncs(ctx, nil /*runIds*/, core.NewSet(sync.GetId()))
+	require.NoError(t, err)
+	assert.Len(t, syncRuns, 1)
+
+	sync, err = db.SyncStore.GetSync(ctx, sync.GetId())
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(time.Hour).UTC(), sync.GetSchedule().GetNextRunAt().AsTime())
+	assert.Equal(t, now.UTC(), sync.GetSchedule().GetLastRunAt().AsTime())
+}
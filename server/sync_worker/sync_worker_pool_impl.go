@@ -0,0 +1,337 @@
+package sync_worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// How long an idle worker waits before checking the queue again.
+	cPollInterval = 5 * time.Second
+	// How often a busy worker reports that it is still alive and checks for cancellation requests.
+	cHeartbeatInterval = 5 * time.Second
+	// Running jobs without a heartbeat for this long are considered orphaned.
+	cStaleJobTimeout = 2 * time.Minute
+	// How often orphaned jobs are looked for after startup.
+	cRecoveryInterval = time.Minute
+	// Orphaned jobs are retried until they have been claimed this many times.
+	cMaxJobAttempts = 3
+	// How long a job waits in the queue when another run holds its locks.
+	cLockedJobDelay = 30 * time.Second
+)
+
+var (
+	cSyncLockedError = core.NewError("sync or destination playlist is locked by another sync run")
+)
+
+func NewSyncWorkerPool(syncEngine core.SyncEngine, numWorkers int) core.SyncWorkerPool {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return &syncWorkerPoolImpl{
+		syncEngine: syncEngine,
+		numWorkers: numWorkers,
+		poolId:     fmt.Sprintf("%s-%s", hostname, uuid.NewString()),
+	}
+}
+
+type syncWorkerPoolImpl struct {
+	syncEngine core.SyncEngine
+	numWorkers int
+	// Unique per process so that jobs claimed by a previous incarnation are never treated as ours.
+	poolId string
+}
+
+var _ core.SyncWorkerPool = (*syncWorkerPoolImpl)(nil)
+
+func (s *syncWorkerPoolImpl) Start(ctx context.Context) {
+	// Recover jobs orphaned by a previous run of the server before accepting new work.
+	s.recoverStaleJobs(ctx)
+
+	wg := sync.WaitGroup{}
+	for i := range s.numWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.work(ctx, fmt.Sprintf("%s-%d", s.poolId, i))
+		}()
+	}
+
+	ticker := time.NewTicker(cRecoveryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case <-ticker.C:
+			s.recoverStaleJobs(ctx)
+		}
+	}
+}
+
+func (s *syncWorkerPoolImpl) work(ctx context.Context, workerId string) {
+	for {
+		job, err := core.ToMyncerCtx(ctx).DB.SyncJobStore.ClaimSyncJob(ctx, workerId)
+		if err != nil {
+			core.Errorf(core.WrappedError(err, "worker %s failed to claim sync job", workerId))
+		}
+		if job != nil {
+			s.runJob(ctx, workerId, job)
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(cPollInterval):
+		}
+	}
+}
+
+func (s *syncWorkerPoolImpl) runJob(ctx context.Context, workerId string, job *core.SyncJob /*const*/) {
+	core.Printf("Worker %s running sync %s (run %s, attempt %d)", workerId, job.SyncId, job.RunId, job.Attempts)
+	jobStore := core.ToMyncerCtx(ctx).DB.SyncJobStore
+
+	heartbeatCtx, stopHeartbeat := context.WithCancel(ctx)
+	defer stopHeartbeat()
+	cancelled := make(chan struct{})
+	go s.heartbeat(heartbeatCtx, workerId, job, cancelled)
+
+	status := core.SyncJobStatus_Completed
+	sync, syncRun, err := s.runSync(core.WithSyncRunCancellation(ctx, cancelled), job)
+	if errors.Is(err, cSyncLockedError) {
+		// Queue the run behind the one holding the lock.
+		core.Printf("Sync %s is locked by another run, re-queueing run %s", job.SyncId, job.RunId)
+		stopHeartbeat()
+		if err := jobStore.RequeueSyncJob(ctx, job.Id, cLockedJobDelay); err != nil {
+			core.Errorf(core.WrappedError(err, "failed to requeue sync job %s", job.Id))
+		}
+		return
+	}
+	if errors.Is(err, core.CSyncPausedError) {
+		// The sync was paused after the run was queued.
+		core.Printf("Sync %s is paused, cancelling run %s", job.SyncId, job.RunId)
+		status = core.SyncJobStatus_Cancelled
+		s.updateSyncRunStatus(ctx, job, myncer_pb.SyncStatus_SYNC_STATUS_CANCELLED, err.Error())
+	} else if err != nil {
+		core.Errorf(core.WrappedError(err, "failed to run sync job %s", job.Id))
+		status = core.SyncJobStatus_Failed
+		s.failSyncRun(ctx, job, err.Error())
+	} else if syncRun.GetSyncStatus() == myncer_pb.SyncStatus_SYNC_STATUS_CANCELLED {
+		status = core.SyncJobStatus_Cancelled
+	} else if syncRun.GetSyncStatus() == myncer_pb.SyncStatus_SYNC_STATUS_FAILED {
+		status = core.SyncJobStatus_Failed
+		if s.maybeScheduleRetry(ctx, job, sync, syncRun) {
+			return
+		}
+	}
+	stopHeartbeat()
+	if err == nil && syncRun.GetKind() != myncer_pb.SyncRunKind_SYNC_RUN_KIND_PREVIEW {
+		s.recordSyncRunOutcome(ctx, syncRun)
+	}
+
+	if err := jobStore.FinishSyncJob(ctx, job.Id, status); err != nil {
+		core.Errorf(core.WrappedError(err, "failed to finish sync job %s", job.Id))
+	}
+}
+
+// Puts the job back in the queue if the run failed with a retryable error and the sync's retry
+// policy allows another attempt.
+// Returns true if a retry was scheduled.
+func (s *syncWorkerPoolImpl) maybeScheduleRetry(
+	ctx context.Context,
+	job *core.SyncJob, /*const*/
+	sync *myncer_pb.Sync, /*const*/
+	syncRun *myncer_pb.SyncRun,
+) bool {
+	attempts := syncRun.GetAttempts()
+	if len(attempts) == 0 || !attempts[len(attempts)-1].GetRetryable() {
+		return false
+	}
+	if len(attempts) >= core.GetMaxSyncRunAttempts(sync.GetRetryPolicy()) {
+		core.Printf("Sync run %s failed after %d attempts, giving up", syncRun.GetRunId(), len(attempts))
+		return false
+	}
+
+	backoff := core.GetRetryBackoff(sync.GetRetryPolicy(), len(attempts), rand.Int64N)
+	lastAttempt := attempts[len(attempts)-1]
+	lastAttempt.NextAttemptAt = timestamppb.New(time.Now().Add(backoff))
+	syncRun.SyncStatus = myncer_pb.SyncStatus_SYNC_STATUS_PENDING
+	dbStores := core.ToMyncerCtx(ctx).DB
+	if err := dbStores.SyncRunStore.UpdateSyncRun(ctx, syncRun); err != nil {
+		core.Errorf(core.WrappedError(err, "failed to update sync run %s", syncRun.GetRunId()))
+		return false
+	}
+	if err := dbStores.SyncJobStore.RequeueSyncJob(ctx, job.Id, backoff); err != nil {
+		core.Errorf(core.WrappedError(err, "failed to requeue sync job %s", job.Id))
+		return false
+	}
+	core.Printf("Retrying sync run %s in %s (attempt %d failed)", syncRun.GetRunId(), backoff, len(attempts))
+	return true
+}
+
+// Counts the failed runs of the sync in a row, pausing the sync once there are too many.
+func (s *syncWorkerPoolImpl) recordSyncRunOutcome(ctx context.Context, syncRun *myncer_pb.SyncRun /*const*/) {
+	// Modified in place since the sync may have been paused or edited while it ran.
+	paused := false
+	sync, err := core.ToMyncerCtx(ctx).DB.SyncStore.ModifySync(
+		ctx,
+		syncRun.GetSyncId(),
+		func(sync *myncer_pb.Sync) error {
+			paused = core.RecordSyncRunOutcome(sync, syncRun, time.Now())
+			return nil
+		},
+	)
+	if err != nil {
+		core.Errorf(core.WrappedError(err, "failed to record outcome of sync run %s", syncRun.GetRunId()))
+		return
+	}
+	if paused {
+		core.Warningf("Paused sync %s after %d failed runs in a row", sync.GetId(), sync.GetConsecutiveFailures())
+	}
+}
+
+func (s *syncWorkerPoolImpl) runSync(
+	ctx context.Context,
+	job *core.SyncJob, /*const*/
+) (*myncer_pb.Sync, *myncer_pb.SyncRun, error) {
+	dbStores := core.ToMyncerCtx(ctx).DB
+	sync, err := dbStores.SyncStore.GetSync(ctx, job.SyncId)
+	if err != nil {
+		return nil, nil, core.WrappedError(err, "failed to get sync %s", job.SyncId)
+	}
+	userInfo, err := dbStores.UserStore.GetUserById(ctx, job.UserId)
+	if err != nil {
+		return nil, nil, core.WrappedError(err, "failed to get user %s", job.UserId)
+	}
+	syncRun, err := dbStores.SyncRunStore.GetSyncRun(ctx, job.RunId)
+	if err != nil {
+		return nil, nil, core.WrappedError(err, "failed to get sync run %s", job.RunId)
+	}
+	if core.IsSyncPaused(sync) && syncRun.GetKind() != myncer_pb.SyncRunKind_SYNC_RUN_KIND_PREVIEW {
+		return nil, nil, core.CSyncPausedError
+	}
+
+	// Two runs writing to the same playlist at once would interleave their clears and adds.
+	lockKeys := []string{core.GetSyncLockKey(sync.GetId())}
+	for _, destination := range core.GetSyncDestinations(sync) {
+		lockKeys = append(lockKeys, core.GetPlaylistLockKey(destination))
+	}
+	lock, err := dbStores.LockStore.TryLock(ctx, lockKeys...)
+	if err != nil {
+		return nil, nil, core.WrappedError(err, "failed to lock sync %s", sync.GetId())
+	}
+	if lock == nil {
+		return nil, nil, cSyncLockedError
+	}
+	defer func() {
+		if err := lock.Unlock(ctx); err != nil {
+			core.Errorf(core.WrappedError(err, "failed to unlock sync %s", sync.GetId()))
+		}
+	}()
+
+	if err := s.syncEngine.RunSync(ctx, userInfo, sync, syncRun); err != nil {
+		return nil, nil, err
+	}
+	return sync, syncRun, nil
+}
+
+// Keeps the job claimed while it runs and closes `cancelled` once cancellation is requested.
+func (s *syncWorkerPoolImpl) heartbeat(
+	ctx context.Context,
+	workerId string,
+	job *core.SyncJob, /*const*/
+	cancelled chan struct{},
+) {
+	ticker := time.NewTicker(cHeartbeatInterval)
+	defer ticker.Stop()
+	isCancelled := false
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			cancelRequested, err := core.ToMyncerCtx(ctx).DB.SyncJobStore.HeartbeatSyncJob(ctx, job.Id, workerId)
+			if err != nil {
+				core.Errorf(core.WrappedError(err, "failed to heartbeat sync job %s", job.Id))
+				continue
+			}
+			if cancelRequested && !isCancelled {
+				core.Printf("Cancelling sync run %s", job.RunId)
+				close(cancelled)
+				isCancelled = true
+			}
+		}
+	}
+}
+
+func (s *syncWorkerPoolImpl) recoverStaleJobs(ctx context.Context) {
+	requeued, cancelled, failed, err := core.ToMyncerCtx(ctx).DB.SyncJobStore.RecoverStaleSyncJobs(
+		ctx,
+		time.Now().Add(-cStaleJobTimeout),
+		cMaxJobAttempts,
+	)
+	if err != nil {
+		core.Errorf(core.WrappedError(err, "failed to recover stale sync jobs"))
+		return
+	}
+	for _, job := range requeued {
+		core.Warningf("Re-queued sync run %s after its worker stopped responding", job.RunId)
+		s.updateSyncRunStatus(ctx, job, myncer_pb.SyncStatus_SYNC_STATUS_PENDING, "" /*errorMessage*/)
+	}
+	for _, job := range cancelled {
+		// Stopping was asked for, so the run is not a failure of the sync.
+		core.Warningf("Cancelled sync run %s after its worker stopped responding", job.RunId)
+		s.updateSyncRunStatus(
+			ctx,
+			job,
+			myncer_pb.SyncStatus_SYNC_STATUS_CANCELLED,
+			"sync run was cancelled after its worker stopped responding",
+		)
+	}
+	for _, job := range failed {
+		core.Warningf("Failed sync run %s after %d interrupted attempts", job.RunId, job.Attempts)
+		s.failSyncRun(
+			ctx,
+			job,
+			fmt.Sprintf("sync run was interrupted %d times and will not be retried", job.Attempts),
+		)
+	}
+}
+
+// Marks the job's sync run as failed unless the sync engine already recorded an outcome.
+func (s *syncWorkerPoolImpl) failSyncRun(ctx context.Context, job *core.SyncJob /*const*/, errorMessage string) {
+	s.updateSyncRunStatus(ctx, job, myncer_pb.SyncStatus_SYNC_STATUS_FAILED, errorMessage)
+}
+
+func (s *syncWorkerPoolImpl) updateSyncRunStatus(
+	ctx context.Context,
+	job *core.SyncJob, /*const*/
+	status myncer_pb.SyncStatus,
+	errorMessage string,
+) {
+	syncRun, err := core.ToMyncerCtx(ctx).DB.SyncRunStore.GetSyncRun(ctx, job.RunId)
+	if err != nil {
+		core.Errorf(core.WrappedError(err, "failed to get sync run %s", job.RunId))
+		return
+	}
+	if core.IsTerminalSyncStatus(syncRun.GetSyncStatus()) {
+		return
+	}
+	syncRun.SyncStatus = status
+	syncRun.ErrorMessage = errorMessage
+	if err := core.ToMyncerCtx(ctx).DB.SyncRunStore.UpdateSyncRun(ctx, syncRun); err != nil {
+		core.Errorf(core.WrappedError(err, "failed to update sync run %s", job.RunId))
+	}
+}
@@ -0,0 +1,272 @@
+package sync_worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+	"github.com/hansbala/myncer/sync_engine"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// Serves a fixed playlist and fails every search with `searchErr`.
+type fakeDatasourceClient struct {
+	core.DatasourceClient
+	songs     []core.Song
+	searchErr error
+}
+
+func (f *fakeDatasourceClient) GetPlaylistSongs(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
+) ([]core.Song, error) {
+	return f.songs, nil
+}
+
+func (f *fakeDatasourceClient) AddToPlaylist(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	playlist *myncer_pb.MusicSource, /*const*/
+	songs []core.Song, /*const*/
+) error {
+	return nil
+}
+
+func (f *fakeDatasourceClient) Search(
+	ctx context.Context,
+	userInfo *myncer_pb.User, /*const*/
+	songToSearch core.Song, /*const*/
+	profile *myncer_pb.MatchingProfile, /*const,@nullable*/
+) ([]*core.SearchCandidate, error) {
+	return nil, f.searchErr
+}
+
+type fakeSyncStore struct {
+	core.SyncStore
+	sync *myncer_pb.Sync
+}
+
+func (f *fakeSyncStore) GetSync(ctx context.Context, id string) (*myncer_pb.Sync, error) {
+	return proto.Clone(f.sync).(*myncer_pb.Sync), nil
+}
+
+func (f *fakeSyncStore) ModifySync(
+	ctx context.Context,
+	id string,
+	modify func(sync *myncer_pb.Sync) error,
+) (*myncer_pb.Sync, error) {
+	sync := proto.Clone(f.sync).(*myncer_pb.Sync)
+	if err := modify(sync); err != nil {
+		return nil, err
+	}
+	f.sync = sync
+	return proto.Clone(sync).(*myncer_pb.Sync), nil
+}
+
+type fakeUserStore struct {
+	core.UserStore
+}
+
+func (f *fakeUserStore) GetUserById(ctx context.Context, id string) (*myncer_pb.User, error) {
+	return &myncer_pb.User{Id: id}, nil
+}
+
+type fakeSyncRunStore struct {
+	core.SyncRunStore
+	syncRun *myncer_pb.SyncRun
+}
+
+func (f *fakeSyncRunStore) GetSyncRun(ctx context.Context, runId string) (*myncer_pb.SyncRun, error) {
+	return proto.Clone(f.syncRun).(*myncer_pb.SyncRun), nil
+}
+
+func (f *fakeSyncRunStore) UpdateSyncRun(ctx context.Context, syncRun *myncer_pb.SyncRun /*const*/) error {
+	f.syncRun = proto.Clone(syncRun).(*myncer_pb.SyncRun)
+	return nil
+}
+
+type fakeSyncRunEventStore struct {
+	core.SyncRunEventStore
+}
+
+func (f *fakeSyncRunEventStore) AddSyncRunEvents(
+	ctx context.Context,
+	events []*myncer_pb.SyncRunEvent, /*const*/
+) error {
+	return nil
+}
+
+type fakeSongOverrideStore struct {
+	core.SongOverrideStore
+}
+
+func (f *fakeSongOverrideStore) GetSongOverrides(
+	ctx context.Context,
+	userId string,
+) ([]*myncer_pb.SongOverride, error) {
+	return nil, nil
+}
+
+type fakeSongResolutionStore struct {
+	core.SongResolutionStore
+}
+
+func (f *fakeSongResolutionStore) GetSongResolution(
+	ctx context.Context,
+	userId string,
+	sourceSong *myncer_pb.Song, /*const*/
+	destinationDatasource myncer_pb.Datasource,
+) (*myncer_pb.SongResolution, error) {
+	return nil, nil
+}
+
+type fakeLock struct{}
+
+func (f *fakeLock) Unlock(ctx context.Context) error {
+	return nil
+}
+
+type fakeLockStore struct {
+	core.LockStore
+}
+
+func (f *fakeLockStore) TryLock(ctx context.Context, keys ...string) (core.Lock, error) {
+	return &fakeLock{}, nil
+}
+
+// Records what happened to the job.
+type fakeSyncJobStore struct {
+	core.SyncJobStore
+	requeueDelays []time.Duration
+	finalStatus   core.SyncJobStatus
+}
+
+func (f *fakeSyncJobStore) HeartbeatSyncJob(ctx context.Context, jobId string, workerId string) (bool, error) {
+	return false, nil
+}
+
+func (f *fakeSyncJobStore) RequeueSyncJob(ctx context.Context, jobId string, delay time.Duration) error {
+	f.requeueDelays = append(f.requeueDelays, delay)
+	return nil
+}
+
+func (f *fakeSyncJobStore) FinishSyncJob(ctx context.Context, jobId string, status core.SyncJobStatus) error {
+	f.finalStatus = status
+	return nil
+}
+
+func TestRunJobSearchErrors(t *testing.T) {
+	initialBackoff := time.Minute
+	testCases := []struct {
+		name                   string
+		searchErr              error
+		expectedStatus         myncer_pb.SyncStatus
+		expectedRetryable      bool
+		expectedRequeued       bool
+		expectedUnmatchedSongs int
+	}{
+		{
+			name:              "retryable errors fail the attempt and schedule a retry",
+			searchErr:         core.NewRetryableError(core.NewError("Tidal API returned status 429 for search")),
+			expectedStatus:    myncer_pb.SyncStatus_SYNC_STATUS_PENDING,
+			expectedRetryable: true,
+			expectedRequeued:  true,
+		},
+		{
+			name:                   "other errors leave the song unmatched",
+			searchErr:              core.NewError("Tidal API returned status 400 for search"),
+			expectedStatus:         myncer_pb.SyncStatus_SYNC_STATUS_COMPLETED,
+			expectedUnmatchedSongs: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sync := &myncer_pb.Sync{
+				Id:     "sync",
+				UserId: "user",
+				SyncVariant: &myncer_pb.Sync_OneWaySync{
+					OneWaySync: &myncer_pb.OneWaySync{
+						Source: &myncer_pb.MusicSource{
+							Datasource: myncer_pb.Datasource_DATASOURCE_SPOTIFY,
+							PlaylistId: "source",
+						},
+						Destination: &myncer_pb.MusicSource{
+							Datasource: myncer_pb.Datasource_DATASOURCE_TIDAL,
+							PlaylistId: "destination",
+						},
+					},
+				},
+				RetryPolicy: &myncer_pb.RetryPolicy{
+					MaxAttempts:           3,
+					InitialBackoffSeconds: int32(initialBackoff.Seconds()),
+				},
+			}
+			syncRunStore := &fakeSyncRunStore{
+				syncRun: &myncer_pb.SyncRun{
+					RunId:      "run",
+					SyncId:     sync.GetId(),
+					SyncStatus: myncer_pb.SyncStatus_SYNC_STATUS_PENDING,
+				},
+			}
+			syncJobStore := &fakeSyncJobStore{}
+			ctx := core.WithMyncerCtx(
+				context.Background(),
+				&core.MyncerCtx{
+					DB: &core.Database{
+						SyncStore:           &fakeSyncStore{sync: sync},
+						UserStore:           &fakeUserStore{},
+						SyncRunStore:        syncRunStore,
+						SyncRunEventStore:   &fakeSyncRunEventStore{},
+						SyncJobStore:        syncJobStore,
+						SongOverrideStore:   &fakeSongOverrideStore{},
+						SongResolutionStore: &fakeSongResolutionStore{},
+						LockStore:           &fakeLockStore{},
+					},
+					DatasourceClients: &core.DatasourceClients{
+						SpotifyClient: &fakeDatasourceClient{
+							songs: []core.Song{
+								sync_engine.NewSong(&myncer_pb.Song{
+									Name:             "Song",
+									ArtistName:       []string{"Artist"},
+									Datasource:       myncer_pb.Datasource_DATASOURCE_SPOTIFY,
+									DatasourceSongId: "1",
+								}),
+							},
+						},
+						TidalClient: &fakeDatasourceClient{searchErr: tc.searchErr},
+					},
+				},
+			)
+
+			workerPool := &syncWorkerPoolImpl{syncEngine: sync_engine.NewSyncEngine()}
+			workerPool.runJob(
+				ctx,
+				"worker",
+				&core.SyncJob{Id: "job", RunId: "run", SyncId: sync.GetId(), UserId: sync.GetUserId(), Attempts: 1},
+			)
+
+			syncRun := syncRunStore.syncRun
+			assert.Equal(t, tc.expectedStatus, syncRun.GetSyncStatus())
+			assert.Len(t, syncRun.GetUnmatchedSongs(), tc.expectedUnmatchedSongs)
+			if assert.Len(t, syncRun.GetAttempts(), 1) {
+				assert.Equal(t, tc.expectedRetryable, syncRun.GetAttempts()[0].GetRetryable())
+			}
+			if !tc.expectedRequeued {
+				assert.Empty(t, syncJobStore.requeueDelays)
+				assert.Equal(t, core.SyncJobStatus_Completed, syncJobStore.finalStatus)
+				return
+			}
+			if assert.Len(t, syncJobStore.requeueDelays, 1) {
+				assert.GreaterOrEqual(t, syncJobStore.requeueDelays[0], initialBackoff/2)
+				assert.LessOrEqual(t, syncJobStore.requeueDelays[0], initialBackoff)
+			}
+			assert.NotNil(t, syncRun.GetAttempts()[0].GetNextAttemptAt())
+			// The job stays queued for the retry.
+			assert.Empty(t, syncJobStore.finalStatus)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Helpers for tests that need a Postgres database.
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hansbala/myncer/core"
+	myncer_pb "github.com/hansbala/myncer/proto/myncer"
+)
+
+// Postgres URL tests that need a database run against. The schema is created if missing.
+const cTestDatabaseUrlEnv = "MYNCER_TEST_DATABASE_URL"
+
+// Returns the test database, skipping the test if none is configured.
+func GetTestDatabase(t *testing.T) *core.Database {
+	t.Helper()
+	url := os.Getenv(cTestDatabaseUrlEnv)
+	if url == "" {
+		t.Skipf("%s is not set", cTestDatabaseUrlEnv)
+	}
+	db := core.MustGetDatabase(
+		context.Background(),
+		&myncer_pb.Config{DatabaseConfig: &myncer_pb.DatabaseConfig{DatabaseUrl: url}},
+	)
+	t.Cleanup(func() { db.DB.Close() })
+	return db
+}
+
+// Creates a user with a unique email.
+func CreateTestUser(t *testing.T, db *core.Database) *myncer_pb.User {
+	t.Helper()
+	id := uuid.NewString()
+	user := &myncer_pb.User{Id: id, Email: fmt.Sprintf("%s@example.com", id)}
+	if err := db.UserStore.CreateUser(context.Background(), user); err != nil {
+		t.Fatalf("failed to create test user: %v", err)
+	}
+	return user
+}
+
+// Creates a one-way sync owned by the user between two Spotify playlists.
+func CreateTestSync(t *testing.T, db *core.Database, user *myncer_pb.User /*const*/) *myncer_pb.Sync {
+	t.Helper()
+	sync := &myncer_pb.Sync{
+		Id:     uuid.NewString(),
+		UserId: user.GetId(),
+		SyncVariant: &myncer_pb.Sync_OneWaySync{
+			OneWaySync: &myncer_pb.OneWaySync{
+				Source: &myncer_pb.MusicSource{
+					Datasource: myncer_pb.Datasource_DATASOURCE_SPOTIFY,
+					PlaylistId: uuid.NewString(),
+				},
+				Destination: &myncer_pb.MusicSource{
+					Datasource: myncer_pb.Datasource_DATASOURCE_SPOTIFY,
+					PlaylistId: uuid.NewString(),
+				},
+			},
+		},
+	}
+	if err := db.SyncStore.CreateSync(context.Background(), sync); err != nil {
+		t.Fatalf("failed to create test sync: %v", err)
+	}
+	return sync
+}